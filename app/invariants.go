@@ -0,0 +1,56 @@
+package app
+
+import (
+	"maps"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// InvariantRoute is an invariant registered by a module.
+type InvariantRoute struct {
+	ModuleName string
+	Route      string
+	Invariant  sdk.Invariant
+}
+
+// FullRoute returns the module/route name of the invariant.
+func (r InvariantRoute) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+// invariantRegistry collects the invariants registered by the app modules. It
+// takes the place of the crisis module, which the app doesn't include.
+type invariantRegistry struct {
+	routes []InvariantRoute
+}
+
+// RegisterRoute implements sdk.InvariantRegistry.
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r.routes = append(r.routes, InvariantRoute{ModuleName: moduleName, Route: route, Invariant: invar})
+}
+
+// Invariants returns the invariants registered by the app modules, ordered by
+// module name.
+func (app *App) Invariants() []InvariantRoute {
+	var ir invariantRegistry
+	for _, name := range slices.Sorted(maps.Keys(app.ModuleManager.Modules)) {
+		if m, ok := app.ModuleManager.Modules[name].(module.HasInvariants); ok { //nolint:staticcheck // deprecated interface
+			m.RegisterInvariants(&ir)
+		}
+	}
+	return ir.routes
+}
+
+// CheckInvariants runs the invariants registered by the app modules and
+// returns the messages of the broken ones.
+func (app *App) CheckInvariants(ctx sdk.Context) []string {
+	var broken []string
+	for _, route := range app.Invariants() {
+		if msg, isBroken := route.Invariant(ctx); isBroken {
+			broken = append(broken, msg)
+		}
+	}
+	return broken
+}
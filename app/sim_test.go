@@ -56,6 +56,16 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// assertInvariants runs the invariants of the app modules on the latest state
+// of app.
+func assertInvariants(t testing.TB, app *App) {
+	t.Helper()
+
+	ctx := app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()})
+	broken := app.CheckInvariants(ctx)
+	require.Empty(t, broken, strings.Join(broken, "\n"))
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using ignite command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -151,6 +161,7 @@ func TestFullAppSimulation(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(app, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, app)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -195,6 +206,7 @@ func TestAppImportExport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -315,6 +327,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 	err = simtestutil.CheckExportSimulation(bApp, config, simParams)
 	require.NoError(t, err)
 	require.NoError(t, simErr)
+	assertInvariants(t, bApp)
 
 	if config.Commit {
 		simtestutil.PrintStats(db)
@@ -361,6 +374,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		bApp.AppCodec(),
 	)
 	require.NoError(t, err)
+	assertInvariants(t, newApp)
 }
 
 func TestAppStateDeterminism(t *testing.T) {
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/app"
+	"govchain/ipfs"
+	"govchain/ipfs/availability"
+)
+
+const flagFailedOnly = "failed-only"
+
+// availabilityService runs the CID availability checker in the start command
+// when enabled in app.toml. Its query service is registered with the app,
+// before the gRPC server starts, and answers once the checker runs.
+type availabilityService struct {
+	checker atomic.Pointer[availability.Checker]
+	// registerErr is the failure to register the query service, returned
+	// by postSetup since the app creator cannot return it.
+	registerErr error
+}
+
+var _ availability.QueryServer = (*availabilityService)(nil)
+
+// newApp creates the application, with the query service of the checker.
+func (s *availabilityService) newApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	appOpts servertypes.AppOptions,
+) servertypes.Application {
+	application := newApp(logger, db, traceStore, appOpts)
+	if cast.ToBool(appOpts.Get("availability.enable")) {
+		s.registerErr = s.registerQueryServer(application)
+	}
+	return application
+}
+
+// registerQueryServer registers the query service of the checker with the
+// gRPC query router of application.
+func (s *availabilityService) registerQueryServer(application servertypes.Application) error {
+	a, ok := application.(*app.App)
+	if !ok {
+		return fmt.Errorf("cannot register the availability query service with an application of type %T", application)
+	}
+	availability.RegisterQueryServer(a.GRPCQueryRouter(), s)
+	return nil
+}
+
+// postSetup opens the database of the checker in the data directory of the
+// node and runs the checker until the node stops.
+func (s *availabilityService) postSetup(svrCtx *server.Context, clientCtx client.Context, ctx context.Context, g *errgroup.Group) error {
+	cfg, err := availability.ReadConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+	if !cfg.Enable {
+		return nil
+	}
+	if s.registerErr != nil {
+		return s.registerErr
+	}
+	store, err := ipfs.NewBlockStore(ipfs.ReadConfig(svrCtx.Viper))
+	if err != nil {
+		return err
+	}
+	db, err := dbm.NewDB("availability", server.GetAppDBBackend(svrCtx.Viper), filepath.Join(svrCtx.Config.RootDir, "data"))
+	if err != nil {
+		return fmt.Errorf("opening the availability database: %w", err)
+	}
+
+	checker := availability.NewChecker(db, store, cfg, svrCtx.Logger.With("module", "availability"))
+	s.checker.Store(checker)
+	g.Go(func() error {
+		defer db.Close()
+		return checker.Run(ctx, clientCtx)
+	})
+	return nil
+}
+
+func (s *availabilityService) CheckResult(ctx context.Context, req *availability.QueryCheckResultRequest) (*availability.QueryCheckResultResponse, error) {
+	checker := s.checker.Load()
+	if checker == nil {
+		return nil, status.Error(codes.Unavailable, "the availability checker is not running")
+	}
+	return checker.CheckResult(ctx, req)
+}
+
+func (s *availabilityService) CheckResults(ctx context.Context, req *availability.QueryCheckResultsRequest) (*availability.QueryCheckResultsResponse, error) {
+	checker := s.checker.Load()
+	if checker == nil {
+		return nil, status.Error(codes.Unavailable, "the availability checker is not running")
+	}
+	return checker.CheckResults(ctx, req)
+}
+
+// AvailabilityCmd returns the commands querying the results of the CID
+// availability checker of a node.
+func AvailabilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "availability",
+		Short: "Querying the CID availability checks of a node",
+		Long: `Querying the results of the CID availability checker of a node. The checks are
+made off consensus, so only the nodes running the checker answer, each with
+its own results.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		availabilityResultCmd(),
+		availabilityResultsCmd(),
+	)
+
+	return cmd
+}
+
+func availabilityResultCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "result [entry-id]",
+		Short:   "Query the result of the last availability check of an entry",
+		Example: "availability result 42",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid entry id %q: %w", args[0], err)
+			}
+
+			res, err := availability.NewQueryClient(clientCtx).CheckResult(cmd.Context(), &availability.QueryCheckResultRequest{EntryId: id})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func availabilityResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "results",
+		Short:   "Query the results of the availability checks, by entry id",
+		Example: "availability results --failed-only",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			failedOnly, err := cmd.Flags().GetBool(flagFailedOnly)
+			if err != nil {
+				return err
+			}
+
+			res, err := availability.NewQueryClient(clientCtx).CheckResults(cmd.Context(), &availability.QueryCheckResultsRequest{
+				Pagination: pageReq,
+				FailedOnly: failedOnly,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(flagFailedOnly, false, "List only the entries whose content could not be retrieved")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "results")
+	return cmd
+}
@@ -40,10 +40,15 @@ func initRootCmd(
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		CheckInvariantsCmd(),
+		VerifyBundleCmd(),
 	)
 
-	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{
-		AddFlags: addModuleInitFlags,
+	// the CID availability checker runs with the node, when enabled
+	availabilitySvc := &availabilityService{}
+	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, availabilitySvc.newApp, appExport, server.StartCmdOptions{
+		AddFlags:  addModuleInitFlags,
+		PostSetup: availabilitySvc.postSetup,
 	})
 
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
@@ -78,6 +83,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
+		AvailabilityCmd(),
 	)
 
 	return cmd
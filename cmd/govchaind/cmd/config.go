@@ -3,6 +3,9 @@ package cmd
 import (
 	cmtcfg "github.com/cometbft/cometbft/config"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+
+	"govchain/ipfs"
+	"govchain/ipfs/availability"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -20,9 +23,13 @@ func initCometBFTConfig() *cmtcfg.Config {
 // initAppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func initAppConfig() (string, interface{}) {
-	// The following code snippet is just for reference.
+	// CustomAppConfig adds the IPFS block store and the CID availability
+	// checker to the server config.
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
+
+		IPFS         ipfs.Config         `mapstructure:"ipfs"`
+		Availability availability.Config `mapstructure:"availability"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -43,10 +50,12 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.MinGasPrices = "0stake"
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:       *srvCfg,
+		IPFS:         ipfs.DefaultConfig(),
+		Availability: availability.DefaultConfig(),
 	}
 
-	customAppTemplate := serverconfig.DefaultConfigTemplate
+	customAppTemplate := serverconfig.DefaultConfigTemplate + ipfs.ConfigTemplate + availability.ConfigTemplate
 	// Edit the default template file
 	//
 	// customAppTemplate := serverconfig.DefaultConfigTemplate + `
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"govchain/app"
+)
+
+const flagAppDBBackend = "app-db-backend"
+
+// CheckInvariantsCmd runs the module invariants against the application state
+// stored in the node home. The node must be stopped, since the command opens
+// the application database.
+func CheckInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants [module]...",
+		Short: "Check the module invariants against the latest stored application state",
+		Long: `Check the module invariants against the latest stored application state.
+
+The command opens the application database of the node home, so the node must
+be stopped. It prints the result of every invariant of the given modules, or of
+all modules, and exits with an error if any invariant is broken.`,
+		Example:      "check-invariants datasets --home ~/.govchain",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			a := app.New(log.NewNopLogger(), db, nil, true, vp)
+			if a.LastBlockHeight() == 0 {
+				return fmt.Errorf("no application state found in %s", home)
+			}
+			ctx := a.NewContextLegacy(true, cmtproto.Header{Height: a.LastBlockHeight()})
+
+			var checked, broken int
+			for _, route := range a.Invariants() {
+				if len(args) > 0 && !slices.Contains(args, route.ModuleName) {
+					continue
+				}
+				checked++
+				if msg, isBroken := route.Invariant(ctx); isBroken {
+					broken++
+					cmd.Printf("BROKEN %s\n%s", route.FullRoute(), msg)
+					continue
+				}
+				cmd.Printf("ok     %s\n", route.FullRoute())
+			}
+
+			switch {
+			case checked == 0:
+				return fmt.Errorf("no invariants registered for modules %v", args)
+			case broken > 0:
+				return fmt.Errorf("%d of %d invariants broken at height %d", broken, checked, a.LastBlockHeight())
+			}
+			cmd.Printf("%d invariants hold at height %d\n", checked, a.LastBlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagAppDBBackend, "", "The type of database of the application")
+
+	return cmd
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"govchain/x/datasets/client/cli"
+	"govchain/x/datasets/types"
+)
+
+// VerifyBundleCmd verifies offline a proof bundle printed by the prove-entry
+// query, and optionally a dataset file against the checksum of the proven
+// entry. It needs neither a node nor a network connection.
+func VerifyBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-bundle [bundle-file] [dataset-file]",
+		Short: "Verify offline an entry proof bundle and optionally a dataset file",
+		Long: `Verify offline an entry proof bundle, as printed by "query datasets prove-entry".
+
+The whole chain of trust is checked: the commit signatures of the header
+against the validator set of the bundle, which must be the one named by the
+header, the app hash of the header against the proven state, and the entry
+against the app hash. When a dataset file is given, its SHA-256 digest must be
+the checksum of the proven entry.
+
+The validator set and the header are read from the bundle, so they must be
+anchored on a trust root given in hex: --trusted-validators-hash, the hash of
+the validator set of the chain obtained from a trusted source, or
+--trusted-app-hash, the app hash the header must commit to. The command fails
+without one.`,
+		Example:      "verify-bundle entry-42.proof.json rainfall-2024.csv --chain-id govchain --trusted-validators-hash 5E2B...07",
+		Args:         cobra.RangeArgs(1, 2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proof types.EntryStateProof
+			if err := cmtjson.Unmarshal(bz, &proof); err != nil {
+				return fmt.Errorf("invalid proof bundle %s: %w", args[0], err)
+			}
+
+			trustedAppHash, err := cli.HexFlag(cmd, cli.FlagTrustedAppHash)
+			if err != nil {
+				return err
+			}
+			trustedValidatorsHash, err := cli.HexFlag(cmd, cli.FlagTrustedValidatorsHash)
+			if err != nil {
+				return err
+			}
+			chainID, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+
+			if chainID != "" && proof.ChainID != chainID {
+				return fmt.Errorf("bundle is for chain %q, not %q", proof.ChainID, chainID)
+			}
+			if err := proof.VerifyTrusted(trustedAppHash, trustedValidatorsHash); err != nil {
+				return err
+			}
+
+			entry, err := proof.DecodeEntry()
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "chain id:        %s\n", proof.ChainID)
+			fmt.Fprintf(out, "state height:    %d\n", proof.Height)
+			fmt.Fprintf(out, "header hash:     %X\n", proof.SignedHeader.Hash().Bytes())
+			fmt.Fprintf(out, "header time:     %s\n", proof.SignedHeader.Time.UTC())
+			fmt.Fprintf(out, "validators hash: %X\n", proof.SignedHeader.ValidatorsHash.Bytes())
+			fmt.Fprintf(out, "app hash:        %X\n", proof.AppHash())
+			fmt.Fprintf(out, "entry:           %d %q (%s)\n", entry.Id, entry.Title, entry.Agency)
+			fmt.Fprintf(out, "ipfs cid:        %s\n", entry.IpfsCid)
+			fmt.Fprintf(out, "checksum:        %s\n", entry.ChecksumSha_256)
+			fmt.Fprintln(out, "proof:           OK")
+
+			if len(args) < 2 {
+				return nil
+			}
+			f, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := entry.VerifyChecksum(f); err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "file checksum:   OK")
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "Chain id the bundle must belong to")
+	cmd.Flags().String(cli.FlagTrustedAppHash, "", "App hash, in hex, the header of the bundle must commit to")
+	cmd.Flags().String(cli.FlagTrustedValidatorsHash, "", "Validators hash, in hex, the header of the bundle must name")
+	cmd.MarkFlagsOneRequired(cli.FlagTrustedAppHash, cli.FlagTrustedValidatorsHash)
+
+	return cmd
+}
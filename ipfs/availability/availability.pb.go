@@ -0,0 +1,1558 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: govchain/ipfs/availability/v1/availability.proto
+
+package availability
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckResult is the result of the last check of the retrievability of the
+// content of an entry.
+type CheckResult struct {
+	EntryId uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Cid     string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	// created_height is the height the entry was created at.
+	CreatedHeight int64 `protobuf:"varint,3,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// retrievable is set when the root block of the content and the sampled
+	// leaves, through the blocks above them, were fetched and matched the CID.
+	Retrievable bool `protobuf:"varint,4,opt,name=retrievable,proto3" json:"retrievable,omitempty"`
+	// error is the reason the content could not be retrieved.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// attempts counts the checks of the entry, failed checks being retried up
+	// to the configured maximum.
+	Attempts  uint32    `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CheckedAt time.Time `protobuf:"bytes,7,opt,name=checked_at,json=checkedAt,proto3,stdtime" json:"checked_at"`
+	// revision is the revision of the entry that set the checked CID.
+	Revision uint64 `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *CheckResult) Reset()         { *m = CheckResult{} }
+func (m *CheckResult) String() string { return proto.CompactTextString(m) }
+func (*CheckResult) ProtoMessage()    {}
+func (*CheckResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f19aa9397f91b1, []int{0}
+}
+func (m *CheckResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckResult.Merge(m, src)
+}
+func (m *CheckResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckResult proto.InternalMessageInfo
+
+func (m *CheckResult) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *CheckResult) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *CheckResult) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *CheckResult) GetRetrievable() bool {
+	if m != nil {
+		return m.Retrievable
+	}
+	return false
+}
+
+func (m *CheckResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CheckResult) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *CheckResult) GetCheckedAt() time.Time {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return time.Time{}
+}
+
+func (m *CheckResult) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// QueryCheckResultRequest defines the QueryCheckResultRequest message.
+type QueryCheckResultRequest struct {
+	EntryId uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+}
+
+func (m *QueryCheckResultRequest) Reset()         { *m = QueryCheckResultRequest{} }
+func (m *QueryCheckResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckResultRequest) ProtoMessage()    {}
+func (*QueryCheckResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f19aa9397f91b1, []int{1}
+}
+func (m *QueryCheckResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckResultRequest.Merge(m, src)
+}
+func (m *QueryCheckResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckResultRequest proto.InternalMessageInfo
+
+func (m *QueryCheckResultRequest) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+// QueryCheckResultResponse defines the QueryCheckResultResponse message.
+type QueryCheckResultResponse struct {
+	Result CheckResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result"`
+}
+
+func (m *QueryCheckResultResponse) Reset()         { *m = QueryCheckResultResponse{} }
+func (m *QueryCheckResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckResultResponse) ProtoMessage()    {}
+func (*QueryCheckResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f19aa9397f91b1, []int{2}
+}
+func (m *QueryCheckResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckResultResponse.Merge(m, src)
+}
+func (m *QueryCheckResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckResultResponse proto.InternalMessageInfo
+
+func (m *QueryCheckResultResponse) GetResult() CheckResult {
+	if m != nil {
+		return m.Result
+	}
+	return CheckResult{}
+}
+
+// QueryCheckResultsRequest defines the QueryCheckResultsRequest message.
+type QueryCheckResultsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// failed_only lists the entries whose content could not be retrieved.
+	FailedOnly bool `protobuf:"varint,2,opt,name=failed_only,json=failedOnly,proto3" json:"failed_only,omitempty"`
+}
+
+func (m *QueryCheckResultsRequest) Reset()         { *m = QueryCheckResultsRequest{} }
+func (m *QueryCheckResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckResultsRequest) ProtoMessage()    {}
+func (*QueryCheckResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f19aa9397f91b1, []int{3}
+}
+func (m *QueryCheckResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckResultsRequest.Merge(m, src)
+}
+func (m *QueryCheckResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckResultsRequest proto.InternalMessageInfo
+
+func (m *QueryCheckResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryCheckResultsRequest) GetFailedOnly() bool {
+	if m != nil {
+		return m.FailedOnly
+	}
+	return false
+}
+
+// QueryCheckResultsResponse defines the QueryCheckResultsResponse message.
+type QueryCheckResultsResponse struct {
+	Results    []CheckResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCheckResultsResponse) Reset()         { *m = QueryCheckResultsResponse{} }
+func (m *QueryCheckResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckResultsResponse) ProtoMessage()    {}
+func (*QueryCheckResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14f19aa9397f91b1, []int{4}
+}
+func (m *QueryCheckResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckResultsResponse.Merge(m, src)
+}
+func (m *QueryCheckResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckResultsResponse proto.InternalMessageInfo
+
+func (m *QueryCheckResultsResponse) GetResults() []CheckResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryCheckResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CheckResult)(nil), "govchain.ipfs.availability.v1.CheckResult")
+	proto.RegisterType((*QueryCheckResultRequest)(nil), "govchain.ipfs.availability.v1.QueryCheckResultRequest")
+	proto.RegisterType((*QueryCheckResultResponse)(nil), "govchain.ipfs.availability.v1.QueryCheckResultResponse")
+	proto.RegisterType((*QueryCheckResultsRequest)(nil), "govchain.ipfs.availability.v1.QueryCheckResultsRequest")
+	proto.RegisterType((*QueryCheckResultsResponse)(nil), "govchain.ipfs.availability.v1.QueryCheckResultsResponse")
+}
+
+func init() {
+	proto.RegisterFile("govchain/ipfs/availability/v1/availability.proto", fileDescriptor_14f19aa9397f91b1)
+}
+
+var fileDescriptor_14f19aa9397f91b1 = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xdb, 0xad, 0xcd, 0x5c, 0x86, 0x90, 0x35, 0x89, 0x2c, 0x12, 0x69, 0x54, 0x09, 0x88,
+	0x76, 0x70, 0x68, 0x99, 0x80, 0x2b, 0x9d, 0x04, 0x83, 0x0b, 0x10, 0x71, 0xe2, 0x52, 0x39, 0x89,
+	0x9b, 0x5a, 0xa4, 0x71, 0x16, 0xbb, 0x91, 0x7a, 0x41, 0x42, 0x1c, 0xb9, 0xec, 0xc4, 0xef, 0xe0,
+	0x67, 0xec, 0xb8, 0x23, 0x27, 0x40, 0xed, 0x1f, 0x41, 0x71, 0x92, 0x91, 0x6a, 0x6c, 0x53, 0xb9,
+	0xf9, 0xfb, 0xe4, 0xf7, 0xfc, 0xde, 0xf7, 0xbd, 0x04, 0x3e, 0x0a, 0x79, 0xe6, 0x4f, 0x09, 0x8b,
+	0x1d, 0x96, 0x4c, 0x84, 0x43, 0x32, 0xc2, 0x22, 0xe2, 0xb1, 0x88, 0xc9, 0x85, 0x93, 0x0d, 0xd6,
+	0x6a, 0x9c, 0xa4, 0x5c, 0x72, 0x74, 0xaf, 0x42, 0xe0, 0x1c, 0x81, 0xd7, 0x6e, 0x64, 0x03, 0xe3,
+	0xc0, 0xe7, 0x62, 0xc6, 0x85, 0xe3, 0x11, 0x41, 0x9d, 0x93, 0x39, 0x4d, 0x73, 0x1e, 0x8f, 0x4a,
+	0x32, 0x70, 0x12, 0x12, 0xb2, 0x98, 0x48, 0xc6, 0xe3, 0x82, 0xca, 0xd8, 0x0b, 0x79, 0xc8, 0xd5,
+	0xd1, 0xc9, 0x4f, 0x65, 0xb7, 0x17, 0x72, 0x1e, 0x46, 0xd4, 0x51, 0x95, 0x37, 0x9f, 0x38, 0x92,
+	0xcd, 0xa8, 0x90, 0x64, 0x96, 0x14, 0x17, 0xfa, 0xdf, 0x9a, 0xb0, 0x7b, 0x34, 0xa5, 0xfe, 0x47,
+	0x97, 0x8a, 0x79, 0x24, 0xd1, 0x3e, 0xd4, 0x68, 0x2c, 0xd3, 0xc5, 0x98, 0x05, 0x3a, 0xb0, 0x80,
+	0xbd, 0xe5, 0x76, 0x54, 0xfd, 0x2a, 0x40, 0x77, 0x60, 0xcb, 0x67, 0x81, 0xde, 0xb4, 0x80, 0xbd,
+	0xe3, 0xe6, 0x47, 0x74, 0x1f, 0xde, 0xf6, 0x53, 0x4a, 0x24, 0x0d, 0xc6, 0x53, 0xca, 0xc2, 0xa9,
+	0xd4, 0x5b, 0x16, 0xb0, 0x5b, 0xee, 0x6e, 0xd9, 0x3d, 0x56, 0x4d, 0x64, 0xc1, 0x6e, 0x4a, 0x65,
+	0xca, 0x68, 0x46, 0xbc, 0x88, 0xea, 0x5b, 0x16, 0xb0, 0x35, 0xb7, 0xde, 0x42, 0x7b, 0x70, 0x9b,
+	0xa6, 0x29, 0x4f, 0xf5, 0x6d, 0x45, 0x5e, 0x14, 0xc8, 0x80, 0x1a, 0x91, 0x92, 0xce, 0x12, 0x29,
+	0xf4, 0xb6, 0x05, 0xec, 0x5d, 0xf7, 0xa2, 0x46, 0x47, 0x10, 0xfa, 0xb9, 0x6c, 0x1a, 0x8c, 0x89,
+	0xd4, 0x3b, 0x16, 0xb0, 0xbb, 0x43, 0x03, 0x17, 0x6e, 0x71, 0xe5, 0x16, 0xbf, 0xaf, 0xdc, 0x8e,
+	0xb4, 0xb3, 0x9f, 0xbd, 0xc6, 0xe9, 0xaf, 0x1e, 0x70, 0x77, 0x4a, 0xdc, 0x73, 0x99, 0x3f, 0x90,
+	0xd2, 0x8c, 0x09, 0xc6, 0x63, 0x5d, 0x53, 0x66, 0x2f, 0xea, 0xfe, 0x21, 0xbc, 0xfb, 0x2e, 0x9f,
+	0x78, 0x6d, 0x38, 0x2e, 0x3d, 0x99, 0x53, 0x71, 0xdd, 0x8c, 0xfa, 0x01, 0xd4, 0x2f, 0xa3, 0x44,
+	0xc2, 0x63, 0x41, 0xd1, 0x31, 0x6c, 0xa7, 0xaa, 0xa3, 0x40, 0xdd, 0xe1, 0x01, 0xbe, 0x76, 0xfb,
+	0xb8, 0xc6, 0x31, 0xda, 0xca, 0xe5, 0xbb, 0x25, 0xbe, 0xff, 0x05, 0x5c, 0x7e, 0x46, 0x54, 0xea,
+	0x5e, 0x40, 0xf8, 0x37, 0x1c, 0xe5, 0x53, 0x0f, 0x70, 0x91, 0x24, 0x9c, 0x27, 0x09, 0xab, 0x24,
+	0xe1, 0x32, 0x49, 0xf8, 0x2d, 0x09, 0x69, 0x89, 0x75, 0x6b, 0x48, 0xd4, 0x83, 0xdd, 0x09, 0x61,
+	0x11, 0x0d, 0xc6, 0x3c, 0x8e, 0x16, 0x6a, 0xed, 0x9a, 0x0b, 0x8b, 0xd6, 0x9b, 0x38, 0x5a, 0xf4,
+	0xbf, 0x03, 0xb8, 0xff, 0x0f, 0x15, 0xa5, 0xdb, 0xd7, 0xb0, 0x53, 0xa8, 0x15, 0x3a, 0xb0, 0x5a,
+	0xff, 0x65, 0xb7, 0x22, 0x40, 0x2f, 0xd7, 0x2c, 0x35, 0x95, 0xa5, 0x87, 0x37, 0x5a, 0x2a, 0x84,
+	0xd4, 0x3d, 0x0d, 0xbf, 0x36, 0xe1, 0xb6, 0x92, 0x8c, 0x3e, 0xad, 0xc7, 0xfe, 0xc9, 0x0d, 0xe2,
+	0xae, 0x88, 0x82, 0xf1, 0x74, 0x63, 0x5c, 0x39, 0x9e, 0xcf, 0x00, 0xde, 0xaa, 0xcf, 0x0d, 0x6d,
+	0xca, 0x54, 0xed, 0xdb, 0x78, 0xb6, 0x39, 0xb0, 0xd0, 0x30, 0x3a, 0x3c, 0x5b, 0x9a, 0xe0, 0x7c,
+	0x69, 0x82, 0xdf, 0x4b, 0x13, 0x9c, 0xae, 0xcc, 0xc6, 0xf9, 0xca, 0x6c, 0xfc, 0x58, 0x99, 0x8d,
+	0x0f, 0xc6, 0xd5, 0x7f, 0x32, 0xaf, 0xad, 0xbe, 0xae, 0xc7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0x5b, 0x59, 0x81, 0xf0, 0xee, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// CheckResult queries the result of the last check of an entry.
+	CheckResult(ctx context.Context, in *QueryCheckResultRequest, opts ...grpc.CallOption) (*QueryCheckResultResponse, error)
+	// CheckResults queries the results of the checks, by entry id.
+	CheckResults(ctx context.Context, in *QueryCheckResultsRequest, opts ...grpc.CallOption) (*QueryCheckResultsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) CheckResult(ctx context.Context, in *QueryCheckResultRequest, opts ...grpc.CallOption) (*QueryCheckResultResponse, error) {
+	out := new(QueryCheckResultResponse)
+	err := c.cc.Invoke(ctx, "/govchain.ipfs.availability.v1.Query/CheckResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckResults(ctx context.Context, in *QueryCheckResultsRequest, opts ...grpc.CallOption) (*QueryCheckResultsResponse, error) {
+	out := new(QueryCheckResultsResponse)
+	err := c.cc.Invoke(ctx, "/govchain.ipfs.availability.v1.Query/CheckResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// CheckResult queries the result of the last check of an entry.
+	CheckResult(context.Context, *QueryCheckResultRequest) (*QueryCheckResultResponse, error)
+	// CheckResults queries the results of the checks, by entry id.
+	CheckResults(context.Context, *QueryCheckResultsRequest) (*QueryCheckResultsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) CheckResult(ctx context.Context, req *QueryCheckResultRequest) (*QueryCheckResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckResult not implemented")
+}
+func (*UnimplementedQueryServer) CheckResults(ctx context.Context, req *QueryCheckResultsRequest) (*QueryCheckResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckResults not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_CheckResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.ipfs.availability.v1.Query/CheckResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckResult(ctx, req.(*QueryCheckResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.ipfs.availability.v1.Query/CheckResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckResults(ctx, req.(*QueryCheckResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "govchain.ipfs.availability.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckResult",
+			Handler:    _Query_CheckResult_Handler,
+		},
+		{
+			MethodName: "CheckResults",
+			Handler:    _Query_CheckResults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "govchain/ipfs/availability/v1/availability.proto",
+}
+
+func (m *CheckResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintAvailability(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CheckedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CheckedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAvailability(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Attempts != 0 {
+		i = encodeVarintAvailability(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAvailability(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Retrievable {
+		i--
+		if m.Retrievable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintAvailability(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintAvailability(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintAvailability(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		i = encodeVarintAvailability(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAvailability(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedOnly {
+		i--
+		if m.FailedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAvailability(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAvailability(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAvailability(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAvailability(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAvailability(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovAvailability(uint64(m.EntryId))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovAvailability(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovAvailability(uint64(m.CreatedHeight))
+	}
+	if m.Retrievable {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAvailability(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovAvailability(uint64(m.Attempts))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CheckedAt)
+	n += 1 + l + sovAvailability(uint64(l))
+	if m.Revision != 0 {
+		n += 1 + sovAvailability(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *QueryCheckResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovAvailability(uint64(m.EntryId))
+	}
+	return n
+}
+
+func (m *QueryCheckResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Result.Size()
+	n += 1 + l + sovAvailability(uint64(l))
+	return n
+}
+
+func (m *QueryCheckResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovAvailability(uint64(l))
+	}
+	if m.FailedOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCheckResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovAvailability(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovAvailability(uint64(l))
+	}
+	return n
+}
+
+func sovAvailability(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAvailability(x uint64) (n int) {
+	return sovAvailability(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAvailability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retrievable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retrievable = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CheckedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAvailability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAvailability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAvailability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAvailability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAvailability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAvailability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAvailability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAvailability
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, CheckResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAvailability(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAvailability
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAvailability(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAvailability
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAvailability
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAvailability
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAvailability
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAvailability
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAvailability        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAvailability          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAvailability = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,318 @@
+// Package availability checks off consensus that the content of the newly
+// created or updated entries can be retrieved from IPFS, records the results in a node
+// local database and serves them over gRPC.
+package availability
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ipfs/go-cid"
+
+	"govchain/ipfs"
+	"govchain/x/datasets/types"
+)
+
+// Keys of the database of the checker.
+var (
+	// resultPrefix prefixes the results by big endian entry id.
+	resultPrefix = []byte("result/")
+	// retryPrefix prefixes the failed checks to retry by big endian due time
+	// in unix nanoseconds, then entry id.
+	retryPrefix = []byte("retry/")
+	// cursorKey holds the lowest update height of the entries to check.
+	cursorKey = []byte("cursor")
+)
+
+// pollPageSize is the number of entries listed per query.
+const pollPageSize = 100
+
+// Checker checks that the content of the newly created entries, and of the
+// entries whose CID was updated, can be fetched and matches their CID: the
+// root block, and the leaves holding the first and last bytes and
+// Config.SampleLeaves random bytes of UnixFS files through the blocks above
+// them. The failed checks are retried up to Config.MaxAttempts times.
+type Checker struct {
+	db      dbm.DB
+	fetcher ipfs.Fetcher
+	cfg     Config
+	logger  log.Logger
+}
+
+var _ QueryServer = (*Checker)(nil)
+
+// NewChecker returns a checker recording its results in db.
+func NewChecker(db dbm.DB, fetcher ipfs.Fetcher, cfg Config, logger log.Logger) *Checker {
+	return &Checker{db: db, fetcher: fetcher, cfg: cfg, logger: logger}
+}
+
+// Run polls the entries created or updated from the height following the
+// latest block, on the first run, until ctx is done. The entries are listed through
+// clientCtx, which must have a node client.
+func (c *Checker) Run(ctx context.Context, clientCtx client.Context) error {
+	if clientCtx.Client == nil {
+		return errors.New("the availability checker requires the gRPC server or the API to be enabled")
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+
+	ticker := time.NewTicker(c.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if err := c.poll(ctx, clientCtx, queryClient); err != nil && ctx.Err() == nil {
+			c.logger.Error("checking the availability of new entries", "err", err)
+		}
+	}
+}
+
+// poll polls the entries once the node has committed a block, setting the
+// cursor to the height following the latest block when unset.
+func (c *Checker) poll(ctx context.Context, clientCtx client.Context, queryClient types.QueryClient) error {
+	status, err := clientCtx.Client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if status.SyncInfo.LatestBlockHeight == 0 {
+		return nil
+	}
+	if err := c.StartAt(status.SyncInfo.LatestBlockHeight + 1); err != nil {
+		return err
+	}
+	return c.Poll(ctx, queryClient)
+}
+
+// StartAt sets the lowest update height of the entries to check, unless the
+// checker already ran.
+func (c *Checker) StartAt(height int64) error {
+	found, err := c.db.Has(cursorKey)
+	if err != nil || found {
+		return err
+	}
+	return c.db.SetSync(cursorKey, binary.BigEndian.AppendUint64(nil, uint64(height)))
+}
+
+// Poll retries the failed checks that are due, then checks the entries created
+// since the last poll and those whose CID was updated since. The entries are
+// listed by the height of their last modification, which a creation sets as
+// well.
+func (c *Checker) Poll(ctx context.Context, queryClient types.QueryClient) error {
+	if err := c.retry(ctx); err != nil {
+		return err
+	}
+
+	from, err := c.cursor()
+	if err != nil {
+		return err
+	}
+
+	// Each listing reads a committed state, which holds all the changes of
+	// its blocks, so that no entry is modified later at a height listed.
+	next := from
+	var key []byte
+	for {
+		res, err := queryClient.ListEntry(ctx, &types.QueryAllEntryRequest{
+			Pagination: &query.PageRequest{Key: key, Limit: pollPageSize},
+			Filter:     &types.EntryFilter{MinUpdatedHeight: from},
+			OrderBy:    types.ENTRY_ORDER_BY_UPDATED,
+		})
+		if err != nil {
+			return fmt.Errorf("listing entries: %w", err)
+		}
+		for _, entry := range res.Entry {
+			// The updates leaving the CID unchanged need no new check.
+			prev, found, err := c.result(entry.Id)
+			if err != nil {
+				return err
+			}
+			if !found || prev.Cid != entry.IpfsCid {
+				if err := c.check(ctx, CheckResult{EntryId: entry.Id, Cid: entry.IpfsCid, CreatedHeight: entry.CreatedHeight, Revision: entry.Revision}, nil); err != nil {
+					return err
+				}
+			}
+			next = max(next, entry.UpdatedHeight+1)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		key = res.Pagination.NextKey
+	}
+	if next == from {
+		return nil
+	}
+	return c.db.SetSync(cursorKey, binary.BigEndian.AppendUint64(nil, uint64(next)))
+}
+
+// retry checks again the entries whose retry is due. The retries left by a
+// check that a new CID of the entry since passed are dropped.
+func (c *Checker) retry(ctx context.Context) error {
+	end := append(append([]byte(nil), retryPrefix...), binary.BigEndian.AppendUint64(nil, uint64(time.Now().UnixNano())+1)...)
+	iter, err := c.db.Iterator(retryPrefix, end)
+	if err != nil {
+		return err
+	}
+	var due [][]byte
+	for ; iter.Valid(); iter.Next() {
+		due = append(due, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		result, found, err := c.result(id)
+		if err != nil {
+			return err
+		}
+		if !found || result.Retrievable {
+			if err := c.db.Delete(key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.check(ctx, result, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check fetches the content of the entry of prev, whose attempts count the
+// previous checks, and records the result in place of the retry key of the
+// check, if any. Nothing is recorded when ctx is done.
+func (c *Checker) check(ctx context.Context, prev CheckResult, retryKey []byte) error {
+	result := CheckResult{
+		EntryId:       prev.EntryId,
+		Cid:           prev.Cid,
+		CreatedHeight: prev.CreatedHeight,
+		Revision:      prev.Revision,
+		Attempts:      prev.Attempts + 1,
+	}
+	root, err := cid.Decode(prev.Cid)
+	if err == nil {
+		fetchCtx, cancel := context.WithTimeout(ctx, c.cfg.CheckTimeout)
+		err = c.fetchSample(fetchCtx, root)
+		cancel()
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	result.CheckedAt = time.Now().UTC()
+	if err != nil {
+		result.Error = err.Error()
+		c.logger.Info("entry content not retrievable", "entry", result.EntryId, "cid", result.Cid, "attempts", result.Attempts, "err", err)
+	} else {
+		result.Retrievable = true
+	}
+
+	bz, err := result.Marshal()
+	if err != nil {
+		return err
+	}
+	batch := c.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(resultKey(result.EntryId), bz); err != nil {
+		return err
+	}
+	if retryKey != nil {
+		if err := batch.Delete(retryKey); err != nil {
+			return err
+		}
+	}
+	if !result.Retrievable && result.Attempts < c.cfg.MaxAttempts {
+		due := result.CheckedAt.Add(c.cfg.RetryInterval)
+		if err := batch.Set(retryQueueKey(due, result.EntryId), []byte{}); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// fetchSample fetches the root block of the content of root and, for a UnixFS
+// file, the leaves holding its first and last bytes and c.cfg.SampleLeaves
+// random bytes, through the blocks above them, so that content whose DAG is
+// only partly retrievable fails. The content of other codecs is only checked
+// by its root block.
+func (c *Checker) fetchSample(ctx context.Context, root cid.Cid) error {
+	// The blocks above the leaves are shared by their paths, so they are
+	// only fetched once.
+	blocks := make(map[cid.Cid][]byte)
+	get := func(k cid.Cid) ([]byte, error) {
+		if block, ok := blocks[k]; ok {
+			return block, nil
+		}
+		block, err := c.fetcher.Get(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		blocks[k] = block
+		return block, nil
+	}
+
+	block, err := get(root)
+	if err != nil {
+		return err
+	}
+	if root.Type() != cid.DagProtobuf && root.Type() != cid.Raw {
+		return nil
+	}
+	size, err := types.UnixFSContentSize(root, block)
+	if err != nil || size == 0 {
+		return err
+	}
+
+	offsets := []uint64{0, size - 1}
+	for range c.cfg.SampleLeaves {
+		offsets = append(offsets, rand.Uint64N(size))
+	}
+	for _, offset := range offsets {
+		if _, _, err := types.ChunkPath(get, root, offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// result returns the recorded result of the entry with the given id.
+func (c *Checker) result(id uint64) (CheckResult, bool, error) {
+	bz, err := c.db.Get(resultKey(id))
+	if err != nil || bz == nil {
+		return CheckResult{}, false, err
+	}
+	var result CheckResult
+	if err := result.Unmarshal(bz); err != nil {
+		return CheckResult{}, false, err
+	}
+	return result, true, nil
+}
+
+// cursor returns the lowest update height of the entries to check.
+func (c *Checker) cursor() (int64, error) {
+	bz, err := c.db.Get(cursorKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+func resultKey(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte(nil), resultPrefix...), id)
+}
+
+func retryQueueKey(due time.Time, id uint64) []byte {
+	key := binary.BigEndian.AppendUint64(append([]byte(nil), retryPrefix...), uint64(due.UnixNano()))
+	return binary.BigEndian.AppendUint64(key, id)
+}
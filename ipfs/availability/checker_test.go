@@ -0,0 +1,229 @@
+package availability_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"slices"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"govchain/ipfs"
+	"govchain/ipfs/availability"
+	"govchain/x/datasets/types"
+)
+
+// fakeQueryClient lists its entries as the datasets module does when filtered
+// by update height and ordered by update.
+type fakeQueryClient struct {
+	types.QueryClient
+	entries []types.Entry
+	calls   int
+}
+
+func (q *fakeQueryClient) ListEntry(_ context.Context, req *types.QueryAllEntryRequest, _ ...grpc.CallOption) (*types.QueryAllEntryResponse, error) {
+	q.calls++
+	var matching []types.Entry
+	for _, entry := range q.entries {
+		if entry.UpdatedHeight >= req.Filter.MinUpdatedHeight {
+			matching = append(matching, entry)
+		}
+	}
+	slices.SortStableFunc(matching, func(a, b types.Entry) int {
+		return int(a.UpdatedHeight - b.UpdatedHeight)
+	})
+	var start uint64
+	if len(req.Pagination.Key) > 0 {
+		start = binary.BigEndian.Uint64(req.Pagination.Key)
+	}
+	end := min(start+req.Pagination.Limit, uint64(len(matching)))
+	res := &types.QueryAllEntryResponse{Entry: matching[start:end], Pagination: &query.PageResponse{}}
+	if end < uint64(len(matching)) {
+		res.Pagination.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+	return res, nil
+}
+
+func (q *fakeQueryClient) create(height int64, c cid.Cid) {
+	q.entries = append(q.entries, types.Entry{Id: uint64(len(q.entries)), IpfsCid: c.String(), Revision: 1, CreatedHeight: height, UpdatedHeight: height})
+}
+
+func (q *fakeQueryClient) update(id uint64, height int64, c cid.Cid) {
+	entry := &q.entries[id]
+	entry.IpfsCid = c.String()
+	entry.Revision++
+	entry.UpdatedHeight = height
+}
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	store := ipfs.NewMemStore()
+	cfg := availability.DefaultConfig()
+	cfg.RetryInterval = 0
+	cfg.MaxAttempts = 2
+	checker := availability.NewChecker(dbm.NewMemDB(), store, cfg, log.NewNopLogger())
+	queryClient := &fakeQueryClient{}
+
+	pinned, err := ipfs.Add(ctx, store, bytes.NewReader([]byte("hello world\n")))
+	require.NoError(t, err)
+	// the content of missing is only added later
+	other := ipfs.NewMemStore()
+	missing, err := ipfs.Add(ctx, other, bytes.NewReader([]byte("lost")))
+	require.NoError(t, err)
+
+	// the entries created before the checker started are not checked
+	queryClient.create(4, pinned)
+	require.NoError(t, checker.StartAt(5))
+	require.NoError(t, checker.StartAt(1))
+	queryClient.create(5, pinned)
+	queryClient.create(5, missing)
+	queryClient.create(6, cid.Undef)
+	require.NoError(t, checker.Poll(ctx, queryClient))
+
+	result := func(id uint64) availability.CheckResult {
+		t.Helper()
+		res, err := checker.CheckResult(ctx, &availability.QueryCheckResultRequest{EntryId: id})
+		require.NoError(t, err)
+		return res.Result
+	}
+	_, err = checker.CheckResult(ctx, &availability.QueryCheckResultRequest{EntryId: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.True(t, result(1).Retrievable)
+	require.Equal(t, uint32(1), result(1).Attempts)
+	require.Equal(t, int64(5), result(1).CreatedHeight)
+	require.False(t, result(2).Retrievable)
+	require.Contains(t, result(2).Error, "block not found")
+	require.False(t, result(3).Retrievable)
+	require.NotEmpty(t, result(3).Error)
+
+	// the failed checks are retried, up to the maximum attempts
+	block, err := other.Get(ctx, missing)
+	require.NoError(t, err)
+	require.NoError(t, store.Put(ctx, missing, block))
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	require.True(t, result(2).Retrievable)
+	require.Equal(t, uint32(2), result(2).Attempts)
+	require.False(t, result(3).Retrievable)
+	require.Equal(t, uint32(2), result(3).Attempts)
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	require.Equal(t, uint32(2), result(3).Attempts)
+
+	// the entries created since the last poll are checked, page by page
+	for range 150 {
+		queryClient.create(7, pinned)
+	}
+	store.Delete(pinned)
+	queryClient.calls = 0
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	require.Equal(t, 2, queryClient.calls)
+	require.True(t, result(1).Retrievable)
+	require.False(t, result(153).Retrievable)
+
+	res, err := checker.CheckResults(ctx, &availability.QueryCheckResultsRequest{FailedOnly: true, Pagination: &query.PageRequest{CountTotal: true, Limit: 10}})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 10)
+	require.Equal(t, uint64(3), res.Results[0].EntryId)
+	require.Equal(t, uint64(151), res.Pagination.Total)
+
+	res, err = checker.CheckResults(ctx, &availability.QueryCheckResultsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 100)
+	require.Equal(t, uint64(1), res.Results[0].EntryId)
+}
+
+func TestCheckerUpdatedEntries(t *testing.T) {
+	ctx := context.Background()
+	store := ipfs.NewMemStore()
+	cfg := availability.DefaultConfig()
+	cfg.RetryInterval = 0
+	checker := availability.NewChecker(dbm.NewMemDB(), store, cfg, log.NewNopLogger())
+	queryClient := &fakeQueryClient{}
+	result := func(id uint64) availability.CheckResult {
+		t.Helper()
+		res, err := checker.CheckResult(ctx, &availability.QueryCheckResultRequest{EntryId: id})
+		require.NoError(t, err)
+		return res.Result
+	}
+
+	first, err := ipfs.Add(ctx, store, bytes.NewReader([]byte("rainfall,2024\n")))
+	require.NoError(t, err)
+	require.NoError(t, checker.StartAt(1))
+	queryClient.create(1, first)
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	require.True(t, result(0).Retrievable)
+	require.Equal(t, uint64(1), result(0).Revision)
+
+	// an update leaving the CID unchanged is not checked again
+	queryClient.update(0, 2, first)
+	store.Delete(first)
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	require.True(t, result(0).Retrievable)
+	require.Equal(t, uint64(1), result(0).Revision)
+
+	// a new CID is checked, with the revision that set it
+	second, err := ipfs.Add(ctx, store, bytes.NewReader([]byte("rainfall,2025\n")))
+	require.NoError(t, err)
+	queryClient.update(0, 3, second)
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	require.True(t, result(0).Retrievable)
+	require.Equal(t, second.String(), result(0).Cid)
+	require.Equal(t, uint64(3), result(0).Revision)
+	require.Equal(t, uint32(1), result(0).Attempts)
+}
+
+func TestCheckerMissingLeaf(t *testing.T) {
+	ctx := context.Background()
+	store := ipfs.NewMemStore()
+	checker := availability.NewChecker(dbm.NewMemDB(), store, availability.DefaultConfig(), log.NewNopLogger())
+	queryClient := &fakeQueryClient{}
+
+	// content of several chunks whose last leaf is missing: the root block
+	// alone is retrievable
+	content := bytes.Repeat([]byte("rainfall,2024,"), 3*types.UnixFSChunkSize/14+100)
+	var blocks []cid.Cid
+	_, err := types.ImportFile(bytes.NewReader(content), func(c cid.Cid, _ []byte) error {
+		blocks = append(blocks, c)
+		return nil
+	})
+	require.NoError(t, err)
+	// the leaves are built in order, then the root
+	last := blocks[len(blocks)-2]
+	root, err := ipfs.Add(ctx, store, bytes.NewReader(content))
+	require.NoError(t, err)
+	store.Delete(last)
+	_, err = store.Get(ctx, root)
+	require.NoError(t, err)
+
+	require.NoError(t, checker.StartAt(1))
+	queryClient.create(1, root)
+	require.NoError(t, checker.Poll(ctx, queryClient))
+	res, err := checker.CheckResult(ctx, &availability.QueryCheckResultRequest{EntryId: 0})
+	require.NoError(t, err)
+	require.False(t, res.Result.Retrievable)
+	require.Contains(t, res.Result.Error, last.String())
+}
+
+func TestConfig(t *testing.T) {
+	require.NoError(t, availability.DefaultConfig().Validate())
+
+	for name, cfg := range map[string]func(*availability.Config){
+		"poll interval": func(cfg *availability.Config) { cfg.PollInterval = 0 },
+		"check timeout": func(cfg *availability.Config) { cfg.CheckTimeout = -time.Second },
+		"retry":         func(cfg *availability.Config) { cfg.RetryInterval = -time.Second },
+		"attempts":      func(cfg *availability.Config) { cfg.MaxAttempts = 0 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := availability.DefaultConfig()
+			cfg(&c)
+			require.Error(t, c.Validate())
+		})
+	}
+}
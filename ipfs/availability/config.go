@@ -0,0 +1,115 @@
+package availability
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Config is the [availability] section of app.toml.
+type Config struct {
+	// Enable runs the checker in the start command.
+	Enable bool `mapstructure:"enable"`
+	// PollInterval is the interval between the polls of the new entries.
+	PollInterval time.Duration `mapstructure:"poll-interval"`
+	// CheckTimeout bounds the fetch of the blocks checked of an entry.
+	CheckTimeout time.Duration `mapstructure:"check-timeout"`
+	// SampleLeaves is the number of leaves fetched at random offsets of the
+	// content, besides those of its first and last bytes.
+	SampleLeaves uint32 `mapstructure:"sample-leaves"`
+	// RetryInterval is the delay before a failed check is retried.
+	RetryInterval time.Duration `mapstructure:"retry-interval"`
+	// MaxAttempts is the number of checks of an entry before its content is
+	// given up as unretrievable.
+	MaxAttempts uint32 `mapstructure:"max-attempts"`
+}
+
+// DefaultConfig returns the default configuration, with the checker disabled.
+func DefaultConfig() Config {
+	return Config{
+		PollInterval:  5 * time.Second,
+		CheckTimeout:  time.Minute,
+		SampleLeaves:  4,
+		RetryInterval: 10 * time.Minute,
+		MaxAttempts:   3,
+	}
+}
+
+// ConfigTemplate is the app.toml template of Config, under the Availability
+// field of the app config.
+const ConfigTemplate = `
+###############################################################################
+###                      CID Availability Configuration                     ###
+###############################################################################
+
+[availability]
+
+# Enable checks that the content of the newly created or updated entries can be
+# fetched from the IPFS block store of the [ipfs] section. The checks are made off
+# consensus, recorded in data/availability.db and served by the gRPC server.
+enable = {{ .Availability.Enable }}
+
+# PollInterval is the interval between the polls of the new entries.
+poll-interval = "{{ .Availability.PollInterval }}"
+
+# CheckTimeout bounds the fetch of the blocks checked of an entry.
+check-timeout = "{{ .Availability.CheckTimeout }}"
+
+# SampleLeaves is the number of leaves fetched at random offsets of the
+# content, besides those of its first and last bytes.
+sample-leaves = {{ .Availability.SampleLeaves }}
+
+# RetryInterval is the delay before a failed check is retried.
+retry-interval = "{{ .Availability.RetryInterval }}"
+
+# MaxAttempts is the number of checks of an entry before its content is given
+# up as unretrievable.
+max-attempts = {{ .Availability.MaxAttempts }}
+`
+
+// ReadConfig reads the [availability] section of app.toml, defaulting the
+// unset values.
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	cfg.Enable = cast.ToBool(opts.Get("availability.enable"))
+	for key, d := range map[string]*time.Duration{
+		"availability.poll-interval":  &cfg.PollInterval,
+		"availability.check-timeout":  &cfg.CheckTimeout,
+		"availability.retry-interval": &cfg.RetryInterval,
+	} {
+		if v := opts.Get(key); v != nil {
+			parsed, err := cast.ToDurationE(v)
+			if err != nil {
+				return Config{}, fmt.Errorf("%s: %w", key, err)
+			}
+			*d = parsed
+		}
+	}
+	if v := opts.Get("availability.sample-leaves"); v != nil {
+		cfg.SampleLeaves = cast.ToUint32(v)
+	}
+	if v := opts.Get("availability.max-attempts"); v != nil {
+		cfg.MaxAttempts = cast.ToUint32(v)
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the intervals and attempts of cfg.
+func (cfg Config) Validate() error {
+	if cfg.PollInterval <= 0 {
+		return errors.New("availability.poll-interval must be positive")
+	}
+	if cfg.CheckTimeout <= 0 {
+		return errors.New("availability.check-timeout must be positive")
+	}
+	if cfg.RetryInterval < 0 {
+		return errors.New("availability.retry-interval must not be negative")
+	}
+	if cfg.MaxAttempts == 0 {
+		return errors.New("availability.max-attempts must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package availability
+
+import (
+	"context"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Checker) CheckResult(_ context.Context, req *QueryCheckResultRequest) (*QueryCheckResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	result, found, err := c.result(req.EntryId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &QueryCheckResultResponse{Result: result}, nil
+}
+
+func (c *Checker) CheckResults(_ context.Context, req *QueryCheckResultsRequest) (*QueryCheckResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var results []CheckResult
+	store := prefix.NewStore(dbadapter.Store{DB: c.db}, resultPrefix)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var result CheckResult
+		if err := result.Unmarshal(value); err != nil {
+			return false, err
+		}
+		if req.FailedOnly && result.Retrievable {
+			return false, nil
+		}
+		if accumulate {
+			results = append(results, result)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryCheckResultsResponse{Results: results, Pagination: pageRes}, nil
+}
@@ -0,0 +1,82 @@
+package ipfs
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// Backends of the block store of the node.
+const (
+	BackendKubo   = "kubo"
+	BackendFlatFS = "flatfs"
+)
+
+// Config is the [ipfs] section of app.toml.
+type Config struct {
+	// Backend is the block store of the node: BackendKubo or BackendFlatFS.
+	Backend string `mapstructure:"backend"`
+	// KuboAPI is the URL of the RPC API of the Kubo node.
+	KuboAPI string `mapstructure:"kubo-api"`
+	// FlatFSDir is the flatfs blocks directory.
+	FlatFSDir string `mapstructure:"flatfs-dir"`
+}
+
+// DefaultConfig returns the configuration of a local Kubo node.
+func DefaultConfig() Config {
+	return Config{
+		Backend: BackendKubo,
+		KuboAPI: "http://127.0.0.1:5001",
+	}
+}
+
+// ConfigTemplate is the app.toml template of Config, under the IPFS field of
+// the app config.
+const ConfigTemplate = `
+###############################################################################
+###                              IPFS Configuration                         ###
+###############################################################################
+
+[ipfs]
+
+# Backend is the block store the node reads IPFS content from: "kubo" for the
+# HTTP RPC API of a Kubo node, "flatfs" for a flatfs blocks directory.
+backend = "{{ .IPFS.Backend }}"
+
+# KuboAPI is the URL of the RPC API of the Kubo node.
+kubo-api = "{{ .IPFS.KuboAPI }}"
+
+# FlatFSDir is the flatfs blocks directory, such as the blocks directory of
+# the repository of a Kubo node.
+flatfs-dir = "{{ .IPFS.FlatFSDir }}"
+`
+
+// ReadConfig reads the [ipfs] section of app.toml, defaulting the unset
+// values.
+func ReadConfig(opts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := cast.ToString(opts.Get("ipfs.backend")); v != "" {
+		cfg.Backend = v
+	}
+	if v := cast.ToString(opts.Get("ipfs.kubo-api")); v != "" {
+		cfg.KuboAPI = v
+	}
+	cfg.FlatFSDir = cast.ToString(opts.Get("ipfs.flatfs-dir"))
+	return cfg
+}
+
+// NewBlockStore returns the block store configured by cfg.
+func NewBlockStore(cfg Config) (BlockStore, error) {
+	switch cfg.Backend {
+	case BackendKubo:
+		return NewKuboClient(cfg.KuboAPI, nil)
+	case BackendFlatFS:
+		if cfg.FlatFSDir == "" {
+			return nil, fmt.Errorf("ipfs.flatfs-dir must be set with the %s backend", BackendFlatFS)
+		}
+		return NewFlatFS(cfg.FlatFSDir)
+	default:
+		return nil, fmt.Errorf("unknown ipfs.backend %q, expected %s or %s", cfg.Backend, BackendKubo, BackendFlatFS)
+	}
+}
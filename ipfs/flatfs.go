@@ -0,0 +1,121 @@
+package ipfs
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+)
+
+// flatfsSharding is the sharding function of the flatfs datastores of Kubo,
+// which the SHARDING file of a blocks directory names.
+const flatfsSharding = "/repo/flatfs/shard/v1/next-to-last/2"
+
+// flatfsShardingFile is the name of the file holding the sharding function.
+const flatfsShardingFile = "SHARDING"
+
+// FlatFS is a BlockStore reading and writing a flatfs blocks directory laid
+// out as the one of Kubo: each block is a file named after the upper case
+// base32 of its multihash, in the directory named after the two characters
+// before the last one. The blocks directory of a stopped Kubo node can be
+// read directly, and a directory written by the node can be served by Kubo.
+type FlatFS struct {
+	dir string
+}
+
+var _ BlockStore = (*FlatFS)(nil)
+
+// NewFlatFS opens the flatfs blocks directory dir, creating it when it does
+// not exist.
+func NewFlatFS(dir string) (*FlatFS, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	shardingPath := filepath.Join(dir, flatfsShardingFile)
+	sharding, err := os.ReadFile(shardingPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		if err := os.WriteFile(shardingPath, []byte(flatfsSharding+"\n"), 0o644); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case strings.TrimSpace(string(sharding)) != flatfsSharding:
+		return nil, fmt.Errorf("unsupported flatfs sharding %q in %s", strings.TrimSpace(string(sharding)), dir)
+	}
+	return &FlatFS{dir: dir}, nil
+}
+
+// Get implements Fetcher.
+func (s *FlatFS) Get(_ context.Context, c cid.Cid) ([]byte, error) {
+	block, err := os.ReadFile(s.path(c))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := VerifyBlock(c, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// Has implements BlockStore.
+func (s *FlatFS) Has(_ context.Context, c cid.Cid) (bool, error) {
+	_, err := os.Stat(s.path(c))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Put implements BlockStore. The block is written to a temporary file renamed
+// in place, so that readers never see a partial block.
+func (s *FlatFS) Put(_ context.Context, c cid.Cid, block []byte) error {
+	if err := VerifyBlock(c, block); err != nil {
+		return err
+	}
+
+	path := s.path(c)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".temp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(block); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// path returns the path of the file of the block of c.
+func (s *FlatFS) path(c cid.Cid) string {
+	key := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(c.Hash())
+	// next-to-last/2 pads the keys shorter than three characters with '_'
+	padded := key
+	if len(padded) < 3 {
+		padded = strings.Repeat("_", 3-len(padded)) + padded
+	}
+	shard := padded[len(padded)-3 : len(padded)-1]
+	return filepath.Join(s.dir, shard, key+".data")
+}
@@ -0,0 +1,61 @@
+// Package ipfs gives the node access to the IPFS blocks of the published
+// content, through a Kubo node, a flatfs blocks directory or memory.
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+
+	"govchain/x/datasets/types"
+)
+
+// ErrNotFound is returned when a block cannot be found.
+var ErrNotFound = errors.New("block not found")
+
+// Fetcher reads blocks by CID.
+type Fetcher interface {
+	// Get returns the block of c, checked against its hash. The error wraps
+	// ErrNotFound when the block cannot be found.
+	Get(ctx context.Context, c cid.Cid) ([]byte, error)
+}
+
+// BlockStore is a Fetcher that also stores blocks.
+type BlockStore interface {
+	Fetcher
+	// Has reports whether the block of c is stored, without fetching it.
+	Has(ctx context.Context, c cid.Cid) (bool, error)
+	// Put stores block as the block of c, which it must match.
+	Put(ctx context.Context, c cid.Cid, block []byte) error
+}
+
+// VerifyBlock checks that block hashes to the multihash of c.
+func VerifyBlock(c cid.Cid, block []byte) error {
+	sum, err := c.Prefix().Sum(block)
+	if err != nil {
+		return fmt.Errorf("hashing block: %w", err)
+	}
+	if !bytes.Equal(sum.Hash(), c.Hash()) {
+		return fmt.Errorf("block does not match %s", c)
+	}
+	return nil
+}
+
+// Add imports the content read from r into s as the upload flow does, and
+// returns its CID.
+func Add(ctx context.Context, s BlockStore, r io.Reader) (cid.Cid, error) {
+	return types.ImportFile(r, func(c cid.Cid, block []byte) error {
+		return s.Put(ctx, c, block)
+	})
+}
+
+// GetFunc adapts f to the block getter of types.ChunkPath.
+func GetFunc(ctx context.Context, f Fetcher) func(c cid.Cid) ([]byte, error) {
+	return func(c cid.Cid) ([]byte, error) {
+		return f.Get(ctx, c)
+	}
+}
@@ -0,0 +1,154 @@
+package ipfs_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+
+	"govchain/ipfs"
+	"govchain/x/datasets/types"
+)
+
+// fakeKubo serves the block commands of the RPC API of Kubo from a MemStore.
+func fakeKubo(t *testing.T, store *ipfs.MemStore) *httptest.Server {
+	t.Helper()
+
+	fail := func(w http.ResponseWriter, msg string) {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]any{"Message": msg, "Code": 0, "Type": "error"})
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v0/block/get", func(w http.ResponseWriter, r *http.Request) {
+		block, err := store.Get(r.Context(), cid.MustParse(r.URL.Query().Get("arg")))
+		if err != nil {
+			fail(w, "block was not found locally (offline)")
+			return
+		}
+		w.Write(block)
+	})
+	mux.HandleFunc("POST /api/v0/block/stat", func(w http.ResponseWriter, r *http.Request) {
+		if found, _ := store.Has(r.Context(), cid.MustParse(r.URL.Query().Get("arg"))); !found {
+			fail(w, "block was not found locally (offline)")
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"Key": r.URL.Query().Get("arg")})
+	})
+	mux.HandleFunc("POST /api/v0/block/put", func(w http.ResponseWriter, r *http.Request) {
+		file, _, err := r.FormFile("file")
+		require.NoError(t, err)
+		block, err := io.ReadAll(file)
+		require.NoError(t, err)
+		codec := map[string]uint64{"dag-pb": cid.DagProtobuf, "raw": cid.Raw}[r.URL.Query().Get("cid-codec")]
+		require.Equal(t, "sha2-256", r.URL.Query().Get("mhtype"))
+		c, err := cid.Prefix{Version: 1, Codec: codec, MhType: multihash.SHA2_256, MhLength: -1}.Sum(block)
+		require.NoError(t, err)
+		require.NoError(t, store.Put(r.Context(), c, block))
+		json.NewEncoder(w).Encode(map[string]any{"Key": c.String(), "Size": len(block)})
+	})
+	mux.HandleFunc("POST /api/v0/pin/add", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"Pins": []string{r.URL.Query().Get("arg")}})
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestBlockStores(t *testing.T) {
+	ctx := context.Background()
+	flatfs, err := ipfs.NewFlatFS(filepath.Join(t.TempDir(), "blocks"))
+	require.NoError(t, err)
+	kubo, err := ipfs.NewKuboClient(fakeKubo(t, ipfs.NewMemStore()).URL, nil)
+	require.NoError(t, err)
+
+	// content of several chunks, stored as a DAG of several blocks
+	content := bytes.Repeat([]byte("rainfall,2024,"), 2*types.UnixFSChunkSize/14+100)
+	want, err := types.FileCid(bytes.NewReader(content))
+	require.NoError(t, err)
+
+	for name, store := range map[string]ipfs.BlockStore{
+		"MemStore":   ipfs.NewMemStore(),
+		"FlatFS":     flatfs,
+		"KuboClient": kubo,
+	} {
+		t.Run(name, func(t *testing.T) {
+			found, err := store.Has(ctx, want)
+			require.NoError(t, err)
+			require.False(t, found)
+			_, err = store.Get(ctx, want)
+			require.ErrorIs(t, err, ipfs.ErrNotFound)
+
+			root, err := ipfs.Add(ctx, store, bytes.NewReader(content))
+			require.NoError(t, err)
+			require.Equal(t, want, root)
+
+			found, err = store.Has(ctx, root)
+			require.NoError(t, err)
+			require.True(t, found)
+			block, err := store.Get(ctx, root)
+			require.NoError(t, err)
+			require.NoError(t, ipfs.VerifyBlock(root, block))
+
+			// the content is read back through the DAG
+			offset := uint64(len(content) - 1)
+			chunk, path, err := types.ChunkPath(ipfs.GetFunc(ctx, store), root, offset)
+			require.NoError(t, err)
+			require.NoError(t, types.VerifyChunkPath(root, offset, chunk, path))
+
+			// a block not matching its CID is rejected
+			require.Error(t, store.Put(ctx, root, []byte("tampered")))
+		})
+	}
+}
+
+func TestFlatFS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := ipfs.NewFlatFS(dir)
+	require.NoError(t, err)
+
+	sharding, err := os.ReadFile(filepath.Join(dir, "SHARDING"))
+	require.NoError(t, err)
+	require.Equal(t, "/repo/flatfs/shard/v1/next-to-last/2\n", string(sharding))
+
+	// the blocks are laid out as in the repository of Kubo
+	c, err := ipfs.Add(ctx, store, bytes.NewReader([]byte("hello world\n")))
+	require.NoError(t, err)
+	require.Equal(t, "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o", c.String())
+	path := filepath.Join(dir, "YD", "CIQENVCICS44LLYUDQ5KVN6ALXC6QRHK2X4R6EUFRMBB5OSFO2FUYDQ.data")
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	// a corrupted block is not returned
+	require.NoError(t, os.WriteFile(path, []byte("hello world?"), 0o644))
+	_, err = store.Get(ctx, c)
+	require.ErrorContains(t, err, "does not match")
+
+	// a directory of another sharding is not opened
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "SHARDING"), []byte("/repo/flatfs/shard/v1/prefix/2\n"), 0o644))
+	_, err = ipfs.NewFlatFS(dir)
+	require.ErrorContains(t, err, "unsupported flatfs sharding")
+}
+
+func TestNewBlockStore(t *testing.T) {
+	_, err := ipfs.NewBlockStore(ipfs.DefaultConfig())
+	require.NoError(t, err)
+	_, err = ipfs.NewBlockStore(ipfs.Config{Backend: ipfs.BackendKubo, KuboAPI: "127.0.0.1:5001"})
+	require.ErrorContains(t, err, "invalid Kubo API URL")
+	_, err = ipfs.NewBlockStore(ipfs.Config{Backend: ipfs.BackendFlatFS})
+	require.ErrorContains(t, err, "flatfs-dir must be set")
+	_, err = ipfs.NewBlockStore(ipfs.Config{Backend: ipfs.BackendFlatFS, FlatFSDir: t.TempDir()})
+	require.NoError(t, err)
+	_, err = ipfs.NewBlockStore(ipfs.Config{Backend: "s3"})
+	require.ErrorContains(t, err, "unknown ipfs.backend")
+}
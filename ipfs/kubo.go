@@ -0,0 +1,194 @@
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+)
+
+// KuboClient is a BlockStore backed by the HTTP RPC API of a Kubo node. Get
+// fetches the blocks the node does not hold from the IPFS network, until the
+// context is done.
+type KuboClient struct {
+	api    string
+	client *http.Client
+}
+
+var _ BlockStore = (*KuboClient)(nil)
+
+// NewKuboClient returns a client of the RPC API served at apiURL, such as
+// http://127.0.0.1:5001, sending its requests with client, or with
+// http.DefaultClient when nil.
+func NewKuboClient(apiURL string, client *http.Client) (*KuboClient, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kubo API URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid Kubo API URL %q: expected http(s)://host:port", apiURL)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &KuboClient{api: strings.TrimSuffix(apiURL, "/") + "/api/v0/", client: client}, nil
+}
+
+// Get implements Fetcher.
+func (k *KuboClient) Get(ctx context.Context, c cid.Cid) ([]byte, error) {
+	body, err := k.call(ctx, "block/get", url.Values{"arg": {c.String()}}, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	block, err := io.ReadAll(io.LimitReader(body, maxKuboBlockSize+1))
+	body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(block) > maxKuboBlockSize {
+		return nil, fmt.Errorf("block %s exceeds %d bytes", c, maxKuboBlockSize)
+	}
+	if err := VerifyBlock(c, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// Has implements BlockStore, reporting whether the Kubo node holds the block
+// without searching the network.
+func (k *KuboClient) Has(ctx context.Context, c cid.Cid) (bool, error) {
+	body, err := k.call(ctx, "block/stat", url.Values{"arg": {c.String()}, "offline": {"true"}}, nil, "")
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	body.Close()
+	return true, nil
+}
+
+// Put implements BlockStore. The block is not pinned.
+func (k *KuboClient) Put(ctx context.Context, c cid.Cid, block []byte) error {
+	if err := VerifyBlock(c, block); err != nil {
+		return err
+	}
+	prefix := c.Prefix()
+	codec, ok := kuboCodecs[prefix.Codec]
+	if !ok {
+		return fmt.Errorf("unsupported codec of %s: %#x", c, prefix.Codec)
+	}
+	hashName, ok := multihash.Codes[prefix.MhType]
+	if !ok {
+		return fmt.Errorf("unsupported multihash of %s: %#x", c, prefix.MhType)
+	}
+
+	var form bytes.Buffer
+	w := multipart.NewWriter(&form)
+	part, err := w.CreateFormFile("file", "block")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(block); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	body, err := k.call(ctx, "block/put", url.Values{
+		"cid-codec": {codec},
+		"mhtype":    {hashName},
+		"mhlen":     {fmt.Sprint(prefix.MhLength)},
+	}, &form, w.FormDataContentType())
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	var res struct{ Key string }
+	if err := json.NewDecoder(body).Decode(&res); err != nil {
+		return fmt.Errorf("decoding block/put response: %w", err)
+	}
+	stored, err := cid.Decode(res.Key)
+	if err != nil {
+		return fmt.Errorf("invalid CID returned by block/put: %w", err)
+	}
+	if !bytes.Equal(stored.Hash(), c.Hash()) {
+		return fmt.Errorf("Kubo stored the block as %s instead of %s", stored, c)
+	}
+	return nil
+}
+
+// Pin pins the DAG rooted at c recursively, so that the Kubo node keeps and
+// provides it.
+func (k *KuboClient) Pin(ctx context.Context, c cid.Cid) error {
+	body, err := k.call(ctx, "pin/add", url.Values{"arg": {c.String()}, "recursive": {"true"}}, nil, "")
+	if err != nil {
+		return err
+	}
+	return body.Close()
+}
+
+// kuboCodecs maps the codecs of UnixFS blocks to their names in the RPC API.
+var kuboCodecs = map[uint64]string{
+	cid.DagProtobuf: "dag-pb",
+	cid.Raw:         "raw",
+}
+
+// maxKuboBlockSize bounds the blocks read from Kubo, at the limit of bitswap.
+const maxKuboBlockSize = 2 << 20
+
+// kuboError is the error returned by the RPC API.
+type kuboError struct {
+	Message string
+	Code    int
+}
+
+func (e *kuboError) Error() string {
+	return "kubo: " + e.Message
+}
+
+// Unwrap maps the missing blocks to ErrNotFound.
+func (e *kuboError) Unwrap() error {
+	if strings.Contains(e.Message, "not found") {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// call posts the RPC command cmd with the query args and the request body,
+// and returns the response body, which the caller closes.
+func (k *KuboClient) call(ctx context.Context, cmd string, args url.Values, body io.Reader, contentType string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.api+cmd+"?"+args.Encode(), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	res, err := k.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusOK {
+		return res.Body, nil
+	}
+	defer res.Body.Close()
+
+	ke := &kuboError{}
+	msg, _ := io.ReadAll(io.LimitReader(res.Body, 1<<16))
+	if err := json.Unmarshal(msg, ke); err != nil || ke.Message == "" {
+		ke.Message = fmt.Sprintf("%s: %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil, ke
+}
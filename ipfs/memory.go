@@ -0,0 +1,73 @@
+package ipfs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+)
+
+// MemStore is a BlockStore holding its blocks in memory, by multihash. It is
+// meant for tests.
+type MemStore struct {
+	mu     sync.RWMutex
+	blocks map[string][]byte
+}
+
+var _ BlockStore = (*MemStore)(nil)
+
+// NewMemStore returns an empty MemStore.
+func NewMemStore() *MemStore {
+	return &MemStore{blocks: make(map[string][]byte)}
+}
+
+// Get implements Fetcher.
+func (s *MemStore) Get(_ context.Context, c cid.Cid) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	block, ok := s.blocks[string(c.Hash())]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, c)
+	}
+	return block, nil
+}
+
+// Has implements BlockStore.
+func (s *MemStore) Has(_ context.Context, c cid.Cid) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.blocks[string(c.Hash())]
+	return ok, nil
+}
+
+// Put implements BlockStore.
+func (s *MemStore) Put(_ context.Context, c cid.Cid, block []byte) error {
+	if err := VerifyBlock(c, block); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blocks[string(c.Hash())] = append([]byte(nil), block...)
+	return nil
+}
+
+// Delete removes the block of c, if any.
+func (s *MemStore) Delete(c cid.Cid) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blocks, string(c.Hash()))
+}
+
+// Len returns the number of blocks held.
+func (s *MemStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.blocks)
+}
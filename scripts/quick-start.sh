@@ -93,5 +93,5 @@ echo ""
 echo "Next steps:"
 echo "  1. Start blockchain: cd govchain && ignite chain serve"
 echo "  2. Start indexer: cd indexer && go run main.go"
-echo "  3. Upload test data: ./scripts/upload-dataset.sh <file> <title> <desc> <agency> <category>"
+echo "  3. Upload test data: govchaind tx datasets upload <file> --title <title> --agency <agency> --from <key>"
 echo ""
@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"govchain/x/datasets/types"
+)
+
+const (
+	manifestFormatCSV  = "csv"
+	manifestFormatJSON = "json"
+)
+
+// manifestRow is a row of a manifest: the fields of an entry, as text.
+type manifestRow struct {
+	title, description, ipfsCid, mimeType, fileName, fileUrl, fallbackUrl  string
+	fileSize, checksum, agency, category, submitter, publishedAt, mirrorOf string
+}
+
+// manifestColumns maps the columns of a manifest, named after the fields of
+// MsgCreateEntry, to the fields of a row.
+var manifestColumns = map[string]func(row *manifestRow) *string{
+	"title":            func(row *manifestRow) *string { return &row.title },
+	"description":      func(row *manifestRow) *string { return &row.description },
+	"ipfs_cid":         func(row *manifestRow) *string { return &row.ipfsCid },
+	"mime_type":        func(row *manifestRow) *string { return &row.mimeType },
+	"file_name":        func(row *manifestRow) *string { return &row.fileName },
+	"file_url":         func(row *manifestRow) *string { return &row.fileUrl },
+	"fallback_url":     func(row *manifestRow) *string { return &row.fallbackUrl },
+	"file_size":        func(row *manifestRow) *string { return &row.fileSize },
+	"checksum_sha_256": func(row *manifestRow) *string { return &row.checksum },
+	"agency":           func(row *manifestRow) *string { return &row.agency },
+	"category":         func(row *manifestRow) *string { return &row.category },
+	"submitter":        func(row *manifestRow) *string { return &row.submitter },
+	"published_at":     func(row *manifestRow) *string { return &row.publishedAt },
+	"mirror_of":        func(row *manifestRow) *string { return &row.mirrorOf },
+}
+
+// manifestColumnNames returns the sorted names of the manifest columns.
+func manifestColumnNames() []string {
+	names := make([]string, 0, len(manifestColumns))
+	for name := range manifestColumns {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// manifestFormat returns the format of the manifest at path, given by format
+// or else by the extension of path.
+func manifestFormat(path, format string) (string, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	switch format {
+	case manifestFormatCSV, manifestFormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown manifest format %q: expected %s or %s", format, manifestFormatCSV, manifestFormatJSON)
+	}
+}
+
+// readManifest reads the rows of the manifest at path in the given format.
+func readManifest(path, format string) ([]manifestRow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case manifestFormatCSV:
+		return parseCSVManifest(data)
+	default:
+		return parseJSONManifest(data)
+	}
+}
+
+// parseCSVManifest parses a CSV manifest, whose header names the columns of
+// its rows.
+func parseCSVManifest(data []byte) ([]manifestRow, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	header, err := r.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("manifest has no header")
+		}
+		return nil, err
+	}
+	fields := make([]func(*manifestRow) *string, len(header))
+	for i := range header {
+		name := strings.TrimSpace(header[i])
+		header[i] = name
+		field, ok := manifestColumns[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q: expected some of %s", name, strings.Join(manifestColumnNames(), ", "))
+		}
+		if slices.Contains(header[:i], name) {
+			return nil, fmt.Errorf("duplicated column %q", name)
+		}
+		fields[i] = field
+	}
+
+	var rows []manifestRow
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var row manifestRow
+		for i, value := range record {
+			*fields[i](&row) = value
+		}
+		rows = append(rows, row)
+	}
+}
+
+// parseJSONManifest parses a JSON manifest, an array of objects whose keys
+// name the columns of the rows. The values are strings, or numbers for the
+// numeric columns.
+func parseJSONManifest(data []byte) ([]manifestRow, error) {
+	var objects []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("manifest is not an array of objects: %w", err)
+	}
+
+	rows := make([]manifestRow, len(objects))
+	for i, object := range objects {
+		for name, raw := range object {
+			field, ok := manifestColumns[name]
+			if !ok {
+				return nil, fmt.Errorf("row %d: unknown column %q: expected some of %s", i+1, name, strings.Join(manifestColumnNames(), ", "))
+			}
+			value, err := jsonManifestValue(raw)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: column %q: %w", i+1, name, err)
+			}
+			*field(&rows[i]) = value
+		}
+	}
+	return rows, nil
+}
+
+// jsonManifestValue returns the text of a JSON string or number, or the empty
+// string for null.
+func jsonManifestValue(raw json.RawMessage) (string, error) {
+	var value any
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if err := d.Decode(&value); err != nil {
+		return "", err
+	}
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	case json.Number:
+		return value.String(), nil
+	default:
+		return "", fmt.Errorf("expected a string or a number: %s", raw)
+	}
+}
+
+// batchEntry returns the entry described by the row. An empty publication
+// time is replaced by publishedAt.
+func (row manifestRow) batchEntry(publishedAt time.Time) (types.BatchEntry, error) {
+	entry := types.BatchEntry{
+		Title:           row.title,
+		Description:     row.description,
+		IpfsCid:         row.ipfsCid,
+		MimeType:        row.mimeType,
+		FileName:        row.fileName,
+		FileUrl:         row.fileUrl,
+		FallbackUrl:     row.fallbackUrl,
+		ChecksumSha_256: row.checksum,
+		Agency:          row.agency,
+		Category:        row.category,
+		Submitter:       row.submitter,
+		PublishedAt:     publishedAt,
+	}
+
+	var err error
+	if row.fileSize != "" {
+		if entry.FileSize, err = strconv.ParseUint(row.fileSize, 10, 64); err != nil {
+			return entry, fmt.Errorf("invalid file_size %q: expected a number of bytes", row.fileSize)
+		}
+	}
+	if row.publishedAt != "" {
+		if entry.PublishedAt, err = time.Parse(time.RFC3339, row.publishedAt); err != nil {
+			return entry, fmt.Errorf("invalid published_at %q: expected an RFC 3339 time", row.publishedAt)
+		}
+	}
+	if row.mirrorOf != "" {
+		id, err := strconv.ParseUint(row.mirrorOf, 10, 64)
+		if err != nil {
+			return entry, fmt.Errorf("invalid mirror_of %q: expected an entry id", row.mirrorOf)
+		}
+		entry.MirrorOf = &types.MirrorLink{EntryId: id}
+	}
+	return entry, nil
+}
+
+// manifestEntries returns the entries of the rows created by creator,
+// checking each of them as MsgCreateEntry does, and that the content of the
+// rows that are not mirrors is not repeated. The errors of the rows are
+// returned by row number, counted from 1.
+func manifestEntries(rows []manifestRow, creator string, publishedAt time.Time) ([]types.BatchEntry, map[int]error) {
+	var (
+		entries = make([]types.BatchEntry, len(rows))
+		errs    = make(map[int]error)
+		seen    = make(map[string]int)
+	)
+	for i, row := range rows {
+		entry, err := row.batchEntry(publishedAt)
+		if err == nil {
+			err = entry.MsgCreateEntry(creator).ValidateBasic()
+		}
+		if err != nil {
+			errs[i+1] = err
+			continue
+		}
+		entries[i] = entry
+
+		if entry.MirrorOf != nil {
+			continue
+		}
+		for _, key := range []string{
+			"ipfs_cid " + types.NormalizeCid(entry.IpfsCid),
+			"checksum_sha_256 " + types.NormalizeChecksum(entry.ChecksumSha_256),
+		} {
+			if other, ok := seen[key]; ok {
+				errs[i+1] = fmt.Errorf("%s is repeated from row %d", key, other)
+				break
+			}
+			seen[key] = i + 1
+		}
+	}
+	return entries, errs
+}
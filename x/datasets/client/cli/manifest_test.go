@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+const (
+	manifestCid      = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	manifestOtherCid = "bafkreifjjcie6lypi6ny7amxnfftagclbuxndqonfipmb64f2km2devei4"
+	manifestChecksum = "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"
+	manifestOther    = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+)
+
+func TestParseCSVManifest(t *testing.T) {
+	tests := []struct {
+		desc string
+		data string
+		rows []manifestRow
+		err  string
+	}{
+		{
+			desc: "Rows",
+			data: "title,ipfs_cid,file_size\nRainfall,cid-0,12\n\"Budget, 2024\",cid-1,\n",
+			rows: []manifestRow{
+				{title: "Rainfall", ipfsCid: "cid-0", fileSize: "12"},
+				{title: "Budget, 2024", ipfsCid: "cid-1"},
+			},
+		},
+		{
+			desc: "ByteOrderMark",
+			data: "\ufefftitle, agency \nRainfall,NOAA\n",
+			rows: []manifestRow{{title: "Rainfall", agency: "NOAA"}},
+		},
+		{
+			desc: "HeaderOnly",
+			data: "title,agency\n",
+		},
+		{
+			desc: "NoHeader",
+			data: "",
+			err:  "manifest has no header",
+		},
+		{
+			desc: "UnknownColumn",
+			data: "title,size\nRainfall,12\n",
+			err:  `unknown column "size"`,
+		},
+		{
+			desc: "DuplicatedColumn",
+			data: "title,agency,title\nRainfall,NOAA,Rain\n",
+			err:  `duplicated column "title"`,
+		},
+		{
+			desc: "RaggedRow",
+			data: "title,agency\nRainfall,NOAA\nBudget\n",
+			err:  "wrong number of fields",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			rows, err := parseCSVManifest([]byte(tc.data))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rows, rows)
+		})
+	}
+}
+
+func TestParseJSONManifest(t *testing.T) {
+	tests := []struct {
+		desc string
+		data string
+		rows []manifestRow
+		err  string
+	}{
+		{
+			desc: "Rows",
+			data: `[{"title": "Rainfall", "file_size": 12, "mirror_of": "3"}, {"title": "Budget", "category": null}]`,
+			rows: []manifestRow{
+				{title: "Rainfall", fileSize: "12", mirrorOf: "3"},
+				{title: "Budget"},
+			},
+		},
+		{
+			desc: "Empty",
+			data: `[]`,
+			rows: []manifestRow{},
+		},
+		{
+			desc: "NotArray",
+			data: `{"title": "Rainfall"}`,
+			err:  "manifest is not an array of objects",
+		},
+		{
+			desc: "UnknownColumn",
+			data: `[{"title": "Rainfall"}, {"size": 12}]`,
+			err:  `row 2: unknown column "size"`,
+		},
+		{
+			desc: "NestedValue",
+			data: `[{"title": {"en": "Rainfall"}}]`,
+			err:  `row 1: column "title": expected a string or a number`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			rows, err := parseJSONManifest([]byte(tc.data))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rows, rows)
+		})
+	}
+}
+
+func TestJSONManifestValue(t *testing.T) {
+	tests := []struct {
+		raw   string
+		value string
+		err   bool
+	}{
+		{raw: `"Rainfall"`, value: "Rainfall"},
+		{raw: `""`, value: ""},
+		{raw: `null`, value: ""},
+		{raw: `12`, value: "12"},
+		// numbers keep their text, beyond the precision of a float
+		{raw: `18446744073709551615`, value: "18446744073709551615"},
+		{raw: `1.5e3`, value: "1.5e3"},
+		{raw: `true`, err: true},
+		{raw: `["Rainfall"]`, err: true},
+		{raw: `{"en": "Rainfall"}`, err: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.raw, func(t *testing.T) {
+			value, err := jsonManifestValue(json.RawMessage(tc.raw))
+			if tc.err {
+				require.ErrorContains(t, err, "expected a string or a number")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestManifestEntries(t *testing.T) {
+	const creator = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	publishedAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	row := func(ipfsCid, checksum string) manifestRow {
+		return manifestRow{title: "Rainfall", agency: "NOAA", mimeType: "text/csv", ipfsCid: ipfsCid, checksum: checksum}
+	}
+	// the CIDv1 form of manifestCid
+	manifestCidV1 := types.NormalizeCid(manifestCid)
+	require.NotEqual(t, manifestCid, manifestCidV1)
+
+	t.Run("Valid", func(t *testing.T) {
+		rows := []manifestRow{row(manifestCid, manifestChecksum), row(manifestOtherCid, manifestOther)}
+		rows[1].fileSize = "12"
+		rows[1].publishedAt = "2024-01-02T03:04:05Z"
+
+		entries, errs := manifestEntries(rows, creator, publishedAt)
+		require.Empty(t, errs)
+		require.Len(t, entries, 2)
+		require.Equal(t, manifestCid, entries[0].IpfsCid)
+		require.Equal(t, publishedAt, entries[0].PublishedAt)
+		require.Equal(t, uint64(12), entries[1].FileSize)
+		require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), entries[1].PublishedAt)
+	})
+	t.Run("RepeatedCid", func(t *testing.T) {
+		// the CIDs are compared in their canonical form
+		rows := []manifestRow{row(manifestCid, manifestChecksum), row(manifestOtherCid, manifestOther), row(manifestCidV1, strings.Repeat("0", 64))}
+		_, errs := manifestEntries(rows, creator, publishedAt)
+		require.Len(t, errs, 1)
+		require.ErrorContains(t, errs[3], "ipfs_cid "+manifestCidV1+" is repeated from row 1")
+	})
+	t.Run("RepeatedChecksum", func(t *testing.T) {
+		// the checksums are compared in lower case
+		rows := []manifestRow{row(manifestCid, manifestChecksum), row(manifestOtherCid, strings.ToUpper(manifestChecksum))}
+		_, errs := manifestEntries(rows, creator, publishedAt)
+		require.Len(t, errs, 1)
+		require.ErrorContains(t, errs[2], "checksum_sha_256 "+manifestChecksum+" is repeated from row 1")
+	})
+	t.Run("MirrorsExempt", func(t *testing.T) {
+		rows := []manifestRow{row(manifestCid, manifestChecksum), row(manifestCid, manifestChecksum), row(manifestCid, manifestChecksum)}
+		rows[1].mirrorOf = "7"
+		rows[2].mirrorOf = "8"
+		entries, errs := manifestEntries(rows, creator, publishedAt)
+		require.Empty(t, errs)
+		require.Equal(t, &types.MirrorLink{EntryId: 7}, entries[1].MirrorOf)
+		require.Equal(t, &types.MirrorLink{EntryId: 8}, entries[2].MirrorOf)
+	})
+	t.Run("InvalidRows", func(t *testing.T) {
+		rows := []manifestRow{row(manifestCid, manifestChecksum), row(manifestOtherCid, manifestOther), row("not-a-cid", manifestOther), row(manifestOtherCid, manifestOther)}
+		rows[0].fileSize = "twelve"
+		rows[1].mirrorOf = "-1"
+
+		_, errs := manifestEntries(rows, creator, publishedAt)
+		require.Len(t, errs, 3)
+		require.ErrorContains(t, errs[1], `invalid file_size "twelve"`)
+		require.ErrorContains(t, errs[2], `invalid mirror_of "-1"`)
+		require.ErrorIs(t, errs[3], types.ErrInvalidCid)
+		// the invalid rows are not counted as seen
+		require.NotContains(t, errs, 4)
+	})
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"govchain/x/datasets/types"
+)
+
+// GetQueryCmd returns the query commands of the module that cannot be
+// generated by autocli. The generated commands are added to it.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the datasets module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdProveEntry(),
+		CmdVerifyFile(),
+	)
+
+	return cmd
+}
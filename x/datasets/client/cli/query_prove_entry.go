@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"govchain/x/datasets/client/prover"
+)
+
+// Flags of the trust roots a state proof is verified against.
+const (
+	FlagTrustedAppHash        = "trusted-app-hash"
+	FlagTrustedValidatorsHash = "trusted-validators-hash"
+)
+
+// CmdProveEntry returns the command fetching an entry with a store proof and
+// verifying it locally.
+func CmdProveEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove-entry [id]",
+		Short: "Fetch an entry with a state proof and verify it locally",
+		Long: `Fetch an entry with an ICS-23 proof of the datasets store, together with the
+signed header committing to the proven state, and verify the proof locally.
+
+The node is not trusted: the proof must be anchored on a trust root given in
+hex, either --trusted-app-hash, the app hash the header must commit to, for
+instance one obtained from a light client, or --trusted-validators-hash, the
+hash of the validator set that must sign the header. Without --height, the
+state of the block before the latest one is proven, since the app hash of a
+state is committed by the header of the next block.
+
+The command prints a self-contained proof bundle, which can be re-verified
+offline.`,
+		Example:      "prove-entry 42 --trusted-app-hash 3A9F...C1 > entry-42.proof.json",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid entry id %q: %w", args[0], err)
+			}
+
+			trustedAppHash, err := HexFlag(cmd, FlagTrustedAppHash)
+			if err != nil {
+				return err
+			}
+			trustedValidatorsHash, err := HexFlag(cmd, FlagTrustedValidatorsHash)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			proof, err := prover.ProveEntry(cmd.Context(), node, clientCtx.ChainID, id, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+			if err := proof.VerifyTrusted(trustedAppHash, trustedValidatorsHash); err != nil {
+				return err
+			}
+
+			bz, err := cmtjson.MarshalIndent(proof, "", "  ")
+			if err != nil {
+				return err
+			}
+			// the bundle is always printed as JSON, whatever the output format
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagTrustedAppHash, "", "App hash, in hex, the header of the proof must commit to")
+	cmd.Flags().String(FlagTrustedValidatorsHash, "", "Validators hash, in hex, the header of the proof must name")
+	cmd.MarkFlagsOneRequired(FlagTrustedAppHash, FlagTrustedValidatorsHash)
+
+	return cmd
+}
+
+// HexFlag returns the bytes of a hex encoded flag.
+func HexFlag(cmd *cobra.Command, name string) ([]byte, error) {
+	s, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"govchain/x/datasets/types"
+)
+
+// ExitCodeMismatch is the exit code of verify-file when the file does not
+// match the entry. Other failures exit with code 1.
+const ExitCodeMismatch = 2
+
+// ExitError is an error that sets the exit code of the command returning it.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e ExitError) Error() string { return e.Err.Error() }
+
+func (e ExitError) Unwrap() error { return e.Err }
+
+// ExitCode returns the exit code of the command.
+func (e ExitError) ExitCode() int { return e.Code }
+
+// CmdVerifyFile returns the command checking a local file against the
+// content recorded by an entry.
+func CmdVerifyFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-file [id] [path]",
+		Short: "Check that a local file is the content recorded by an entry",
+		Long: `Check that a local file is the content recorded by an entry, for instance after
+downloading it from the file or fallback URL.
+
+The size, SHA-256 checksum and IPFS CID of the file are computed locally and
+compared to the entry, and every mismatch is reported. The CID is computed
+with the parameters of the upload flow, the defaults of "ipfs add": 256 KiB
+chunks in a balanced UnixFS DAG. Content added to IPFS with other parameters
+has another CID; the checksum still identifies it. Fields the entry does not
+record are not compared.
+
+The command exits with code 0 when the file matches, 2 when it does not, and
+1 on any other error, including an entry recording neither a checksum nor a
+CID.`,
+		Example:      "verify-file 42 ./rainfall-2024.csv && echo verified",
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid entry id %q: %w", args[0], err)
+			}
+
+			res, err := types.NewQueryClient(clientCtx).GetEntry(cmd.Context(), &types.QueryGetEntryRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			entry := res.Entry
+
+			f, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			digest, err := types.DigestFile(f)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", args[1], err)
+			}
+
+			mismatched := entry.MismatchedFields(digest)
+			check := func(field, recorded, computed string) string {
+				switch {
+				case recorded == "":
+					return fmt.Sprintf("NOT RECORDED  file %s", computed)
+				case slices.Contains(mismatched, field):
+					return fmt.Sprintf("MISMATCH      file %s, entry %s", computed, recorded)
+				default:
+					return fmt.Sprintf("OK            %s", computed)
+				}
+			}
+			var recordedSize string
+			if entry.FileSize != 0 {
+				recordedSize = strconv.FormatUint(entry.FileSize, 10)
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "entry:     %d %q (%s)\n", entry.Id, entry.Title, entry.Agency)
+			fmt.Fprintf(out, "file size: %s\n", check(types.FieldFileSize, recordedSize, strconv.FormatUint(digest.Size, 10)))
+			fmt.Fprintf(out, "checksum:  %s\n", check(types.FieldChecksumSha256, entry.ChecksumSha_256, digest.Checksum))
+			fmt.Fprintf(out, "ipfs cid:  %s\n", check(types.FieldIpfsCid, entry.IpfsCid, digest.Cid.String()))
+
+			if entry.ChecksumSha_256 == "" && entry.IpfsCid == "" {
+				return fmt.Errorf("entry %d records neither a checksum nor a CID to verify %s against", id, args[1])
+			}
+			if len(mismatched) > 0 {
+				return ExitError{
+					Code: ExitCodeMismatch,
+					Err:  fmt.Errorf("%s does not match entry %d: %s", args[1], id, strings.Join(mismatched, ", ")),
+				}
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"govchain/x/datasets/types"
+)
+
+// GetTxCmd returns the transaction commands of the module that cannot be
+// generated by autocli. The generated commands are added to it.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transactions commands for the datasets module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdRespondChallenge(),
+		CmdUpload(),
+		CmdImportManifest(),
+	)
+
+	return cmd
+}
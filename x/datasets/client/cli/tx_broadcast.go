@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+)
+
+// txInclusionTimeout bounds the wait for the inclusion of a broadcast
+// transaction in a block.
+const txInclusionTimeout = time.Minute
+
+// broadcastAndWait broadcasts a transaction of msgs with broadcastTx, then
+// waits for its inclusion in a block and returns its result, so that the
+// caller can read the responses of the messages. No result is returned with
+// --generate-only or --dry-run.
+func broadcastAndWait(cmd *cobra.Command, clientCtx client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := broadcastTx(cmd, clientCtx, msgs...)
+	if err != nil || res == nil {
+		return res, err
+	}
+	return waitForTx(clientCtx, res.TxHash)
+}
+
+// errTxDeclined is returned when the transaction to broadcast is declined at
+// the confirmation prompt.
+var errTxDeclined = errors.New("transaction declined")
+
+// broadcastTx builds, signs and broadcasts a transaction of msgs, and returns
+// the result of its check. With --generate-only or --dry-run, the transaction
+// is printed or simulated by tx.GenerateOrBroadcastTxCLI and no result is
+// returned. The gas estimate and the transaction to confirm are printed to the
+// error stream of cmd, leaving its output to the caller, and declining the
+// transaction fails with errTxDeclined.
+func broadcastTx(cmd *cobra.Command, clientCtx client.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	if clientCtx.GenerateOnly || clientCtx.Simulate {
+		return nil, tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+	}
+	for _, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	if txf.SimulateAndExecute() {
+		if clientCtx.Offline {
+			return nil, errors.New("cannot estimate gas in offline mode")
+		}
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+		fmt.Fprintf(cmd.ErrOrStderr(), "%s\n", tx.GasEstimateResponse{GasEstimate: txf.Gas()})
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if !clientCtx.SkipConfirm {
+		txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode transaction: %w", err)
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "%s\n", txJSON)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errTxDeclined
+		}
+	}
+
+	if err := tx.Sign(clientCtx.CmdContext, txf, clientCtx.FromName, builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res, nil
+}
+
+// waitForTx polls the transaction with the given hash until it is included in
+// a block, and fails when it is included with an error.
+func waitForTx(clientCtx client.Context, hash string) (*sdk.TxResponse, error) {
+	ctx := clientCtx.CmdContext
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, txInclusionTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		res, err := authtx.QueryTx(clientCtx, hash)
+		if err == nil {
+			if res.Code != 0 {
+				return res, fmt.Errorf("transaction %s failed with code %d: %s", hash, res.Code, res.RawLog)
+			}
+			return res, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s not included after %s: %w", hash, txInclusionTimeout, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// msgResponses returns the responses of the messages of an included
+// transaction, in message order.
+func msgResponses(res *sdk.TxResponse) ([][]byte, error) {
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data of transaction %s: %w", res.TxHash, err)
+	}
+	var msgData sdk.TxMsgData
+	if err := msgData.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("invalid data of transaction %s: %w", res.TxHash, err)
+	}
+	responses := make([][]byte, len(msgData.MsgResponses))
+	for i, resp := range msgData.MsgResponses {
+		responses[i] = resp.Value
+	}
+	return responses, nil
+}
@@ -0,0 +1,398 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"govchain/x/datasets/types"
+)
+
+const (
+	flagFormat       = "format"
+	flagBatchSize    = "batch-size"
+	flagProgressFile = "progress-file"
+	flagValidateOnly = "validate-only"
+
+	// progressFileSuffix is appended to the manifest path to name its default
+	// progress file.
+	progressFileSuffix = ".progress"
+	// maxProgressRecordSize bounds the lines of a progress file, records of
+	// batches of up to types.MaxBatchEntries entries.
+	maxProgressRecordSize = 1 << 20
+)
+
+// importBatch is a record of the progress file of an import: a batch
+// transaction, once broadcast and again once included with the ids of the
+// created entries.
+type importBatch struct {
+	TxHash   string   `json:"txhash"`
+	Rows     []int    `json:"rows"`
+	IpfsCids []string `json:"ipfs_cids"`
+	Ids      []uint64 `json:"ids,omitempty"`
+}
+
+// importedEntry is an entry created by an import.
+type importedEntry struct {
+	Row     int    `json:"row"`
+	EntryId string `json:"entry_id"`
+	IpfsCid string `json:"ipfs_cid"`
+	TxHash  string `json:"txhash"`
+}
+
+// importResult is printed by import-manifest once the rows are imported.
+type importResult struct {
+	Rows    int             `json:"rows"`
+	Created int             `json:"created"`
+	Resumed int             `json:"resumed"`
+	Entries []importedEntry `json:"entries"`
+}
+
+// CmdImportManifest returns the command creating the entries listed by a
+// manifest in batches.
+func CmdImportManifest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-manifest [manifest]",
+		Short: "Create the entries listed by a CSV or JSON manifest in batches",
+		Long: `Create the entries listed by a CSV or JSON manifest, in batch transactions.
+
+The manifest lists an entry per row, with columns named after the fields of
+create-entry: title, description, ipfs_cid, mime_type, file_name, file_url,
+fallback_url, file_size, checksum_sha_256, agency, category, submitter,
+published_at (RFC 3339, default the time of the latest block) and mirror_of
+(the id of the mirrored entry). A CSV manifest names its columns in a header
+row; a JSON manifest is an array of objects. The format is given by the file
+extension, or by --format.
+
+Every row is checked before anything is broadcast, and the errors are reported
+by row number, counted from 1 after the header. The rows are then split in
+batches of --batch-size entries, by default the max_batch_entries param, each
+created by a MsgCreateEntriesBatch transaction whose inclusion is awaited
+before the next one is broadcast. A failing batch creates none of its entries.
+
+The progress of the import is recorded in --progress-file, by default the
+manifest path with a ".progress" suffix. Running the command again resumes the
+import: the rows already created are skipped, provided their CID did not
+change. The gas of each transaction is estimated unless --gas is set.`,
+		Example: `import-manifest datasets-2024-q3.csv --from alice
+import-manifest datasets.json --batch-size 20 --from alice
+import-manifest datasets-2024-q3.csv --validate-only`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			path := args[0]
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if format, err = manifestFormat(path, format); err != nil {
+				return err
+			}
+			rows, err := readManifest(path, format)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", path, err)
+			}
+			if len(rows) == 0 {
+				return fmt.Errorf("%s has no rows", path)
+			}
+
+			publishedAt, err := latestBlockTime(clientCtx)
+			if err != nil {
+				return err
+			}
+			creator := clientCtx.GetFromAddress().String()
+			entries, rowErrs := manifestEntries(rows, creator, publishedAt)
+			if len(rowErrs) > 0 {
+				invalid := make([]int, 0, len(rowErrs))
+				for row := range rowErrs {
+					invalid = append(invalid, row)
+				}
+				slices.Sort(invalid)
+				for _, row := range invalid {
+					fmt.Fprintf(cmd.ErrOrStderr(), "row %d: %s\n", row, rowErrs[row].Error())
+				}
+				return fmt.Errorf("%d of the %d rows of %s are invalid", len(invalid), len(rows), path)
+			}
+			if validateOnly, _ := cmd.Flags().GetBool(flagValidateOnly); validateOnly {
+				fmt.Fprintf(cmd.ErrOrStderr(), "the %d rows of %s are valid\n", len(rows), path)
+				return nil
+			}
+
+			batchSize, err := importBatchSize(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			if !cmd.Flags().Changed(flags.FlagGas) {
+				if err := cmd.Flags().Set(flags.FlagGas, flags.GasFlagAuto); err != nil {
+					return err
+				}
+				if !cmd.Flags().Changed(flags.FlagGasAdjustment) {
+					if err := cmd.Flags().Set(flags.FlagGasAdjustment, "1.5"); err != nil {
+						return err
+					}
+				}
+			}
+
+			progressPath, _ := cmd.Flags().GetString(flagProgressFile)
+			if progressPath == "" {
+				progressPath = path + progressFileSuffix
+			}
+			progress, err := openImportProgress(clientCtx, progressPath, cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+			defer progress.Close()
+
+			result := importResult{Rows: len(rows)}
+			var pending []int
+			for i, entry := range entries {
+				imported, ok := progress.done[i+1]
+				if !ok {
+					pending = append(pending, i+1)
+					continue
+				}
+				if imported.IpfsCid != types.NormalizeCid(entry.IpfsCid) {
+					return fmt.Errorf("row %d changed since it was imported as entry %s by transaction %s: delete %s to import the manifest again",
+						i+1, imported.EntryId, imported.TxHash, progressPath)
+				}
+				result.Entries = append(result.Entries, imported)
+				result.Resumed++
+			}
+			if result.Resumed > 0 {
+				fmt.Fprintf(cmd.ErrOrStderr(), "resuming the import of %s: %d of %d rows already imported\n", path, result.Resumed, len(rows))
+			}
+
+			for start := 0; start < len(pending); start += batchSize {
+				batchRows := pending[start:min(start+batchSize, len(pending))]
+				msg := &types.MsgCreateEntriesBatch{Creator: creator}
+				record := importBatch{Rows: batchRows}
+				for _, row := range batchRows {
+					msg.Entries = append(msg.Entries, entries[row-1])
+					record.IpfsCids = append(record.IpfsCids, types.NormalizeCid(entries[row-1].IpfsCid))
+				}
+
+				res, err := broadcastTx(cmd, clientCtx, msg)
+				if errors.Is(err, errTxDeclined) {
+					return fmt.Errorf("import of %s stopped at %s: %w; run the command again to resume it",
+						path, rowRange(batchRows), err)
+				}
+				if err != nil {
+					return batchError(batchRows, err)
+				}
+				if res == nil {
+					// the batches are printed or simulated, not broadcast
+					if clientCtx.GenerateOnly || clientCtx.Simulate {
+						continue
+					}
+					return fmt.Errorf("%s not broadcast", rowRange(batchRows))
+				}
+				record.TxHash = res.TxHash
+				if err := progress.append(record); err != nil {
+					return err
+				}
+				if res, err = waitForTx(clientCtx, res.TxHash); err != nil {
+					return batchError(batchRows, err)
+				}
+				imported, err := progress.complete(record, res)
+				if err != nil {
+					return err
+				}
+				result.Entries = append(result.Entries, imported...)
+				result.Created += len(imported)
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s created as entries %s-%s by transaction %s\n",
+					rowRange(batchRows), imported[0].EntryId, imported[len(imported)-1].EntryId, res.TxHash)
+			}
+			if clientCtx.GenerateOnly || clientCtx.Simulate {
+				return nil
+			}
+
+			slices.SortFunc(result.Entries, func(a, b importedEntry) int { return a.Row - b.Row })
+			out, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(flagFormat, "", "Format of the manifest, csv or json (default given by the file extension)")
+	cmd.Flags().Int(flagBatchSize, 0, "Number of entries of each transaction (default the max_batch_entries param)")
+	cmd.Flags().String(flagProgressFile, "", "File recording the progress of the import (default the manifest path with a .progress suffix)")
+	cmd.Flags().Bool(flagValidateOnly, false, "Check the rows of the manifest without broadcasting anything")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// importBatchSize returns the number of entries of each batch, the
+// --batch-size flag within the max_batch_entries param.
+func importBatchSize(cmd *cobra.Command, clientCtx client.Context) (int, error) {
+	batchSize, _ := cmd.Flags().GetInt(flagBatchSize)
+	if batchSize < 0 {
+		return 0, fmt.Errorf("invalid --%s: %d", flagBatchSize, batchSize)
+	}
+	if clientCtx.Offline {
+		if batchSize == 0 {
+			return 0, fmt.Errorf("--%s is required offline", flagBatchSize)
+		}
+		return batchSize, nil
+	}
+
+	res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the params: %w", err)
+	}
+	maxEntries := int(res.Params.MaxBatchEntries)
+	switch {
+	case maxEntries == 0:
+		return 0, errors.New("entry batches are disabled by the max_batch_entries param")
+	case batchSize > maxEntries:
+		return 0, fmt.Errorf("--%s %d exceeds the max_batch_entries param %d", flagBatchSize, batchSize, maxEntries)
+	case batchSize == 0:
+		return maxEntries, nil
+	default:
+		return batchSize, nil
+	}
+}
+
+// batchError returns the error of the batch of rows, naming the row of the
+// failing entry when the error tells it.
+func batchError(rows []int, err error) error {
+	if i, ok := types.BatchEntryIndex(err); ok && i < len(rows) {
+		return fmt.Errorf("row %d: %w", rows[i], err)
+	}
+	return fmt.Errorf("%s: %w", rowRange(rows), err)
+}
+
+// rowRange describes the range of the batch of rows.
+func rowRange(rows []int) string {
+	if len(rows) == 1 {
+		return fmt.Sprintf("row %d", rows[0])
+	}
+	return fmt.Sprintf("rows %d-%d", rows[0], rows[len(rows)-1])
+}
+
+// importProgress is the progress file of an import, a JSON record per line.
+type importProgress struct {
+	file *os.File
+	// done holds the imported entries by row.
+	done map[int]importedEntry
+}
+
+// openImportProgress opens the progress file at path, creating it if needed,
+// and loads the imported entries. The batches broadcast without a record of
+// their inclusion are looked up; those that failed or were not included are
+// imported again, as reported to errOut.
+func openImportProgress(clientCtx client.Context, path string, errOut io.Writer) (*importProgress, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	p := &importProgress{file: file, done: make(map[int]importedEntry)}
+
+	var unresolved []importBatch
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxProgressRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		var record importBatch
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			file.Close()
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		if len(record.Ids) == 0 {
+			unresolved = append(unresolved, record)
+			continue
+		}
+		unresolved = slices.DeleteFunc(unresolved, func(b importBatch) bool { return b.TxHash == record.TxHash })
+		p.load(record)
+	}
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	for _, record := range unresolved {
+		res, err := waitForTx(clientCtx, record.TxHash)
+		if err != nil {
+			fmt.Fprintf(errOut, "importing %s again: %s\n", rowRange(record.Rows), err.Error())
+			continue
+		}
+		if _, err := p.complete(record, res); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// load records the entries of an included batch as imported.
+func (p *importProgress) load(record importBatch) {
+	for i, row := range record.Rows {
+		p.done[row] = importedEntry{
+			Row:     row,
+			EntryId: strconv.FormatUint(record.Ids[i], 10),
+			IpfsCid: record.IpfsCids[i],
+			TxHash:  record.TxHash,
+		}
+	}
+}
+
+// complete records the inclusion of the batch with the result res, and
+// returns the created entries.
+func (p *importProgress) complete(record importBatch, res *sdk.TxResponse) ([]importedEntry, error) {
+	responses, err := msgResponses(res)
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) != 1 {
+		return nil, fmt.Errorf("transaction %s has %d message responses", res.TxHash, len(responses))
+	}
+	var created types.MsgCreateEntriesBatchResponse
+	if err := created.Unmarshal(responses[0]); err != nil {
+		return nil, fmt.Errorf("invalid response of transaction %s: %w", res.TxHash, err)
+	}
+	if len(created.Ids) != len(record.Rows) {
+		return nil, fmt.Errorf("transaction %s created %d entries instead of %d", res.TxHash, len(created.Ids), len(record.Rows))
+	}
+
+	record.Ids = created.Ids
+	if err := p.append(record); err != nil {
+		return nil, err
+	}
+	p.load(record)
+
+	imported := make([]importedEntry, len(record.Rows))
+	for i, row := range record.Rows {
+		imported[i] = p.done[row]
+	}
+	return imported, nil
+}
+
+// append writes record to the progress file.
+func (p *importProgress) append(record importBatch) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return p.file.Sync()
+}
+
+// Close closes the progress file.
+func (p *importProgress) Close() error {
+	return p.file.Close()
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestBatchError(t *testing.T) {
+	rows := []int{4, 5, 7}
+	tests := []struct {
+		desc string
+		err  error
+		msg  string
+	}{
+		{
+			desc: "FailingEntry",
+			err:  errors.New("failed to execute message; message index: 0: entry 1: entry already exists"),
+			msg:  "row 5: ",
+		},
+		{
+			desc: "FirstEntry",
+			err:  errors.New("entry 0: invalid title"),
+			msg:  "row 4: ",
+		},
+		{
+			desc: "IndexOutOfBatch",
+			err:  errors.New("entry 3: invalid title"),
+			msg:  "rows 4-7: ",
+		},
+		{
+			desc: "NoIndex",
+			err:  errors.New("insufficient fees"),
+			msg:  "rows 4-7: ",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := batchError(rows, tc.err)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.msg+tc.err.Error(), err.Error())
+		})
+	}
+
+	require.EqualError(t, batchError([]int{9}, errors.New("out of gas")), "row 9: out of gas")
+}
+
+func TestBatchErrorOfKeeper(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		moduletestutil.MakeTestEncodingConfig().Codec,
+		addressCodec,
+		authtypes.NewModuleAddress(types.GovModuleName),
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	require.NoError(t, k.CurrentPeriod.Set(ctx, types.DefaultGenesis().CurrentPeriod))
+
+	creator, err := addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	require.NoError(t, k.Agency.Set(ctx, "NOAA", types.Agency{Id: "NOAA", Name: "NOAA", Publishers: []string{creator}}))
+
+	msg := &types.MsgCreateEntriesBatch{Creator: creator}
+	for _, cid := range []string{"cid-0", "cid-1", "cid-0"} {
+		msg.Entries = append(msg.Entries, types.BatchEntry{Agency: "NOAA", IpfsCid: cid})
+	}
+	_, err = keeper.NewMsgServerImpl(k).CreateEntriesBatch(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDuplicateEntry)
+
+	// the error is read back from the log of the failed transaction
+	codespace, code, log := errorsmod.ABCIInfo(errorsmod.Wrapf(err, "failed to execute message; message index: %d", 0), false)
+	require.Equal(t, types.ModuleName, codespace)
+	txErr := fmt.Errorf("transaction %s failed with code %d: %s", "AB12", code, log)
+	require.EqualError(t, batchError([]int{4, 5, 7}, txErr), "row 7: "+txErr.Error())
+}
+
+func TestOpenImportProgress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manifest.csv.progress")
+	records := []string{
+		`{"txhash":"AA","rows":[1,2],"ipfs_cids":["cid-1","cid-2"]}`,
+		`{"txhash":"AA","rows":[1,2],"ipfs_cids":["cid-1","cid-2"],"ids":[10,11]}`,
+		`{"txhash":"BB","rows":[4],"ipfs_cids":["cid-4"]}`,
+		`{"txhash":"BB","rows":[4],"ipfs_cids":["cid-4"],"ids":[12]}`,
+	}
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(records, "\n")+"\n"), 0o600))
+
+	// the broadcast batches recorded as included are not looked up
+	var errOut bytes.Buffer
+	progress, err := openImportProgress(client.Context{}, path, &errOut)
+	require.NoError(t, err)
+	require.Empty(t, errOut.String())
+	require.Equal(t, map[int]importedEntry{
+		1: {Row: 1, EntryId: "10", IpfsCid: "cid-1", TxHash: "AA"},
+		2: {Row: 2, EntryId: "11", IpfsCid: "cid-2", TxHash: "AA"},
+		4: {Row: 4, EntryId: "12", IpfsCid: "cid-4", TxHash: "BB"},
+	}, progress.done)
+
+	// the records are appended to the file, and loaded when it is reopened
+	require.NoError(t, progress.append(importBatch{TxHash: "CC", Rows: []int{3}, IpfsCids: []string{"cid-3"}, Ids: []uint64{13}}))
+	require.NoError(t, progress.Close())
+	progress, err = openImportProgress(client.Context{}, path, &errOut)
+	require.NoError(t, err)
+	require.Len(t, progress.done, 4)
+	require.Equal(t, importedEntry{Row: 3, EntryId: "13", IpfsCid: "cid-3", TxHash: "CC"}, progress.done[3])
+	require.NoError(t, progress.Close())
+
+	t.Run("New", func(t *testing.T) {
+		progress, err := openImportProgress(client.Context{}, filepath.Join(t.TempDir(), "new.progress"), &errOut)
+		require.NoError(t, err)
+		require.Empty(t, progress.done)
+		require.NoError(t, progress.Close())
+	})
+	t.Run("Corrupted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "corrupted.progress")
+		require.NoError(t, os.WriteFile(path, []byte(records[1]+"\n{\"txhash\":\n"), 0o600))
+		_, err := openImportProgress(client.Context{}, path, &errOut)
+		require.ErrorContains(t, err, path+":2: ")
+	})
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+
+	"govchain/x/datasets/types"
+)
+
+// CmdRespondChallenge returns the command answering a retrievability
+// challenge from a local copy of the challenged content.
+func CmdRespondChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "respond-challenge [challenge-id] [path]",
+		Short: "Answer a retrievability challenge from a local copy of the content",
+		Long: `Answer a retrievability challenge of the signer from a local copy of the
+challenged content.
+
+The UnixFS DAG of the file is rebuilt locally with the parameters of the upload
+flow, the defaults of "ipfs add", and must have the CID of the challenge. The
+chunk holding the challenged byte and the Merkle path to it are broadcast, or
+the root block when the challenged offset is past the content. An invalid
+answer fails the challenge, so the command checks the proof before
+broadcasting it.`,
+		Example: "respond-challenge 7 ./rainfall-2024.csv --from pinner",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid challenge id %q: %w", args[0], err)
+			}
+			res, err := types.NewQueryClient(clientCtx).GetChallenge(cmd.Context(), &types.QueryGetChallengeRequest{Id: id})
+			if err != nil {
+				return err
+			}
+			challenge := res.Challenge
+			root, err := cid.Decode(challenge.Cid)
+			if err != nil {
+				return fmt.Errorf("invalid challenge cid: %w", err)
+			}
+
+			f, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			fileCid, chunk, path, err := types.FileChunkPath(f, challenge.Offset)
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", args[1], err)
+			}
+			if types.NormalizeCid(fileCid.String()) != types.NormalizeCid(challenge.Cid) {
+				return fmt.Errorf("%s has cid %s, not the challenged cid %s", args[1], fileCid, challenge.Cid)
+			}
+			if err := types.VerifyChunkPath(root, challenge.Offset, chunk, path); err != nil {
+				return fmt.Errorf("invalid proof of challenge %d: %w", id, err)
+			}
+
+			msg := &types.MsgRespondChallenge{
+				Pinner:      clientCtx.GetFromAddress().String(),
+				ChallengeId: id,
+				Chunk:       chunk,
+				Path:        path,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,248 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ipfs/go-cid"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"govchain/ipfs"
+	"govchain/x/datasets/types"
+)
+
+const (
+	flagTitle       = "title"
+	flagDescription = "description"
+	flagAgency      = "agency"
+	flagCategory    = "category"
+	flagFileName    = "file-name"
+	flagFileURL     = "file-url"
+	flagFallbackURL = "fallback-url"
+	flagMimeType    = "mime-type"
+	flagSubmitter   = "submitter"
+	flagPublishedAt = "published-at"
+	flagCidOnly     = "cid-only"
+	flagIPFSAPI     = "ipfs-api"
+)
+
+// uploadResult is printed by upload once the entry is created.
+type uploadResult struct {
+	EntryId         string `json:"entry_id"`
+	TxHash          string `json:"txhash"`
+	Height          string `json:"height"`
+	IpfsCid         string `json:"ipfs_cid"`
+	ChecksumSha_256 string `json:"checksum_sha_256"`
+	FileSize        string `json:"file_size"`
+	MimeType        string `json:"mime_type"`
+	Pinned          bool   `json:"pinned"`
+}
+
+// CmdUpload returns the command adding a dataset file to IPFS and publishing
+// an entry for it.
+func CmdUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload [file]",
+		Short: "Add a dataset file to IPFS and publish an entry for it",
+		Long: `Add a dataset file to IPFS and publish an entry for it, in a single command.
+
+The size, SHA-256 checksum and IPFS CID of the file are computed locally, the
+CID with the parameters of the upload flow, the defaults of "ipfs add". The
+media type is sniffed from the content and refined by the file extension for
+the data formats sniffing cannot tell apart, such as CSV; set --mime-type to
+override it.
+
+The blocks of the file are added to the Kubo node whose RPC API is given by
+--ipfs-api, and pinned there, as they are computed. With --cid-only, the file
+is not added anywhere and must be made available by other means.
+
+The entry is then created by the signer and the command waits for the
+transaction to be included, printing the id of the new entry.`,
+		Example: `upload ./rainfall-2024.csv --title "Rainfall 2024" --agency NOAA --category environment --from alice
+upload ./budget.xlsx --title "Budget" --agency treasury --cid-only --fallback-url https://data.example.gov/budget.xlsx --from alice`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var publishedAt time.Time
+			if s, _ := cmd.Flags().GetString(flagPublishedAt); s != "" {
+				publishedAt, err = time.Parse(time.RFC3339, s)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %w", flagPublishedAt, err)
+				}
+			} else if publishedAt, err = latestBlockTime(clientCtx); err != nil {
+				return err
+			}
+
+			cidOnly, _ := cmd.Flags().GetBool(flagCidOnly)
+			var put func(c cid.Cid, block []byte) error
+			var kubo *ipfs.KuboClient
+			if !cidOnly {
+				apiURL, _ := cmd.Flags().GetString(flagIPFSAPI)
+				if kubo, err = ipfs.NewKuboClient(apiURL, nil); err != nil {
+					return err
+				}
+				put = func(c cid.Cid, block []byte) error {
+					return kubo.Put(cmd.Context(), c, block)
+				}
+			}
+			msg, root, err := uploadMsg(cmd.Flags(), args[0], clientCtx.GetFromAddress().String(), publishedAt, put)
+			if err != nil {
+				return err
+			}
+			if kubo != nil {
+				if err := kubo.Pin(cmd.Context(), root); err != nil {
+					return fmt.Errorf("pinning %s: %w", root, err)
+				}
+			}
+
+			res, err := broadcastAndWait(cmd, clientCtx, msg)
+			if err != nil || res == nil {
+				return err
+			}
+			responses, err := msgResponses(res)
+			if err != nil {
+				return err
+			}
+			var created types.MsgCreateEntryResponse
+			if len(responses) != 1 {
+				return fmt.Errorf("transaction %s has %d message responses", res.TxHash, len(responses))
+			}
+			if err := created.Unmarshal(responses[0]); err != nil {
+				return fmt.Errorf("invalid response of transaction %s: %w", res.TxHash, err)
+			}
+
+			out, err := json.Marshal(uploadResult{
+				EntryId:         strconv.FormatUint(created.Id, 10),
+				TxHash:          res.TxHash,
+				Height:          strconv.FormatInt(res.Height, 10),
+				IpfsCid:         msg.IpfsCid,
+				ChecksumSha_256: msg.ChecksumSha_256,
+				FileSize:        strconv.FormatUint(msg.FileSize, 10),
+				MimeType:        msg.MimeType,
+				Pinned:          kubo != nil,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(flagTitle, "", "Title of the dataset (required)")
+	cmd.Flags().String(flagDescription, "", "Description of the dataset")
+	cmd.Flags().String(flagAgency, "", "Id of the publishing agency (required)")
+	cmd.Flags().String(flagCategory, "", "Category of the dataset")
+	cmd.Flags().String(flagFileName, "", "File name recorded by the entry (default the base name of the file)")
+	cmd.Flags().String(flagFileURL, "", "URL the file is published at")
+	cmd.Flags().String(flagFallbackURL, "", "URL of a copy of the file outside IPFS")
+	cmd.Flags().String(flagMimeType, "", "Media type of the file (default sniffed from the content)")
+	cmd.Flags().String(flagSubmitter, "", "Name of the person or system submitting the dataset")
+	cmd.Flags().String(flagPublishedAt, "", "Publication time of the dataset in RFC 3339 (default the time of the latest block)")
+	cmd.Flags().Bool(flagCidOnly, false, "Compute the CID without adding the file to IPFS")
+	cmd.Flags().String(flagIPFSAPI, "http://127.0.0.1:5001", "URL of the RPC API of the Kubo node the file is added to")
+	_ = cmd.MarkFlagRequired(flagTitle)
+	_ = cmd.MarkFlagRequired(flagAgency)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// uploadMsg returns the message creating the entry of the file at path,
+// signed by creator and described by the flags of the upload command, with
+// the CID of the file. The size, checksum and CID of the file are computed as
+// its blocks are passed to put, if set, and its media type is sniffed unless
+// given by the flags.
+func uploadMsg(flagSet *pflag.FlagSet, path, creator string, publishedAt time.Time, put func(c cid.Cid, block []byte) error) (*types.MsgCreateEntry, cid.Cid, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, cid.Undef, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, cid.Undef, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, cid.Undef, fmt.Errorf("%s is not a regular file", path)
+	}
+
+	fileName, _ := flagSet.GetString(flagFileName)
+	if fileName == "" {
+		fileName = filepath.Base(path)
+	}
+	mimeType, _ := flagSet.GetString(flagMimeType)
+	if mimeType == "" {
+		head := make([]byte, types.MimeSniffLength)
+		n, err := io.ReadFull(f, head)
+		if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+			return nil, cid.Undef, err
+		}
+		mimeType = types.SniffMimeType(head[:n], fileName)
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, cid.Undef, err
+		}
+	}
+
+	msg := &types.MsgCreateEntry{
+		Creator:     creator,
+		MimeType:    mimeType,
+		FileName:    fileName,
+		FileSize:    uint64(info.Size()),
+		PublishedAt: publishedAt,
+	}
+	for flag, field := range map[string]*string{
+		flagTitle:       &msg.Title,
+		flagDescription: &msg.Description,
+		flagAgency:      &msg.Agency,
+		flagCategory:    &msg.Category,
+		flagFileURL:     &msg.FileUrl,
+		flagFallbackURL: &msg.FallbackUrl,
+		flagSubmitter:   &msg.Submitter,
+	} {
+		if *field, err = flagSet.GetString(flag); err != nil {
+			return nil, cid.Undef, err
+		}
+	}
+
+	digest, err := types.ImportDigest(f, put)
+	if err != nil {
+		return nil, cid.Undef, fmt.Errorf("adding %s: %w", path, err)
+	}
+	if digest.Size != msg.FileSize {
+		return nil, cid.Undef, fmt.Errorf("%s changed while it was read", path)
+	}
+	msg.IpfsCid = digest.Cid.String()
+	msg.ChecksumSha_256 = digest.Checksum
+	return msg, digest.Cid, nil
+}
+
+// latestBlockTime returns the time of the latest block, the latest
+// publication time an entry can be created with, or the current time when
+// the node is not queried.
+func latestBlockTime(clientCtx client.Context) (time.Time, error) {
+	if clientCtx.Offline || clientCtx.GenerateOnly {
+		return time.Now().UTC().Truncate(time.Second), nil
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return time.Time{}, err
+	}
+	status, err := node.Status(clientCtx.CmdContext)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get the status of the node: %w", err)
+	}
+	return status.SyncInfo.LatestBlockTime.UTC(), nil
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"govchain/ipfs"
+	"govchain/x/datasets/types"
+)
+
+func TestUploadMsg(t *testing.T) {
+	const creator = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+	publishedAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// content of several chunks, so that its DAG has more than a block
+	content := bytes.Repeat([]byte("station,date,rainfall_mm\nmanila,2024-01-01,12.5\n"), 3*types.UnixFSChunkSize/48+100)
+	path := filepath.Join(t.TempDir(), "rainfall-2024.csv")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	sum := sha256.Sum256(content)
+	expected, err := ipfs.Add(context.Background(), ipfs.NewMemStore(), bytes.NewReader(content))
+	require.NoError(t, err)
+
+	t.Run("Added", func(t *testing.T) {
+		flagSet := CmdUpload().Flags()
+		require.NoError(t, flagSet.Set(flagTitle, "Rainfall 2024"))
+		require.NoError(t, flagSet.Set(flagAgency, "NOAA"))
+		require.NoError(t, flagSet.Set(flagCategory, "environment"))
+
+		store := ipfs.NewMemStore()
+		msg, root, err := uploadMsg(flagSet, path, creator, publishedAt, func(c cid.Cid, block []byte) error {
+			return store.Put(context.Background(), c, block)
+		})
+		require.NoError(t, err)
+		require.Equal(t, expected, root)
+		require.Equal(t, &types.MsgCreateEntry{
+			Creator:         creator,
+			Title:           "Rainfall 2024",
+			Agency:          "NOAA",
+			Category:        "environment",
+			IpfsCid:         expected.String(),
+			MimeType:        "text/csv",
+			FileName:        "rainfall-2024.csv",
+			FileSize:        uint64(len(content)),
+			ChecksumSha_256: hex.EncodeToString(sum[:]),
+			PublishedAt:     publishedAt,
+		}, msg)
+		require.NoError(t, msg.ValidateBasic())
+
+		// the blocks of the file were passed to put as they were computed
+		_, path, err := types.ChunkPath(ipfs.GetFunc(context.Background(), store), root, uint64(len(content))-1)
+		require.NoError(t, err)
+		require.NotEmpty(t, path)
+	})
+	t.Run("CidOnly", func(t *testing.T) {
+		flagSet := CmdUpload().Flags()
+		require.NoError(t, flagSet.Set(flagFileName, "rainfall.txt"))
+		require.NoError(t, flagSet.Set(flagMimeType, "application/octet-stream"))
+
+		msg, root, err := uploadMsg(flagSet, path, creator, publishedAt, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, root)
+		require.Equal(t, "rainfall.txt", msg.FileName)
+		require.Equal(t, "application/octet-stream", msg.MimeType)
+	})
+	t.Run("NotRegular", func(t *testing.T) {
+		_, _, err := uploadMsg(CmdUpload().Flags(), t.TempDir(), creator, publishedAt, nil)
+		require.ErrorContains(t, err, "is not a regular file")
+	})
+	t.Run("Missing", func(t *testing.T) {
+		_, _, err := uploadMsg(CmdUpload().Flags(), filepath.Join(t.TempDir(), "missing.csv"), creator, publishedAt, nil)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,120 @@
+// Package prover fetches the state proofs of entries from a node, for clients
+// that do not run the command line.
+package prover
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"govchain/x/datasets/types"
+)
+
+// validatorsPerPage is the largest page size served by the CometBFT RPC.
+const validatorsPerPage = 100
+
+// Node is the CometBFT RPC of the node the proofs are fetched from, as
+// implemented by the HTTP client of CometBFT.
+type Node interface {
+	ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error)
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error)
+	Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error)
+}
+
+// ProveEntry fetches from node the entry with the given id, with a proof of
+// the datasets store at height, the header committing to this state and the
+// validator set that signed it. A zero height proves the state of the block
+// before the latest one. The node must be on the chain chainID, unless it is
+// empty. The returned proof is not verified.
+func ProveEntry(ctx context.Context, node Node, chainID string, id uint64, height int64) (*types.EntryStateProof, error) {
+	if height == 0 {
+		status, err := node.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// the node cannot prove the state of the first block
+		height = status.SyncInfo.LatestBlockHeight - 1
+		if height < 2 {
+			return nil, fmt.Errorf("no provable state at latest height %d", status.SyncInfo.LatestBlockHeight)
+		}
+	}
+
+	key := types.EntryStoreKey(id)
+	query, err := node.ABCIQueryWithOptions(ctx, fmt.Sprintf("/store/%s/key", types.StoreKey), key, rpcclient.ABCIQueryOptions{
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := query.Response
+	if !res.IsOK() {
+		return nil, errorsmod.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+	if len(res.Value) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "entry %d at height %d", id, res.Height)
+	}
+	if res.ProofOps == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidStateProof, "no proof returned for entry %d", id)
+	}
+
+	headerHeight := res.Height + 1
+	commit, err := node.Commit(ctx, &headerHeight)
+	if err != nil {
+		return nil, fmt.Errorf("fetching the header at height %d: %w", headerHeight, err)
+	}
+	if chainID != "" && commit.ChainID != chainID {
+		return nil, fmt.Errorf("node is on chain %q, not %q", commit.ChainID, chainID)
+	}
+
+	validators, err := validatorSet(ctx, node, headerHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry types.Entry
+	if err := entry.Unmarshal(res.Value); err != nil {
+		return nil, err
+	}
+	entryJSON, err := codec.ProtoMarshalJSON(&entry, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EntryStateProof{
+		ChainID:      commit.ChainID,
+		Height:       res.Height,
+		StoreKey:     types.StoreKey,
+		Key:          key,
+		Value:        res.Value,
+		Entry:        entryJSON,
+		Proof:        res.ProofOps,
+		SignedHeader: &commit.SignedHeader,
+		ValidatorSet: validators,
+	}, nil
+}
+
+// validatorSet returns the validator set at height, fetching all its pages.
+func validatorSet(ctx context.Context, node Node, height int64) (*cmttypes.ValidatorSet, error) {
+	var validators []*cmttypes.Validator
+	perPage := validatorsPerPage
+	for page := 1; ; page++ {
+		res, err := node.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("fetching the validators at height %d: %w", height, err)
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+	}
+	return cmttypes.NewValidatorSet(validators), nil
+}
@@ -0,0 +1,166 @@
+package prover_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/bytes"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtprotoversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cmtversion "github.com/cometbft/cometbft/version"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/client/prover"
+	"govchain/x/datasets/types"
+)
+
+// fakeNode serves a multistore holding the datasets store, committed by a
+// header of the next height signed by its validator set.
+type fakeNode struct {
+	store      *rootmulti.Store
+	height     int64
+	header     *cmttypes.SignedHeader
+	validators *cmttypes.ValidatorSet
+	// pageSize bounds the pages of validators, whatever the requested size.
+	pageSize int
+}
+
+func newFakeNode(t *testing.T, entry types.Entry) *fakeNode {
+	t.Helper()
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	// the first state cannot be proven
+	ms.Commit()
+
+	value, err := entry.Marshal()
+	require.NoError(t, err)
+	ms.GetKVStore(key).Set(types.EntryStoreKey(entry.Id), value)
+	commit := ms.Commit()
+
+	valSet, privVals := cmttypes.RandValidatorSet(5, 10)
+	header := cmttypes.Header{
+		Version:            cmtprotoversion.Consensus{Block: cmtversion.BlockProtocol},
+		ChainID:            "govchain",
+		Height:             commit.Version + 1,
+		Time:               time.Now(),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            commit.Hash,
+		ProposerAddress:    valSet.Proposer.Address,
+	}
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := cmttypes.NewVoteSet(header.ChainID, header.Height, 0, cmtproto.PrecommitType, valSet)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, header.Height, 0, voteSet, privVals, header.Time, false)
+	require.NoError(t, err)
+
+	return &fakeNode{
+		store:      ms,
+		height:     commit.Version,
+		header:     &cmttypes.SignedHeader{Header: &header, Commit: extCommit.ToCommit()},
+		validators: valSet,
+		pageSize:   2,
+	}
+}
+
+func (n *fakeNode) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res, err := n.store.Query(&storetypes.RequestQuery{
+		Path:   path[len("/store"):],
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+		Key:      res.Key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}}, nil
+}
+
+func (n *fakeNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: n.height + 1}}, nil
+}
+
+func (n *fakeNode) Commit(_ context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	if *height != n.header.Height {
+		return nil, fmt.Errorf("no header at height %d", *height)
+	}
+	return coretypes.NewResultCommit(n.header.Header, n.header.Commit, true), nil
+}
+
+func (n *fakeNode) Validators(_ context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	if *height != n.header.Height {
+		return nil, fmt.Errorf("no validators at height %d", *height)
+	}
+	size := min(*perPage, n.pageSize)
+	start := min((*page-1)*size, n.validators.Size())
+	end := min(start+size, n.validators.Size())
+	return &coretypes.ResultValidators{
+		BlockHeight: *height,
+		Validators:  n.validators.Validators[start:end],
+		Count:       end - start,
+		Total:       n.validators.Size(),
+	}, nil
+}
+
+func TestProveEntry(t *testing.T) {
+	entry := types.Entry{Id: 7, Title: "title", Agency: "NOAA"}
+	node := newFakeNode(t, entry)
+
+	proof, err := prover.ProveEntry(context.Background(), node, "govchain", entry.Id, 0)
+	require.NoError(t, err)
+	require.Equal(t, node.height, proof.Height)
+	var entryJSON map[string]any
+	require.NoError(t, json.Unmarshal(proof.Entry, &entryJSON))
+	require.Equal(t, "title", entryJSON["title"])
+	// the validator set is fetched across its pages
+	require.Equal(t, node.validators.Hash(), proof.ValidatorSet.Hash())
+	require.NoError(t, proof.VerifyTrusted(node.header.AppHash, node.header.ValidatorsHash))
+
+	decoded, err := proof.DecodeEntry()
+	require.NoError(t, err)
+	require.Equal(t, entry.Title, decoded.Title)
+
+	t.Run("AtHeight", func(t *testing.T) {
+		proof, err := prover.ProveEntry(context.Background(), node, "", entry.Id, node.height)
+		require.NoError(t, err)
+		require.Equal(t, node.height, proof.Height)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		_, err := prover.ProveEntry(context.Background(), node, "govchain", entry.Id+1, 0)
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("OtherChain", func(t *testing.T) {
+		_, err := prover.ProveEntry(context.Background(), node, "other", entry.Id, 0)
+		require.ErrorContains(t, err, `node is on chain "govchain", not "other"`)
+	})
+	t.Run("NoProvableState", func(t *testing.T) {
+		node := *node
+		node.height = 0
+		_, err := prover.ProveEntry(context.Background(), &node, "govchain", entry.Id, 0)
+		require.ErrorContains(t, err, "no provable state")
+	})
+}
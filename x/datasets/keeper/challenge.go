@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/rand/v2"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ipfs/go-cid"
+
+	"govchain/x/datasets/types"
+)
+
+// challengeRand returns the source of the challenges of the current block,
+// seeded with the block hash so that every validator picks the same ones
+// while pinners cannot predict them.
+func challengeRand(ctx context.Context) *rand.Rand {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	h := sha256.New()
+	h.Write(sdkCtx.HeaderHash())
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(sdkCtx.BlockHeight())))
+	var seed [32]byte
+	copy(seed[:], h.Sum(nil))
+	return rand.New(rand.NewChaCha8(seed))
+}
+
+// issueChallenges picks params.ChallengesPerBlock entries among the pinned
+// ones and challenges each of their pinners to prove the retrievability of a
+// random byte of the content. The pinners with an open challenge for the
+// entry are not challenged again.
+func (k Keeper) issueChallenges(ctx context.Context, params types.Params) error {
+	if params.ChallengesPerBlock == 0 {
+		return nil
+	}
+	next, err := k.EntrySeq.Peek(ctx)
+	if err != nil || next == 0 {
+		return err
+	}
+
+	r := challengeRand(ctx)
+	for range params.ChallengesPerBlock {
+		id, found, err := k.pinnedEntryFrom(ctx, r.Uint64N(next))
+		if err != nil || !found {
+			return err
+		}
+		entry, err := k.Entry.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		// The offsets are drawn from the declared size, an offset past
+		// the content of an oversized declaration being proven by the
+		// root block, and only the codecs of UnixFS files can be proven.
+		root, err := cid.Decode(entry.IpfsCid)
+		if err != nil || entry.FileSize == 0 || (root.Type() != cid.DagProtobuf && root.Type() != cid.Raw) {
+			continue
+		}
+
+		atts, err := k.pinAttestations(ctx, id)
+		if err != nil {
+			return err
+		}
+		for _, att := range atts {
+			open, err := k.hasOpenChallenge(ctx, att.Pinner, id)
+			if err != nil {
+				return err
+			}
+			if open {
+				continue
+			}
+			if err := k.issueChallenge(ctx, params, entry, att.Pinner, r.Uint64N(entry.FileSize)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pinnedEntryFrom returns the id of the first entry with a pin attestation
+// from id on, wrapping around to the first pinned entry.
+func (k Keeper) pinnedEntryFrom(ctx context.Context, id uint64) (uint64, bool, error) {
+	for _, ranger := range []collections.Ranger[collections.Pair[uint64, string]]{
+		new(collections.Range[collections.Pair[uint64, string]]).StartInclusive(collections.Join(id, "")),
+		nil,
+	} {
+		iter, err := k.PinAttestation.Iterate(ctx, ranger)
+		if err != nil {
+			return 0, false, err
+		}
+		valid := iter.Valid()
+		var key collections.Pair[uint64, string]
+		if valid {
+			key, err = iter.Key()
+		}
+		iter.Close()
+		if err != nil {
+			return 0, false, err
+		}
+		if valid {
+			return key.K1(), true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// hasOpenChallenge reports whether pinner has an open challenge for the entry
+// with the given id.
+func (k Keeper) hasOpenChallenge(ctx context.Context, pinner string, id uint64) (bool, error) {
+	iter, err := k.PinnerChallenges.Iterate(ctx, collections.NewSuperPrefixedTripleRange[string, uint64, uint64](pinner, id))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+func (k Keeper) issueChallenge(ctx context.Context, params types.Params, entry types.Entry, pinner string, offset uint64) error {
+	id, err := k.ChallengeSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	challenge := types.Challenge{
+		Id:             id,
+		EntryId:        entry.Id,
+		Pinner:         pinner,
+		Cid:            entry.IpfsCid,
+		Offset:         offset,
+		IssuedHeight:   height,
+		DeadlineHeight: height + int64(params.ChallengeResponseBlocks),
+	}
+	if err := k.setChallenge(ctx, challenge); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventChallengeIssued{
+		ChallengeId:    challenge.Id,
+		EntryId:        challenge.EntryId,
+		Pinner:         challenge.Pinner,
+		Cid:            challenge.Cid,
+		Offset:         challenge.Offset,
+		DeadlineHeight: challenge.DeadlineHeight,
+	})
+}
+
+// expireChallenges fails the challenges whose deadline has passed.
+func (k Keeper) expireChallenges(ctx context.Context, params types.Params) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var expired []uint64
+	if err := k.ChallengeDeadlineQueue.Walk(ctx, collections.NewPrefixUntilPairRange[int64, uint64](height-1), func(key collections.Pair[int64, uint64]) (bool, error) {
+		expired = append(expired, key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		challenge, err := k.Challenge.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if _, err := k.resolveChallenge(ctx, params, challenge, errors.New("missed the deadline")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveChallenge closes challenge, which is passed unless failure is set,
+// and records the outcome in the score of the pinner. It returns the pinner.
+func (k Keeper) resolveChallenge(ctx context.Context, params types.Params, challenge types.Challenge, failure error) (types.Pinner, error) {
+	if err := k.removeChallenge(ctx, challenge); err != nil {
+		return types.Pinner{}, err
+	}
+
+	pinner, err := k.Pinner.Get(ctx, challenge.Pinner)
+	if err != nil {
+		return types.Pinner{}, err
+	}
+	reason := ""
+	if failure == nil {
+		pinner.PassChallenge()
+	} else {
+		pinner.FailChallenge(params.ChallengeFailurePenalty)
+		reason = failure.Error()
+	}
+	if err := k.Pinner.Set(ctx, pinner.Address, pinner); err != nil {
+		return types.Pinner{}, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventChallengeResolved{
+		ChallengeId: challenge.Id,
+		EntryId:     challenge.EntryId,
+		Pinner:      challenge.Pinner,
+		Passed:      failure == nil,
+		Reason:      reason,
+		Reputation:  pinner.Reputation,
+	}); err != nil {
+		return types.Pinner{}, err
+	}
+	return pinner, nil
+}
+
+// setChallenge stores challenge and its indexes.
+func (k Keeper) setChallenge(ctx context.Context, challenge types.Challenge) error {
+	if err := k.Challenge.Set(ctx, challenge.Id, challenge); err != nil {
+		return err
+	}
+	if err := k.ChallengeDeadlineQueue.Set(ctx, collections.Join(challenge.DeadlineHeight, challenge.Id)); err != nil {
+		return err
+	}
+	return k.PinnerChallenges.Set(ctx, collections.Join3(challenge.Pinner, challenge.EntryId, challenge.Id))
+}
+
+// clearChallenges removes the open challenges for the entry with the given
+// id, without resolving them.
+func (k Keeper) clearChallenges(ctx context.Context, id uint64) error {
+	iter, err := k.Challenge.Indexes.Entry.MatchExact(ctx, id)
+	if err != nil {
+		return err
+	}
+	challengeIds, err := iter.PrimaryKeys()
+	if err != nil {
+		return err
+	}
+
+	for _, challengeId := range challengeIds {
+		challenge, err := k.Challenge.Get(ctx, challengeId)
+		if err != nil {
+			return err
+		}
+		if err := k.removeChallenge(ctx, challenge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeChallenge removes challenge and its indexes.
+func (k Keeper) removeChallenge(ctx context.Context, challenge types.Challenge) error {
+	if err := k.ChallengeDeadlineQueue.Remove(ctx, collections.Join(challenge.DeadlineHeight, challenge.Id)); err != nil {
+		return err
+	}
+	if err := k.PinnerChallenges.Remove(ctx, collections.Join3(challenge.Pinner, challenge.EntryId, challenge.Id)); err != nil {
+		return err
+	}
+	return k.Challenge.Remove(ctx, challenge.Id)
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// SetEntry stores entry and updates the state derived from it. It fails with
+// ErrDuplicateEntry if the entry is not a mirror and its CID or checksum is
+// registered by another entry.
+func (k Keeper) SetEntry(ctx context.Context, entry types.Entry) error {
+	var (
+		oldEntry            *types.Entry
+		oldTerms            map[string]uint32
+		oldCid, oldChecksum string
+		newCid, newChecksum = uniqueKeys(entry)
+	)
+	old, err := k.Entry.Get(ctx, entry.Id)
+	switch {
+	case err == nil:
+		oldEntry = &old
+		oldTerms = old.SearchTerms()
+		oldCid, oldChecksum = uniqueKeys(old)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.updateUniqueIndex(ctx, k.EntryByCid, types.FieldIpfsCid, entry.Id, oldCid, newCid); err != nil {
+		return err
+	}
+	if err := k.updateUniqueIndex(ctx, k.EntryByChecksum, types.FieldChecksumSha256, entry.Id, oldChecksum, newChecksum); err != nil {
+		return err
+	}
+	if err := k.updateMirrorIndex(ctx, entry.Id, oldEntry, &entry); err != nil {
+		return err
+	}
+	if err := k.Entry.Set(ctx, entry.Id, entry); err != nil {
+		return err
+	}
+	if err := k.updateStats(ctx, oldEntry, &entry); err != nil {
+		return err
+	}
+	return k.updateSearchIndex(ctx, entry.Id, oldTerms, entry.SearchTerms())
+}
+
+// RemoveEntry removes the entry with the given id and the state derived from it.
+func (k Keeper) RemoveEntry(ctx context.Context, id uint64) error {
+	old, err := k.Entry.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	oldCid, oldChecksum := uniqueKeys(old)
+	if err := k.updateUniqueIndex(ctx, k.EntryByCid, types.FieldIpfsCid, id, oldCid, ""); err != nil {
+		return err
+	}
+	if err := k.updateUniqueIndex(ctx, k.EntryByChecksum, types.FieldChecksumSha256, id, oldChecksum, ""); err != nil {
+		return err
+	}
+	if err := k.updateMirrorIndex(ctx, id, &old, nil); err != nil {
+		return err
+	}
+	if err := k.Entry.Remove(ctx, id); err != nil {
+		return err
+	}
+	if err := k.updateStats(ctx, &old, nil); err != nil {
+		return err
+	}
+	return k.updateSearchIndex(ctx, id, old.SearchTerms(), nil)
+}
+
+// uniqueKeys returns the normalized CID and checksum under which entry is
+// registered in the unique indexes. Mirrors and unset values are not
+// registered.
+func uniqueKeys(entry types.Entry) (cid, checksum string) {
+	if entry.IsMirror() {
+		return "", ""
+	}
+	return types.NormalizeCid(entry.IpfsCid), types.NormalizeChecksum(entry.ChecksumSha_256)
+}
+
+// entryByUniqueKey returns the id of the entry registered under key in idx.
+func entryByUniqueKey(ctx context.Context, idx collections.Map[string, uint64], key string) (uint64, bool, error) {
+	if key == "" {
+		return 0, false, nil
+	}
+	id, err := idx.Get(ctx, key)
+	switch {
+	case err == nil:
+		return id, true, nil
+	case errors.Is(err, collections.ErrNotFound):
+		return 0, false, nil
+	default:
+		return 0, false, err
+	}
+}
+
+// updateUniqueIndex moves the registration of entry id in idx from oldKey to
+// newKey.
+func (k Keeper) updateUniqueIndex(ctx context.Context, idx collections.Map[string, uint64], field string, id uint64, oldKey, newKey string) error {
+	if oldKey == newKey {
+		return nil
+	}
+
+	owner, found, err := entryByUniqueKey(ctx, idx, newKey)
+	if err != nil {
+		return err
+	}
+	if found && owner != id {
+		return errorsmod.Wrapf(types.ErrDuplicateEntry, "%s %s is registered by entry %d", field, newKey, owner)
+	}
+
+	owner, found, err = entryByUniqueKey(ctx, idx, oldKey)
+	if err != nil {
+		return err
+	}
+	if found && owner == id {
+		if err := idx.Remove(ctx, oldKey); err != nil {
+			return err
+		}
+	}
+
+	if newKey == "" {
+		return nil
+	}
+	return idx.Set(ctx, newKey, id)
+}
+
+// updateMirrorIndex moves the registration of entry id in the mirror index
+// from the entry mirrored by oldEntry to the one mirrored by newEntry. Either
+// entry may be nil.
+func (k Keeper) updateMirrorIndex(ctx context.Context, id uint64, oldEntry, newEntry *types.Entry) error {
+	if oldEntry != nil && oldEntry.IsMirror() {
+		if err := k.EntryMirrors.Remove(ctx, collections.Join(oldEntry.MirrorOf.EntryId, id)); err != nil {
+			return err
+		}
+	}
+	if newEntry != nil && newEntry.IsMirror() {
+		return k.EntryMirrors.Set(ctx, collections.Join(newEntry.MirrorOf.EntryId, id))
+	}
+	return nil
+}
+
+// mirrorsOf returns the ids of the mirrors of the entry with the given id.
+func (k Keeper) mirrorsOf(ctx context.Context, id uint64) ([]uint64, error) {
+	var mirrors []uint64
+	err := k.EntryMirrors.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](id), func(key collections.Pair[uint64, uint64]) (bool, error) {
+		mirrors = append(mirrors, key.K2())
+		return false, nil
+	})
+	return mirrors, err
+}
+
+// updateSearchIndex replaces the oldTerms of entry id by newTerms in the
+// search index. Terms are written in sorted order to keep state transitions
+// deterministic.
+func (k Keeper) updateSearchIndex(ctx context.Context, id uint64, oldTerms, newTerms map[string]uint32) error {
+	for _, term := range slices.Sorted(maps.Keys(oldTerms)) {
+		if _, ok := newTerms[term]; !ok {
+			if err := k.EntrySearchIndex.Remove(ctx, collections.Join(term, id)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, term := range slices.Sorted(maps.Keys(newTerms)) {
+		weight := newTerms[term]
+		if oldTerms[term] == weight {
+			continue
+		}
+		if err := k.EntrySearchIndex.Set(ctx, collections.Join(term, id), weight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDuplicate returns an error if entry duplicates the content of another
+// entry without being linked to it as a mirror, or if its mirror link is
+// invalid.
+func (k Keeper) checkDuplicate(ctx context.Context, entry types.Entry) error {
+	if entry.IsMirror() {
+		target, err := k.Entry.Get(ctx, entry.MirrorOf.EntryId)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return errorsmod.Wrapf(types.ErrInvalidMirror, "entry %d doesn't exist", entry.MirrorOf.EntryId)
+			}
+			return err
+		}
+		if target.IsMirror() {
+			return errorsmod.Wrapf(types.ErrInvalidMirror, "entry %d is itself a mirror of entry %d", target.Id, target.MirrorOf.EntryId)
+		}
+		sameCid := entry.IpfsCid != "" && types.NormalizeCid(entry.IpfsCid) == types.NormalizeCid(target.IpfsCid)
+		sameChecksum := entry.ChecksumSha_256 != "" && types.NormalizeChecksum(entry.ChecksumSha_256) == types.NormalizeChecksum(target.ChecksumSha_256)
+		if !sameCid && !sameChecksum {
+			return errorsmod.Wrapf(types.ErrInvalidMirror, "entry %d has neither the same ipfs_cid nor the same checksum_sha_256", target.Id)
+		}
+		return nil
+	}
+
+	cid, checksum := uniqueKeys(entry)
+	for _, key := range []struct {
+		idx   collections.Map[string, uint64]
+		field string
+		value string
+	}{
+		{k.EntryByCid, types.FieldIpfsCid, cid},
+		{k.EntryByChecksum, types.FieldChecksumSha256, checksum},
+	} {
+		owner, found, err := entryByUniqueKey(ctx, key.idx, key.value)
+		if err != nil {
+			return err
+		}
+		if found && owner != entry.Id {
+			return errorsmod.Wrapf(types.ErrDuplicateEntry, "%s %s is registered by entry %d; set mirror_of to publish a mirror", key.field, key.value, owner)
+		}
+	}
+	return nil
+}
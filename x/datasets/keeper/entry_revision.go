@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// appendRevision records entry, as just written by editor, as a new immutable
+// revision of its history, and marks the entry as changed during the current
+// reporting period. previousCid is the IPFS CID of the revision it supersedes.
+func (k Keeper) appendRevision(ctx context.Context, entry types.Entry, previousCid, editor, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.PeriodChanges.Set(ctx, entry.Id); err != nil {
+		return err
+	}
+	return k.EntryRevision.Set(ctx, collections.Join(entry.Id, entry.Revision), types.EntryRevision{
+		EntryId:         entry.Id,
+		Revision:        entry.Revision,
+		PreviousIpfsCid: previousCid,
+		Editor:          editor,
+		BlockHeight:     sdkCtx.BlockHeight(),
+		TxHash:          txHash(sdkCtx),
+		ChangeReason:    reason,
+		Entry:           entry,
+	})
+}
+
+// setUpdated records the current transaction as the last modification of entry.
+func setUpdated(ctx sdk.Context, entry *types.Entry) {
+	entry.UpdatedTxHash = txHash(ctx)
+	entry.UpdatedHeight = ctx.BlockHeight()
+	entry.UpdatedAt = ctx.BlockTime()
+}
+
+// txHash returns the hex encoded hash of the transaction being executed, or
+// the empty string outside of a transaction.
+func txHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+)
+
+// statsKey identifies a statistics bucket. Unlike collections.Pair, it can be
+// used as a map key.
+type statsKey struct {
+	dimension, key string
+}
+
+// entryStats holds entry counters computed from the stored entries.
+type entryStats struct {
+	totals  types.EntryTotals
+	buckets map[statsKey]types.EntryCount
+}
+
+// updateStats replaces the contribution of old to the entry counters by the
+// contribution of new. Either may be nil.
+func (k Keeper) updateStats(ctx context.Context, old, new *types.Entry) error {
+	totals, err := k.EntryTotals.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if old != nil {
+		if err := k.countEntry(ctx, &totals, *old, false); err != nil {
+			return err
+		}
+	}
+	if new != nil {
+		if err := k.countEntry(ctx, &totals, *new, true); err != nil {
+			return err
+		}
+	}
+	return k.EntryTotals.Set(ctx, totals)
+}
+
+// countEntry adds entry to the counters, or removes it from them. Buckets
+// left without entries are removed.
+func (k Keeper) countEntry(ctx context.Context, totals *types.EntryTotals, entry types.Entry, add bool) error {
+	if entry.IsRetracted() {
+		totals.RetractedEntries = adjustCounter(totals.RetractedEntries, 1, add)
+		return nil
+	}
+	totals.Entries = adjustCounter(totals.Entries, 1, add)
+	totals.Bytes = adjustCounter(totals.Bytes, entry.FileSize, add)
+
+	for _, dimension := range types.StatsDimensions {
+		key := collections.Join(dimension, entry.StatsKey(dimension))
+		count, err := k.EntryStats.Get(ctx, key)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		count.Entries = adjustCounter(count.Entries, 1, add)
+		count.Bytes = adjustCounter(count.Bytes, entry.FileSize, add)
+		if count.Entries == 0 {
+			if err := k.EntryStats.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.EntryStats.Set(ctx, key, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// adjustCounter adds delta to v, or subtracts it. Counters don't go below
+// zero; a mismatch with the stored entries is reported by the stats
+// invariant.
+func adjustCounter(v, delta uint64, add bool) uint64 {
+	switch {
+	case add:
+		return v + delta
+	case delta > v:
+		return 0
+	default:
+		return v - delta
+	}
+}
+
+// computeStats recomputes the entry counters from the stored entries.
+func (k Keeper) computeStats(ctx context.Context) (entryStats, error) {
+	stats := entryStats{buckets: make(map[statsKey]types.EntryCount)}
+	err := k.Entry.Walk(ctx, nil, func(_ uint64, entry types.Entry) (bool, error) {
+		if entry.IsRetracted() {
+			stats.totals.RetractedEntries++
+			return false, nil
+		}
+		stats.totals.Entries++
+		stats.totals.Bytes += entry.FileSize
+		for _, dimension := range types.StatsDimensions {
+			key := statsKey{dimension, entry.StatsKey(dimension)}
+			count := stats.buckets[key]
+			count.Entries++
+			count.Bytes += entry.FileSize
+			stats.buckets[key] = count
+		}
+		return false, nil
+	})
+	return stats, err
+}
+
+// sortedKeys returns the bucket keys of s in (dimension, key) order.
+func (s entryStats) sortedKeys() []statsKey {
+	keys := slices.Collect(maps.Keys(s.buckets))
+	slices.SortFunc(keys, func(a, b statsKey) int {
+		return cmp.Or(cmp.Compare(a.dimension, b.dimension), cmp.Compare(a.key, b.key))
+	})
+	return keys
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+// typedEvents decodes the typed events emitted on the event manager of ctx.
+func typedEvents(t *testing.T, ctx sdk.Context) []proto.Message {
+	t.Helper()
+
+	var msgs []proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+func TestEntryEvents(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	newCtx := func() sdk.Context {
+		return sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	}
+
+	ctx := newCtx()
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Category: "climate", IpfsCid: "cid-1"})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventEntryCreated{
+		EntryId:  resp.Id,
+		Agency:   "NOAA",
+		Category: "climate",
+		IpfsCid:  "cid-1",
+		Creator:  creator,
+	}}, typedEvents(t, ctx))
+
+	ctx = newCtx()
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, Agency: "NOAA", Category: "climate", IpfsCid: "cid-2", Title: "title"})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventEntryUpdated{
+		EntryId:       resp.Id,
+		Agency:        "NOAA",
+		Category:      "climate",
+		IpfsCid:       "cid-2",
+		Creator:       creator,
+		Revision:      2,
+		ChangedFields: []string{"title", "ipfs_cid"},
+	}}, typedEvents(t, ctx))
+
+	ctx = newCtx()
+	_, err = srv.DeleteEntry(ctx, &types.MsgDeleteEntry{Creator: creator, Id: resp.Id, Reason: "wrong file"})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventEntryDeleted{
+		EntryId:  resp.Id,
+		Agency:   "NOAA",
+		Category: "climate",
+		IpfsCid:  "cid-2",
+		Creator:  creator,
+		Reason:   "wrong file",
+	}}, typedEvents(t, ctx))
+
+	ctx = newCtx()
+	_, err = srv.PurgeEntry(ctx, &types.MsgPurgeEntry{Authority: authority, Id: resp.Id, Reason: "court order"})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventEntryDeleted{
+		EntryId:  resp.Id,
+		Agency:   "NOAA",
+		Category: "climate",
+		IpfsCid:  "cid-2",
+		Creator:  creator,
+		Reason:   "court order",
+		Purged:   true,
+	}}, typedEvents(t, ctx))
+
+	// failed transitions emit nothing
+	ctx = newCtx()
+	_, err = srv.DeleteEntry(ctx, &types.MsgDeleteEntry{Creator: creator, Id: resp.Id, Reason: "wrong file"})
+	require.Error(t, err)
+	require.Empty(t, typedEvents(t, ctx))
+}
+
+func TestParamsUpdatedEvent(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.MaxFileSizeBytes = 1 << 30
+	params.AllowedMimeTypes = []string{} // typed events decode empty lists as non-nil
+	params.AllowedCategories = []string{"climate"}
+	params.MaxAllowedPinners = 10
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	_, err = srv.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventParamsUpdated{
+		Authority:     authority,
+		Params:        params,
+		ChangedFields: []string{"max_file_size_bytes", "allowed_categories", "max_allowed_pinners"},
+	}}, typedEvents(t, ctx))
+}
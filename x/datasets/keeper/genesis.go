@@ -3,20 +3,88 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"govchain/x/datasets/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	for _, elem := range genState.EntryList {
-		if err := k.Entry.Set(ctx, elem.Id, elem); err != nil {
+		if err := k.SetEntry(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.AgencyList {
+		if err := k.Agency.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.EntryRevisionList {
+		if err := k.EntryRevision.Set(ctx, collections.Join(elem.EntryId, elem.Revision), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.PeriodRootList {
+		if err := k.PeriodRoot.Set(ctx, elem.PeriodId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.PeriodLeafList {
+		if err := k.PeriodLeaf.Set(ctx, collections.Join(elem.PeriodId, elem.EntryId), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, address := range genState.AllowedPinners {
+		addr, err := k.addressCodec.StringToBytes(address)
+		if err != nil {
+			return err
+		}
+		if err := k.AllowedPinners.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.PinnerList {
+		if err := k.Pinner.Set(ctx, elem.Address, elem); err != nil {
 			return err
 		}
 	}
 
+	for _, elem := range genState.PinAttestationList {
+		if err := k.setPinAttestation(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ChallengeList {
+		if err := k.setChallenge(ctx, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range genState.PeriodChanges {
+		if err := k.PeriodChanges.Set(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	if err := k.CurrentPeriod.Set(ctx, genState.CurrentPeriod); err != nil {
+		return err
+	}
+
 	if err := k.EntrySeq.Set(ctx, genState.EntryCount); err != nil {
 		return err
 	}
+	if err := k.ChallengeSeq.Set(ctx, genState.ChallengeCount); err != nil {
+		return err
+	}
 	return k.Params.Set(ctx, genState.Params)
 }
 
@@ -37,10 +105,96 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.Agency.Walk(ctx, nil, func(key string, elem types.Agency) (bool, error) {
+		genesis.AgencyList = append(genesis.AgencyList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.EntryRevision.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.EntryRevision) (bool, error) {
+		genesis.EntryRevisionList = append(genesis.EntryRevisionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PeriodRoot.Walk(ctx, nil, func(_ uint64, elem types.PeriodRoot) (bool, error) {
+		genesis.PeriodRootList = append(genesis.PeriodRootList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PeriodLeaf.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.PeriodLeaf) (bool, error) {
+		genesis.PeriodLeafList = append(genesis.PeriodLeafList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.AllowedPinners.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		address, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		genesis.AllowedPinners = append(genesis.AllowedPinners, address)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Pinner.Walk(ctx, nil, func(_ string, elem types.Pinner) (bool, error) {
+		genesis.PinnerList = append(genesis.PinnerList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PinAttestation.Walk(ctx, nil, func(_ collections.Pair[uint64, string], elem types.PinAttestation) (bool, error) {
+		genesis.PinAttestationList = append(genesis.PinAttestationList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Challenge.Walk(ctx, nil, func(_ uint64, elem types.Challenge) (bool, error) {
+		genesis.ChallengeList = append(genesis.ChallengeList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PeriodChanges.Walk(ctx, nil, func(id uint64) (bool, error) {
+		genesis.PeriodChanges = append(genesis.PeriodChanges, id)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.CurrentPeriod, err = k.CurrentPeriod.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	genesis.EntryCount, err = k.EntrySeq.Peek(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	genesis.ChallengeCount, err = k.ChallengeSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
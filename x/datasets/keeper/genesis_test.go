@@ -5,17 +5,41 @@ import (
 
 	"govchain/x/datasets/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+	allowed, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+
 	genesisState := types.GenesisState{
 		Params:     types.DefaultParams(),
-		EntryList:  []types.Entry{{Id: 0}, {Id: 1}},
+		EntryList:  []types.Entry{{Id: 0}, {Id: 1, IpfsCid: "cid"}},
 		EntryCount: 2,
+		EntryRevisionList: []types.EntryRevision{
+			{EntryId: 0, Revision: 1, Entry: types.Entry{Id: 0, Revision: 1}},
+			{EntryId: 0, Revision: 2, PreviousIpfsCid: "cid", Entry: types.Entry{Id: 0, Revision: 2}},
+		},
+		AgencyList: []types.Agency{{Id: "NASA", Name: "National Aeronautics and Space Administration"}, {Id: "NOAA", Name: "National Oceanic and Atmospheric Administration"}},
+		PeriodRootList: []types.PeriodRoot{
+			{PeriodId: 1, Root: []byte{1}, StartHeight: 1, EndHeight: 10, EntryCount: 1},
+		},
+		PeriodLeafList: []types.PeriodLeaf{{PeriodId: 1, EntryId: 0, ContentHash: []byte{2}}},
+		CurrentPeriod:  types.CurrentPeriod{PeriodId: 2, StartHeight: 11, EpochEnded: true},
+		PeriodChanges:  []uint64{1},
+		PinnerList:     []types.Pinner{{Address: "pinner", Moniker: "pinner", RegisteredHeight: 3, Reputation: 500, ChallengesPassed: 2}},
+		PinAttestationList: []types.PinAttestation{
+			{EntryId: 1, Pinner: "pinner", Cid: "cid", ProofHeight: 4, AttestedHeight: 5, ExpiresHeight: 9},
+		},
+		ChallengeList: []types.Challenge{
+			{Id: 2, EntryId: 1, Pinner: "pinner", Cid: "cid", Offset: 7, IssuedHeight: 6, DeadlineHeight: 8},
+		},
+		ChallengeCount: 3,
+		AllowedPinners: []string{allowed},
 	}
-	f := initFixture(t)
-	err := f.keeper.InitGenesis(f.ctx, genesisState)
+	err = f.keeper.InitGenesis(f.ctx, genesisState)
 	require.NoError(t, err)
 	got, err := f.keeper.ExportGenesis(f.ctx)
 	require.NoError(t, err)
@@ -24,5 +48,32 @@ func TestGenesis(t *testing.T) {
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.EntryList, got.EntryList)
 	require.Equal(t, genesisState.EntryCount, got.EntryCount)
+	require.EqualExportedValues(t, genesisState.AgencyList, got.AgencyList)
+	require.EqualExportedValues(t, genesisState.EntryRevisionList, got.EntryRevisionList)
+	require.EqualExportedValues(t, genesisState.PeriodRootList, got.PeriodRootList)
+	require.EqualExportedValues(t, genesisState.PeriodLeafList, got.PeriodLeafList)
+	require.Equal(t, genesisState.CurrentPeriod, got.CurrentPeriod)
+	require.Equal(t, genesisState.PeriodChanges, got.PeriodChanges)
+	require.EqualExportedValues(t, genesisState.PinnerList, got.PinnerList)
+	require.EqualExportedValues(t, genesisState.PinAttestationList, got.PinAttestationList)
+	require.EqualExportedValues(t, genesisState.ChallengeList, got.ChallengeList)
+	require.Equal(t, genesisState.ChallengeCount, got.ChallengeCount)
+	require.Equal(t, genesisState.AllowedPinners, got.AllowedPinners)
 
+	// the imported attestations are counted, and expire
+	pinCount, err := f.keeper.PinCount.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), pinCount)
+	require.NoError(t, f.keeper.BeginBlock(sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(9)))
+	found, err := f.keeper.PinCount.Has(f.ctx, 1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// and the imported challenges are missed
+	pinner, err := f.keeper.Pinner.Get(f.ctx, "pinner")
+	require.NoError(t, err)
+	require.Equal(t, uint64(500-types.DefaultChallengeFailurePenalty), pinner.Reputation)
+	found, err = f.keeper.Challenge.Has(f.ctx, 2)
+	require.NoError(t, err)
+	require.False(t, found)
 }
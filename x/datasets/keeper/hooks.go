@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks implements the x/epochs hooks, which end the reporting periods that
+// follow an epoch.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the epoch hooks of the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd flags the current reporting period to be closed by the next
+// EndBlock when the ended epoch is the snapshot epoch.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.SnapshotEpochIdentifier == "" || params.SnapshotEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	current, err := h.k.CurrentPeriod.Get(ctx)
+	if err != nil {
+		return err
+	}
+	current.EpochEnded = true
+	return h.k.CurrentPeriod.Set(ctx, current)
+}
+
+// BeforeEpochStart implements the x/epochs hooks.
+func (h Hooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
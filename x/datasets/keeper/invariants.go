@@ -0,0 +1,350 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Invariant routes of the datasets module.
+const (
+	EntrySequenceInvariantRoute = "entry-sequence"
+	EntryIndexesInvariantRoute  = "entry-indexes"
+	EntryCreatorInvariantRoute  = "entry-creator"
+	EntryStatsInvariantRoute    = "entry-stats"
+	PeriodRootsInvariantRoute   = "period-roots"
+	PinCountsInvariantRoute     = "pin-counts"
+)
+
+// RegisterInvariants registers the datasets module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, EntrySequenceInvariantRoute, EntrySequenceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, EntryIndexesInvariantRoute, EntryIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, EntryCreatorInvariantRoute, EntryCreatorInvariant(k))
+	ir.RegisterRoute(types.ModuleName, EntryStatsInvariantRoute, EntryStatsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, PeriodRootsInvariantRoute, PeriodRootsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, PinCountsInvariantRoute, PinCountsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the datasets module, returning the
+// result of the first broken one.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			EntrySequenceInvariant(k),
+			EntryIndexesInvariant(k),
+			EntryCreatorInvariant(k),
+			EntryStatsInvariant(k),
+			PeriodRootsInvariant(k),
+			PinCountsInvariant(k),
+		} {
+			if msg, broken := invariant(ctx); broken {
+				return msg, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// invariantResult formats the problems found by the invariant of route.
+func invariantResult(route string, problems []string) (string, bool) {
+	return sdk.FormatInvariant(types.ModuleName, route, strings.Join(problems, "\n")), len(problems) > 0
+}
+
+// EntrySequenceInvariant checks that every entry id is below the next id of
+// the entry sequence.
+func EntrySequenceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		next, err := k.EntrySeq.Peek(ctx)
+		if err != nil {
+			return invariantResult(EntrySequenceInvariantRoute, []string{fmt.Sprintf("failed to read the entry sequence: %s", err)})
+		}
+
+		var problems []string
+		if err := k.Entry.Walk(ctx, nil, func(id uint64, _ types.Entry) (bool, error) {
+			if id >= next {
+				problems = append(problems, fmt.Sprintf("entry %d is not below the entry sequence %d", id, next))
+			}
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read entries: %s", err))
+		}
+		return invariantResult(EntrySequenceInvariantRoute, problems)
+	}
+}
+
+// EntryIndexesInvariant checks that every record of the secondary indexes,
+// the search index, the CID, checksum and mirror indexes and the revision
+// history refers to an existing entry and agrees with it.
+func EntryIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		c := indexChecker{k: k, ctx: ctx, entries: make(map[uint64]*types.Entry)}
+
+		checkMultiIndex(&c, "agency", k.Entry.Indexes.Agency, func(e types.Entry) string { return e.Agency })
+		checkMultiIndex(&c, "category", k.Entry.Indexes.Category, func(e types.Entry) string { return e.Category })
+		checkMultiIndex(&c, "mime_type", k.Entry.Indexes.MimeType, func(e types.Entry) string { return e.MimeType })
+		checkMultiIndex(&c, "created_height", k.Entry.Indexes.CreatedHeight, func(e types.Entry) int64 { return e.CreatedHeight })
+		checkMultiIndex(&c, "file_size", k.Entry.Indexes.FileSize, func(e types.Entry) uint64 { return e.FileSize })
+
+		terms := make(map[uint64]map[string]uint32)
+		c.walk("search", k.EntrySearchIndex.Walk(ctx, nil, func(key collections.Pair[string, uint64], weight uint32) (bool, error) {
+			entry := c.entry("search", key.K2())
+			if entry == nil {
+				return false, nil
+			}
+			if _, ok := terms[entry.Id]; !ok {
+				terms[entry.Id] = entry.SearchTerms()
+			}
+			if want := terms[entry.Id][key.K1()]; want != weight {
+				c.problems = append(c.problems, fmt.Sprintf("search index weights term %q of entry %d %d, entry gives %d", key.K1(), key.K2(), weight, want))
+			}
+			return false, nil
+		}))
+		c.walk("cid", k.EntryByCid.Walk(ctx, nil, func(cid string, id uint64) (bool, error) {
+			if entry := c.entry("cid", id); entry != nil {
+				if got, _ := uniqueKeys(*entry); got != cid {
+					c.problems = append(c.problems, fmt.Sprintf("cid index maps %s to entry %d, which registers %q", cid, id, got))
+				}
+			}
+			return false, nil
+		}))
+		c.walk("checksum", k.EntryByChecksum.Walk(ctx, nil, func(checksum string, id uint64) (bool, error) {
+			if entry := c.entry("checksum", id); entry != nil {
+				if _, got := uniqueKeys(*entry); got != checksum {
+					c.problems = append(c.problems, fmt.Sprintf("checksum index maps %s to entry %d, which registers %q", checksum, id, got))
+				}
+			}
+			return false, nil
+		}))
+		c.walk("mirror", k.EntryMirrors.Walk(ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+			if entry := c.entry("mirror", key.K2()); entry != nil && (!entry.IsMirror() || entry.MirrorOf.EntryId != key.K1()) {
+				c.problems = append(c.problems, fmt.Sprintf("mirror index links entry %d to entry %d, which it does not mirror", key.K2(), key.K1()))
+			}
+			return false, nil
+		}))
+		c.walk("revision", k.EntryRevision.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], _ types.EntryRevision) (bool, error) {
+			c.entry("revision", key.K1())
+			return false, nil
+		}))
+
+		return invariantResult(EntryIndexesInvariantRoute, c.problems)
+	}
+}
+
+// indexChecker accumulates the problems found by EntryIndexesInvariant,
+// caching the entries it reads.
+type indexChecker struct {
+	k        Keeper
+	ctx      sdk.Context
+	entries  map[uint64]*types.Entry
+	problems []string
+}
+
+// entry returns the entry with the given id, or nil after recording a problem
+// if it doesn't exist.
+func (c *indexChecker) entry(index string, id uint64) *types.Entry {
+	if entry, ok := c.entries[id]; ok {
+		if entry == nil {
+			c.problems = append(c.problems, fmt.Sprintf("%s index refers to missing entry %d", index, id))
+		}
+		return entry
+	}
+
+	var entry *types.Entry
+	value, err := c.k.Entry.Get(c.ctx, id)
+	switch {
+	case err == nil:
+		entry = &value
+	case errors.Is(err, collections.ErrNotFound):
+		c.problems = append(c.problems, fmt.Sprintf("%s index refers to missing entry %d", index, id))
+	default:
+		c.problems = append(c.problems, fmt.Sprintf("failed to read entry %d: %s", id, err))
+	}
+	c.entries[id] = entry
+	return entry
+}
+
+// walk records the error of walking index, if any.
+func (c *indexChecker) walk(index string, err error) {
+	if err != nil {
+		c.problems = append(c.problems, fmt.Sprintf("failed to read the %s index: %s", index, err))
+	}
+}
+
+// checkMultiIndex checks that every record of idx refers to an existing entry
+// whose reference key, as returned by ref, matches the record.
+func checkMultiIndex[R comparable](c *indexChecker, index string, idx *indexes.Multi[R, uint64, types.Entry], ref func(types.Entry) R) {
+	c.walk(index, idx.Walk(c.ctx, nil, func(refKey R, id uint64) (bool, error) {
+		if entry := c.entry(index, id); entry != nil && ref(*entry) != refKey {
+			c.problems = append(c.problems, fmt.Sprintf("%s index refers to entry %d under %v, entry gives %v", index, id, refKey, ref(*entry)))
+		}
+		return false, nil
+	}))
+}
+
+// EntryCreatorInvariant checks that every entry has a valid creator address.
+func EntryCreatorInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		if err := k.Entry.Walk(ctx, nil, func(id uint64, entry types.Entry) (bool, error) {
+			if _, err := k.addressCodec.StringToBytes(entry.Creator); err != nil {
+				problems = append(problems, fmt.Sprintf("entry %d has invalid creator %q: %s", id, entry.Creator, err))
+			}
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read entries: %s", err))
+		}
+		return invariantResult(EntryCreatorInvariantRoute, problems)
+	}
+}
+
+// EntryStatsInvariant checks that the entry counters match the stored entries.
+func EntryStatsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected, err := k.computeStats(ctx)
+		if err != nil {
+			return invariantResult(EntryStatsInvariantRoute, []string{fmt.Sprintf("failed to read entries: %s", err)})
+		}
+
+		var problems []string
+		totals, err := k.EntryTotals.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return invariantResult(EntryStatsInvariantRoute, []string{fmt.Sprintf("failed to read totals: %s", err)})
+		}
+		if totals != expected.totals {
+			problems = append(problems, fmt.Sprintf("totals are %v, entries give %v", totals, expected.totals))
+		}
+
+		seen := make(map[statsKey]bool, len(expected.buckets))
+		if err := k.EntryStats.Walk(ctx, nil, func(pair collections.Pair[string, string], count types.EntryCount) (bool, error) {
+			key := statsKey{pair.K1(), pair.K2()}
+			seen[key] = true
+			if want := expected.buckets[key]; count != want {
+				problems = append(problems, fmt.Sprintf("%s %q is %v, entries give %v", key.dimension, key.key, count, want))
+			}
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read buckets: %s", err))
+		}
+		for _, key := range expected.sortedKeys() {
+			if !seen[key] {
+				problems = append(problems, fmt.Sprintf("%s %q is missing, entries give %v", key.dimension, key.key, expected.buckets[key]))
+			}
+		}
+
+		return invariantResult(EntryStatsInvariantRoute, problems)
+	}
+}
+
+// PeriodRootsInvariant checks that the root of every closed reporting period
+// matches its leaves and precedes the current period.
+func PeriodRootsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		current, err := k.CurrentPeriod.Get(ctx)
+		if err != nil {
+			return invariantResult(PeriodRootsInvariantRoute, []string{fmt.Sprintf("failed to read the current period: %s", err)})
+		}
+
+		var problems []string
+		if err := k.PeriodRoot.Walk(ctx, nil, func(id uint64, root types.PeriodRoot) (bool, error) {
+			if id >= current.PeriodId {
+				problems = append(problems, fmt.Sprintf("period %d is not before the current period %d", id, current.PeriodId))
+			}
+			leaves, err := k.periodLeaves(ctx, id)
+			if err != nil {
+				return true, err
+			}
+			if uint64(len(leaves)) != root.EntryCount || !bytes.Equal(types.PeriodMerkleRoot(leaves), root.Root) {
+				problems = append(problems, fmt.Sprintf("root of period %d does not match its %d leaves", id, len(leaves)))
+			}
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read period roots: %s", err))
+		}
+		return invariantResult(PeriodRootsInvariantRoute, problems)
+	}
+}
+
+// PinCountsInvariant checks that the pin count of every entry is the number of
+// its attestations, that every attestation is made by a registered pinner for
+// the current content of its active entry, and that the expiry queue holds
+// exactly the attestations.
+func PinCountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var problems []string
+		attested := make(map[uint64][]string)
+		queued := 0
+		if err := k.PinAttestation.Walk(ctx, nil, func(key collections.Pair[uint64, string], att types.PinAttestation) (bool, error) {
+			attested[key.K1()] = append(attested[key.K1()], key.K2())
+
+			found, err := k.Pinner.Has(ctx, att.Pinner)
+			if err != nil {
+				return true, err
+			}
+			if !found {
+				problems = append(problems, fmt.Sprintf("attestation of entry %d by unregistered pinner %s", att.EntryId, att.Pinner))
+			}
+			entry, err := k.Entry.Get(ctx, att.EntryId)
+			switch {
+			case errors.Is(err, collections.ErrNotFound):
+				problems = append(problems, fmt.Sprintf("attestation by %s of missing entry %d", att.Pinner, att.EntryId))
+			case err != nil:
+				return true, err
+			case types.NormalizeCid(att.Cid) != types.NormalizeCid(entry.IpfsCid):
+				problems = append(problems, fmt.Sprintf("attestation of entry %d by %s is for cid %s, entry has %s", att.EntryId, att.Pinner, att.Cid, entry.IpfsCid))
+			case entry.IsRetracted():
+				problems = append(problems, fmt.Sprintf("attestation by %s of retracted entry %d", att.Pinner, att.EntryId))
+			}
+			found, err = k.PinExpiryQueue.Has(ctx, collections.Join3(att.ExpiresHeight, att.EntryId, att.Pinner))
+			if err != nil {
+				return true, err
+			}
+			if !found {
+				problems = append(problems, fmt.Sprintf("attestation of entry %d by %s is not queued to expire at %d", att.EntryId, att.Pinner, att.ExpiresHeight))
+			}
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read pin attestations: %s", err))
+		}
+
+		if err := k.PinExpiryQueue.Walk(ctx, nil, func(key collections.Triple[int64, uint64, string]) (bool, error) {
+			queued++
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read the pin expiry queue: %s", err))
+		}
+		attestations := 0
+		for _, pinners := range attested {
+			attestations += len(pinners)
+		}
+		if queued != attestations {
+			problems = append(problems, fmt.Sprintf("pin expiry queue holds %d attestations, %d are stored", queued, attestations))
+		}
+
+		counted := make(map[uint64]bool)
+		if err := k.PinCount.Walk(ctx, nil, func(id uint64, pinCount uint32) (bool, error) {
+			counted[id] = true
+			if pinCount == 0 || int(pinCount) != len(attested[id]) {
+				problems = append(problems, fmt.Sprintf("entry %d has pin count %d, %d attestations are stored", id, pinCount, len(attested[id])))
+			}
+			return false, nil
+		}); err != nil {
+			problems = append(problems, fmt.Sprintf("failed to read pin counts: %s", err))
+		}
+		for _, id := range slices.Sorted(maps.Keys(attested)) {
+			if !counted[id] {
+				problems = append(problems, fmt.Sprintf("entry %d has no pin count, %d attestations are stored", id, len(attested[id])))
+			}
+		}
+		return invariantResult(PinCountsInvariantRoute, problems)
+	}
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestEntryStatsInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EntryStatsInvariant(f.keeper)
+
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 0, Agency: "NOAA", FileSize: 10}))
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, f.keeper.EntryStats.Set(f.ctx, collections.Join(types.StatsDimensionAgency, "NOAA"), types.EntryCount{Entries: 2, Bytes: 10}))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, `agency "NOAA"`)
+
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 1, types.Entry{Id: 1, Agency: "PAGASA"}))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, `agency "PAGASA" is missing`)
+}
+
+func TestEntrySequenceInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EntrySequenceInvariant(f.keeper)
+
+	require.NoError(t, f.keeper.EntrySeq.Set(f.ctx, 1))
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 0}))
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 1}))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "entry 1 is not below the entry sequence 1")
+}
+
+func TestEntryIndexesInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EntryIndexesInvariant(f.keeper)
+
+	entry := types.Entry{Id: 0, Title: "Flood maps", Agency: "NOAA", IpfsCid: "cid-0", ChecksumSha_256: "abc", Revision: 1}
+	require.NoError(t, f.keeper.SetEntry(f.ctx, entry))
+	require.NoError(t, f.keeper.EntryRevision.Set(f.ctx, collections.Join(uint64(0), uint64(1)), types.EntryRevision{EntryId: 0, Revision: 1, Entry: entry}))
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	tests := []struct {
+		desc    string
+		corrupt func(ctx context.Context) error
+		msg     string
+	}{
+		{
+			desc: "secondary index",
+			corrupt: func(ctx context.Context) error {
+				return f.keeper.Entry.Indexes.Agency.Reference(ctx, 7, types.Entry{Id: 7, Agency: "NOAA"}, func() (types.Entry, error) {
+					return types.Entry{}, collections.ErrNotFound
+				})
+			},
+			msg: "agency index refers to missing entry 7",
+		},
+		{
+			desc: "search index",
+			corrupt: func(ctx context.Context) error {
+				return f.keeper.EntrySearchIndex.Set(ctx, collections.Join("flood", uint64(0)), 1)
+			},
+			msg: `search index weights term "flood" of entry 0 1, entry gives 3`,
+		},
+		{
+			desc:    "cid index",
+			corrupt: func(ctx context.Context) error { return f.keeper.EntryByCid.Set(ctx, "cid-1", 0) },
+			msg:     "cid index maps cid-1 to entry 0",
+		},
+		{
+			desc: "mirror index",
+			corrupt: func(ctx context.Context) error {
+				return f.keeper.EntryMirrors.Set(ctx, collections.Join(uint64(3), uint64(0)))
+			},
+			msg: "mirror index links entry 0 to entry 3",
+		},
+		{
+			desc: "revision",
+			corrupt: func(ctx context.Context) error {
+				return f.keeper.EntryRevision.Set(ctx, collections.Join(uint64(8), uint64(1)), types.EntryRevision{EntryId: 8, Revision: 1})
+			},
+			msg: "revision index refers to missing entry 8",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			require.NoError(t, tc.corrupt(cacheCtx))
+
+			msg, broken := invariant(ctx)
+			require.False(t, broken, msg)
+			msg, broken = invariant(cacheCtx)
+			require.True(t, broken)
+			require.Contains(t, msg, tc.msg)
+		})
+	}
+}
+
+func TestEntryCreatorInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.EntryCreatorInvariant(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 0, Creator: creator}))
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 1, Creator: "invalid"}))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, `entry 1 has invalid creator "invalid"`)
+}
+
+func TestPeriodRootsInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.PeriodRootsInvariant(f.keeper)
+
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 0}))
+	require.NoError(t, f.keeper.PeriodChanges.Set(f.ctx, 0))
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(int64(types.DefaultParams().SnapshotIntervalBlocks))))
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	for _, tc := range []struct {
+		desc    string
+		corrupt func(ctx sdk.Context) error
+		msg     string
+	}{
+		{"missing leaf", func(ctx sdk.Context) error {
+			return f.keeper.PeriodLeaf.Remove(ctx, collections.Join(uint64(1), uint64(0)))
+		}, "root of period 1 does not match its 0 leaves"},
+		{"root of the current period", func(ctx sdk.Context) error {
+			return f.keeper.CurrentPeriod.Set(ctx, types.CurrentPeriod{PeriodId: 1})
+		}, "period 1 is not before the current period 1"},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			require.NoError(t, tc.corrupt(cacheCtx))
+			msg, broken := invariant(cacheCtx)
+			require.True(t, broken)
+			require.Contains(t, msg, tc.msg)
+		})
+	}
+}
+
+func TestPinCountsInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	invariant := keeper.PinCountsInvariant(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	pinner, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 0, Creator: creator, IpfsCid: "cid-0"}))
+	attestPin(t, f, 0, "cid-0", pinner)
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+
+	require.NoError(t, f.keeper.SetEntry(f.ctx, types.Entry{Id: 1, Creator: creator}))
+	require.NoError(t, f.keeper.PinCount.Set(f.ctx, 1, 3))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "entry 1 has pin count 3, 0 attestations are stored")
+
+	require.NoError(t, f.keeper.PinCount.Remove(f.ctx, 0))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "entry 0 has no pin count, 1 attestations are stored")
+
+	require.NoError(t, f.keeper.PinExpiryQueue.Set(f.ctx, collections.Join3(int64(7), uint64(0), "other")))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "pin expiry queue holds 2 attestations, 1 are stored")
+}
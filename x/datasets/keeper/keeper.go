@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"govchain/x/datasets/types"
 )
@@ -22,7 +24,139 @@ type Keeper struct {
 	Schema   collections.Schema
 	Params   collections.Item[types.Params]
 	EntrySeq collections.Sequence
-	Entry    collections.Map[uint64, types.Entry]
+	Entry    *collections.IndexedMap[uint64, types.Entry, EntryIndexes]
+	Agency   collections.Map[string, types.Agency]
+	// EntryRevision holds the append-only history of every entry, keyed by
+	// (entry id, revision).
+	EntryRevision collections.Map[collections.Pair[uint64, uint64], types.EntryRevision]
+	// EntrySearchIndex is the inverted index of the entry search terms, holding
+	// the weight of each (term, entry id).
+	EntrySearchIndex collections.Map[collections.Pair[string, uint64], uint32]
+	// EntryByCid and EntryByChecksum map the normalized IPFS CID and SHA-256
+	// checksum of the entries that are not mirrors to their id.
+	EntryByCid      collections.Map[string, uint64]
+	EntryByChecksum collections.Map[string, uint64]
+	// EntryMirrors indexes the mirrors by (mirrored entry id, mirror id).
+	EntryMirrors collections.KeySet[collections.Pair[uint64, uint64]]
+	// EntryTotals and EntryStats hold the entry counters, the latter keyed by
+	// (statistics dimension, bucket key).
+	EntryTotals collections.Item[types.EntryTotals]
+	EntryStats  collections.Map[collections.Pair[string, string], types.EntryCount]
+	// PeriodRoot holds the Merkle root of every closed reporting period and
+	// PeriodLeaf its leaves, keyed by (period id, entry id).
+	PeriodRoot collections.Map[uint64, types.PeriodRoot]
+	PeriodLeaf collections.Map[collections.Pair[uint64, uint64], types.PeriodLeaf]
+	// CurrentPeriod describes the open reporting period and PeriodChanges
+	// holds the ids of the entries changed during it.
+	CurrentPeriod collections.Item[types.CurrentPeriod]
+	PeriodChanges collections.KeySet[uint64]
+	// AllowedPinners holds the addresses allowed to register as pinners and
+	// to attest to pins.
+	AllowedPinners collections.KeySet[sdk.AccAddress]
+	// Pinner holds the registered pinners by address and PinAttestation their
+	// current attestations, keyed by (entry id, pinner). PinExpiryQueue orders
+	// the attestations by expiry height and PinCount counts them by entry id.
+	Pinner         collections.Map[string, types.Pinner]
+	PinAttestation collections.Map[collections.Pair[uint64, string], types.PinAttestation]
+	PinExpiryQueue collections.KeySet[collections.Triple[int64, uint64, string]]
+	PinCount       collections.Map[uint64, uint32]
+	// Challenge holds the open retrievability challenges by id, indexed by
+	// entry id. ChallengeDeadlineQueue orders them by (deadline height, id)
+	// and PinnerChallenges indexes them by (pinner, entry id, id).
+	ChallengeSeq           collections.Sequence
+	Challenge              *collections.IndexedMap[uint64, types.Challenge, ChallengeIndexes]
+	ChallengeDeadlineQueue collections.KeySet[collections.Pair[int64, uint64]]
+	PinnerChallenges       collections.KeySet[collections.Triple[string, uint64, uint64]]
+}
+
+// EntryIndexes defines the secondary indexes maintained over the Entry map.
+type EntryIndexes struct {
+	Agency   *indexes.Multi[string, uint64, types.Entry]
+	Category *indexes.Multi[string, uint64, types.Entry]
+	MimeType *indexes.Multi[string, uint64, types.Entry]
+	// CreatedHeight, FileSize and UpdatedHeight order the entries for sorted
+	// listings.
+	CreatedHeight *indexes.Multi[int64, uint64, types.Entry]
+	FileSize      *indexes.Multi[uint64, uint64, types.Entry]
+	UpdatedHeight *indexes.Multi[int64, uint64, types.Entry]
+}
+
+// IndexesList implements the collections.Indexes interface.
+func (i EntryIndexes) IndexesList() []collections.Index[uint64, types.Entry] {
+	return []collections.Index[uint64, types.Entry]{i.Agency, i.Category, i.MimeType, i.CreatedHeight, i.FileSize, i.UpdatedHeight}
+}
+
+// NewEntryIndexes creates the secondary indexes of the Entry map.
+func NewEntryIndexes(sb *collections.SchemaBuilder) EntryIndexes {
+	return EntryIndexes{
+		Agency: indexes.NewMulti(
+			sb, types.EntryAgencyIndexKey, "entry_by_agency",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, entry types.Entry) (string, error) {
+				return entry.Agency, nil
+			},
+		),
+		Category: indexes.NewMulti(
+			sb, types.EntryCategoryIndexKey, "entry_by_category",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, entry types.Entry) (string, error) {
+				return entry.Category, nil
+			},
+		),
+		MimeType: indexes.NewMulti(
+			sb, types.EntryMimeTypeIndexKey, "entry_by_mime_type",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, entry types.Entry) (string, error) {
+				return entry.MimeType, nil
+			},
+		),
+		CreatedHeight: indexes.NewMulti(
+			sb, types.EntryCreatedHeightIndexKey, "entry_by_created_height",
+			collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, entry types.Entry) (int64, error) {
+				return entry.CreatedHeight, nil
+			},
+		),
+		FileSize: indexes.NewMulti(
+			sb, types.EntryFileSizeIndexKey, "entry_by_file_size",
+			collections.Uint64Key, collections.Uint64Key,
+			func(_ uint64, entry types.Entry) (uint64, error) {
+				return entry.FileSize, nil
+			},
+		),
+		UpdatedHeight: indexes.NewMulti(
+			sb, types.EntryUpdatedHeightIndexKey, "entry_by_updated_height",
+			collections.Int64Key, collections.Uint64Key,
+			func(_ uint64, entry types.Entry) (int64, error) {
+				return entry.UpdatedHeight, nil
+			},
+		),
+	}
+}
+
+// ChallengeIndexes defines the secondary indexes maintained over the Challenge
+// map.
+type ChallengeIndexes struct {
+	// Entry indexes the challenges by (entry id, id).
+	Entry *indexes.Multi[uint64, uint64, types.Challenge]
+}
+
+// IndexesList implements the collections.Indexes interface.
+func (i ChallengeIndexes) IndexesList() []collections.Index[uint64, types.Challenge] {
+	return []collections.Index[uint64, types.Challenge]{i.Entry}
+}
+
+// NewChallengeIndexes creates the secondary indexes of the Challenge map.
+func NewChallengeIndexes(sb *collections.SchemaBuilder) ChallengeIndexes {
+	return ChallengeIndexes{
+		Entry: indexes.NewMulti(
+			sb, types.ChallengeEntryIndexKey, "challenges_by_entry",
+			collections.Uint64Key, collections.Uint64Key,
+			func(_ uint64, challenge types.Challenge) (uint64, error) {
+				return challenge.EntryId, nil
+			},
+		),
+	}
 }
 
 func NewKeeper(
@@ -45,8 +179,65 @@ func NewKeeper(
 		authority:    authority,
 
 		Params:   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Entry:    collections.NewMap(sb, types.EntryKey, "entry", collections.Uint64Key, codec.CollValue[types.Entry](cdc)),
+		Entry:    collections.NewIndexedMap(sb, types.EntryKey, "entry", collections.Uint64Key, codec.CollValue[types.Entry](cdc), NewEntryIndexes(sb)),
 		EntrySeq: collections.NewSequence(sb, types.EntryCountKey, "entrySequence"),
+		Agency:   collections.NewMap(sb, types.AgencyKey, "agency", collections.StringKey, codec.CollValue[types.Agency](cdc)),
+		EntryRevision: collections.NewMap(
+			sb, types.EntryRevisionKey, "entry_revision",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.EntryRevision](cdc),
+		),
+		EntrySearchIndex: collections.NewMap(
+			sb, types.EntrySearchIndexKey, "entry_search_index",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint32Value,
+		),
+		EntryByCid:      collections.NewMap(sb, types.EntryCidIndexKey, "entry_by_cid", collections.StringKey, collections.Uint64Value),
+		EntryByChecksum: collections.NewMap(sb, types.EntryChecksumIndexKey, "entry_by_checksum", collections.StringKey, collections.Uint64Value),
+		EntryMirrors: collections.NewKeySet(
+			sb, types.EntryMirrorIndexKey, "entry_mirrors",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		EntryTotals: collections.NewItem(sb, types.EntryTotalsKey, "entry_totals", codec.CollValue[types.EntryTotals](cdc)),
+		EntryStats: collections.NewMap(
+			sb, types.EntryStatsKey, "entry_stats",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.EntryCount](cdc),
+		),
+		PeriodRoot: collections.NewMap(sb, types.PeriodRootKey, "period_root", collections.Uint64Key, codec.CollValue[types.PeriodRoot](cdc)),
+		PeriodLeaf: collections.NewMap(
+			sb, types.PeriodLeafKey, "period_leaf",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+			codec.CollValue[types.PeriodLeaf](cdc),
+		),
+		CurrentPeriod:  collections.NewItem(sb, types.CurrentPeriodKey, "current_period", codec.CollValue[types.CurrentPeriod](cdc)),
+		PeriodChanges:  collections.NewKeySet(sb, types.PeriodChangesKey, "period_changes", collections.Uint64Key),
+		AllowedPinners: collections.NewKeySet(sb, types.AllowedPinnersKey, "allowed_pinners", sdk.AccAddressKey),
+		Pinner:         collections.NewMap(sb, types.PinnerKey, "pinner", collections.StringKey, codec.CollValue[types.Pinner](cdc)),
+		PinAttestation: collections.NewMap(
+			sb, types.PinAttestationKey, "pin_attestation",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			codec.CollValue[types.PinAttestation](cdc),
+		),
+		PinExpiryQueue: collections.NewKeySet(
+			sb, types.PinExpiryQueueKey, "pin_expiry_queue",
+			collections.TripleKeyCodec(collections.Int64Key, collections.Uint64Key, collections.StringKey),
+		),
+		PinCount:     collections.NewMap(sb, types.PinCountKey, "pin_count", collections.Uint64Key, collections.Uint32Value),
+		ChallengeSeq: collections.NewSequence(sb, types.ChallengeCountKey, "challenge_sequence"),
+		Challenge: collections.NewIndexedMap(
+			sb, types.ChallengeKey, "challenge",
+			collections.Uint64Key, codec.CollValue[types.Challenge](cdc),
+			NewChallengeIndexes(sb),
+		),
+		ChallengeDeadlineQueue: collections.NewKeySet(
+			sb, types.ChallengeDeadlineQueueKey, "challenge_deadline_queue",
+			collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key),
+		),
+		PinnerChallenges: collections.NewKeySet(
+			sb, types.PinnerChallengesKey, "pinner_challenges",
+			collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key),
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
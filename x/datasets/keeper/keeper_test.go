@@ -47,6 +47,9 @@ func initFixture(t *testing.T) *fixture {
 	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
+	if err := k.CurrentPeriod.Set(ctx, types.DefaultGenesis().CurrentPeriod); err != nil {
+		t.Fatalf("failed to set current period: %v", err)
+	}
 
 	return &fixture{
 		ctx:          ctx,
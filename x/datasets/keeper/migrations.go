@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "govchain/x/datasets/migrations/v2"
+	"govchain/x/datasets/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2, converting the
+// string-typed entry fields to their typed counterparts. Values that cannot be
+// parsed are logged and emitted as events so that they can be corrected.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	invalid, err := v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, func(ctx context.Context, entry types.Entry) error {
+		return m.keeper.Entry.Set(ctx, entry.Id, entry)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range invalid {
+		ctx.Logger().Error(
+			"unable to migrate entry value",
+			"module", types.ModuleName,
+			"id", v.EntryId,
+			"field", v.Field,
+			"value", v.Value,
+			"error", v.Err,
+		)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeMigrationInvalidValue,
+			sdk.NewAttribute(types.AttributeKeyEntryId, strconv.FormatUint(v.EntryId, 10)),
+			sdk.NewAttribute(types.AttributeKeyField, v.Field),
+			sdk.NewAttribute(types.AttributeKeyValue, v.Value),
+			sdk.NewAttribute(types.AttributeKeyError, v.Err.Error()),
+		))
+	}
+
+	return nil
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3, starting the
+// revision history of every existing entry with its current state as
+// revision 1.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var entries []types.Entry
+	if err := m.keeper.Entry.Walk(ctx, nil, func(_ uint64, entry types.Entry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entry.Revision = 1
+		if err := m.keeper.Entry.Set(ctx, entry.Id, entry); err != nil {
+			return err
+		}
+		if err := m.keeper.EntryRevision.Set(ctx, collections.Join(entry.Id, entry.Revision), types.EntryRevision{
+			EntryId:  entry.Id,
+			Revision: entry.Revision,
+			Editor:   entry.Creator,
+			TxHash:   entry.CreatedTxHash,
+			Entry:    entry,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4, backfilling
+// the creation and last modification provenance of every entry from its
+// revision history. The modification time of entries updated before the
+// migration is not recorded anywhere and is left unset.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var entries []types.Entry
+	if err := m.keeper.Entry.Walk(ctx, nil, func(_ uint64, entry types.Entry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		first, err := m.keeper.EntryRevision.Get(ctx, collections.Join(entry.Id, uint64(1)))
+		switch {
+		case err == nil:
+			entry.CreatedHeight = first.BlockHeight
+		case !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+
+		last, err := m.keeper.EntryRevision.Get(ctx, collections.Join(entry.Id, entry.Revision))
+		switch {
+		case err == nil:
+			entry.UpdatedTxHash = last.TxHash
+			entry.UpdatedHeight = last.BlockHeight
+		case !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+		if entry.Revision <= 1 {
+			entry.UpdatedTxHash = entry.CreatedTxHash
+			entry.UpdatedHeight = entry.CreatedHeight
+			entry.UpdatedAt = entry.CreatedAt
+		}
+
+		if err := m.keeper.Entry.Set(ctx, entry.Id, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5, building the
+// search index of the existing entries.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return m.keeper.Entry.Walk(ctx, nil, func(id uint64, entry types.Entry) (bool, error) {
+		return false, m.keeper.updateSearchIndex(ctx, id, nil, entry.SearchTerms())
+	})
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6, building the
+// CID and checksum indexes of the existing entries. An entry sharing its CID
+// or checksum with an earlier entry is linked to it as a mirror and indexed
+// under it; the links are logged and emitted as events so that they can be
+// reviewed.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	var entries []types.Entry
+	if err := m.keeper.Entry.Walk(ctx, nil, func(_ uint64, entry types.Entry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		cid, checksum := uniqueKeys(entry)
+		if cid == "" && checksum == "" {
+			continue
+		}
+
+		var (
+			mirrorOf uint64
+			field    string
+		)
+		for _, key := range []struct {
+			idx   collections.Map[string, uint64]
+			field string
+			value string
+		}{
+			{m.keeper.EntryByCid, types.FieldIpfsCid, cid},
+			{m.keeper.EntryByChecksum, types.FieldChecksumSha256, checksum},
+		} {
+			owner, found, err := entryByUniqueKey(ctx, key.idx, key.value)
+			if err != nil {
+				return err
+			}
+			if found {
+				mirrorOf, field = owner, key.field
+				break
+			}
+		}
+
+		if field == "" {
+			if err := m.keeper.updateUniqueIndex(ctx, m.keeper.EntryByCid, types.FieldIpfsCid, entry.Id, "", cid); err != nil {
+				return err
+			}
+			if err := m.keeper.updateUniqueIndex(ctx, m.keeper.EntryByChecksum, types.FieldChecksumSha256, entry.Id, "", checksum); err != nil {
+				return err
+			}
+			continue
+		}
+
+		entry.MirrorOf = &types.MirrorLink{EntryId: mirrorOf}
+		if err := m.keeper.Entry.Set(ctx, entry.Id, entry); err != nil {
+			return err
+		}
+		if err := m.keeper.updateMirrorIndex(ctx, entry.Id, nil, &entry); err != nil {
+			return err
+		}
+
+		ctx.Logger().Info(
+			"linked duplicate entry as mirror",
+			"module", types.ModuleName,
+			"id", entry.Id,
+			"mirror_of", mirrorOf,
+			"field", field,
+		)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeMigrationMirror,
+			sdk.NewAttribute(types.AttributeKeyEntryId, strconv.FormatUint(entry.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMirrorOf, strconv.FormatUint(mirrorOf, 10)),
+			sdk.NewAttribute(types.AttributeKeyField, field),
+		))
+	}
+	return nil
+}
+
+// Migrate6to7 migrates the store from consensus version 6 to 7, building the
+// creation height, update height and file size indexes of the existing
+// entries.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	var entries []types.Entry
+	if err := m.keeper.Entry.Walk(ctx, nil, func(_ uint64, entry types.Entry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	notFound := func() (types.Entry, error) { return types.Entry{}, collections.ErrNotFound }
+	for _, entry := range entries {
+		if err := m.keeper.Entry.Indexes.CreatedHeight.Reference(ctx, entry.Id, entry, notFound); err != nil {
+			return err
+		}
+		if err := m.keeper.Entry.Indexes.UpdatedHeight.Reference(ctx, entry.Id, entry, notFound); err != nil {
+			return err
+		}
+		if err := m.keeper.Entry.Indexes.FileSize.Reference(ctx, entry.Id, entry, notFound); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate7to8 migrates the store from consensus version 7 to 8, computing the
+// entry counters from the existing entries.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	stats, err := m.keeper.computeStats(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.keeper.EntryStats.Clear(ctx, nil); err != nil {
+		return err
+	}
+	for _, key := range stats.sortedKeys() {
+		if err := m.keeper.EntryStats.Set(ctx, collections.Join(key.dimension, key.key), stats.buckets[key]); err != nil {
+			return err
+		}
+	}
+	return m.keeper.EntryTotals.Set(ctx, stats.totals)
+}
+
+// Migrate8to9 migrates the store from consensus version 8 to 9, setting the
+// default snapshot params and opening the first reporting period at the
+// upgrade height.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.SnapshotIntervalBlocks = types.DefaultSnapshotIntervalBlocks
+	params.SnapshotEpochIdentifier = types.DefaultSnapshotEpochIdentifier
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+	return m.keeper.CurrentPeriod.Set(ctx, types.CurrentPeriod{PeriodId: 1, StartHeight: ctx.BlockHeight()})
+}
+
+// Migrate9to10 migrates the store from consensus version 9 to 10, setting the
+// default pin attestation window and maximum number of allowed pinners. Pins are now counted from the attestations
+// of the pinners, none of which is registered yet, so the pin counts start at
+// zero; the counts declared by the publishers are no longer read.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.PinAttestationWindowBlocks = types.DefaultPinAttestationWindowBlocks
+	params.MaxAllowedPinners = types.DefaultMaxAllowedPinners
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate10to11 migrates the store from consensus version 10 to 11, setting
+// the default challenge params and the maximum reputation of the registered
+// pinners.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.ChallengesPerBlock = types.DefaultChallengesPerBlock
+	params.ChallengeResponseBlocks = types.DefaultChallengeResponseBlocks
+	params.ChallengeFailurePenalty = types.DefaultChallengeFailurePenalty
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	var pinners []types.Pinner
+	if err := m.keeper.Pinner.Walk(ctx, nil, func(_ string, pinner types.Pinner) (bool, error) {
+		pinners = append(pinners, pinner)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, pinner := range pinners {
+		pinner.Reputation = types.MaxPinnerReputation
+		if err := m.keeper.Pinner.Set(ctx, pinner.Address, pinner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate11to12 migrates the store from consensus version 11 to 12, setting
+// the default maximum number of entries of a batch.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.MaxBatchEntries = types.DefaultMaxBatchEntries
+	return m.keeper.Params.Set(ctx, params)
+}
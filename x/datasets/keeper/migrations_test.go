@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	entries := createNEntry(f.keeper, f.ctx, 3)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(sdk.UnwrapSDKContext(f.ctx)))
+
+	for _, entry := range entries {
+		got, err := f.keeper.Entry.Get(f.ctx, entry.Id)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), got.Revision)
+
+		rev, err := f.keeper.EntryRevision.Get(f.ctx, collections.Join(entry.Id, uint64(1)))
+		require.NoError(t, err)
+		require.EqualExportedValues(t, got, rev.Entry)
+		require.Empty(t, rev.PreviousIpfsCid)
+	}
+}
+
+func TestMigrate3to4(t *testing.T) {
+	f := initFixture(t)
+	created := time.Unix(1700000000, 0).UTC()
+
+	// entry 0 was never updated, entry 1 was updated once
+	for id, revisions := range []uint64{1, 2} {
+		entry := types.Entry{Id: uint64(id), Revision: revisions, CreatedTxHash: "CREATE", CreatedAt: created}
+		require.NoError(t, f.keeper.Entry.Set(f.ctx, entry.Id, entry))
+		for rev := uint64(1); rev <= revisions; rev++ {
+			require.NoError(t, f.keeper.EntryRevision.Set(f.ctx, collections.Join(entry.Id, rev), types.EntryRevision{
+				EntryId:     entry.Id,
+				Revision:    rev,
+				BlockHeight: int64(10 * rev),
+				TxHash:      fmt.Sprintf("TX%d", rev),
+			}))
+		}
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(sdk.UnwrapSDKContext(f.ctx)))
+
+	entry, err := f.keeper.Entry.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), entry.CreatedHeight)
+	require.Equal(t, "CREATE", entry.UpdatedTxHash)
+	require.Equal(t, int64(10), entry.UpdatedHeight)
+	require.Equal(t, created, entry.UpdatedAt)
+
+	entry, err = f.keeper.Entry.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), entry.CreatedHeight)
+	require.Equal(t, "TX2", entry.UpdatedTxHash)
+	require.Equal(t, int64(20), entry.UpdatedHeight)
+	require.True(t, entry.UpdatedAt.IsZero())
+}
+
+func TestMigrate4to5(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 0, types.Entry{Id: 0, Title: "Flood maps"}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(sdk.UnwrapSDKContext(f.ctx)))
+
+	weight, err := f.keeper.EntrySearchIndex.Get(f.ctx, collections.Join("flood", uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.SearchWeightTitle), weight)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 0, types.Entry{Id: 0, IpfsCid: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"}))
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 1, types.Entry{Id: 1, ChecksumSha_256: "abc"}))
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 2, types.Entry{Id: 2, IpfsCid: "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"}))
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 3, types.Entry{Id: 3, ChecksumSha_256: "ABC"}))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate5to6(ctx))
+
+	for id, mirrorOf := range map[uint64]uint64{2: 0, 3: 1} {
+		entry, err := f.keeper.Entry.Get(f.ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, &types.MirrorLink{EntryId: mirrorOf}, entry.MirrorOf)
+	}
+	for _, id := range []uint64{0, 1} {
+		entry, err := f.keeper.Entry.Get(f.ctx, id)
+		require.NoError(t, err)
+		require.False(t, entry.IsMirror())
+	}
+
+	id, err := f.keeper.EntryByCid.Get(f.ctx, types.NormalizeCid("QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), id)
+	id, err = f.keeper.EntryByChecksum.Get(f.ctx, "abc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+
+	var links []collections.Pair[uint64, uint64]
+	require.NoError(t, f.keeper.EntryMirrors.Walk(f.ctx, nil, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		links = append(links, key)
+		return false, nil
+	}))
+	require.Equal(t, []collections.Pair[uint64, uint64]{collections.Join[uint64, uint64](0, 2), collections.Join[uint64, uint64](1, 3)}, links)
+
+	var mirrors int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMigrationMirror {
+			mirrors++
+		}
+	}
+	require.Equal(t, 2, mirrors)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	f := initFixture(t)
+	entry := types.Entry{Id: 0, CreatedHeight: 12, UpdatedHeight: 56, FileSize: 34}
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 0, entry))
+	get := func() (types.Entry, error) { return entry, nil }
+	require.NoError(t, f.keeper.Entry.Indexes.CreatedHeight.Unreference(f.ctx, 0, get))
+	require.NoError(t, f.keeper.Entry.Indexes.UpdatedHeight.Unreference(f.ctx, 0, get))
+	require.NoError(t, f.keeper.Entry.Indexes.FileSize.Unreference(f.ctx, 0, get))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate6to7(sdk.UnwrapSDKContext(f.ctx)))
+
+	iter, err := f.keeper.Entry.Indexes.CreatedHeight.MatchExact(f.ctx, 12)
+	require.NoError(t, err)
+	ids, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, ids)
+
+	byUpdate, err := f.keeper.Entry.Indexes.UpdatedHeight.MatchExact(f.ctx, 56)
+	require.NoError(t, err)
+	ids, err = byUpdate.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, ids)
+
+	bySize, err := f.keeper.Entry.Indexes.FileSize.MatchExact(f.ctx, 34)
+	require.NoError(t, err)
+	ids, err = bySize.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0}, ids)
+}
+
+func TestMigrate7to8(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 0, types.Entry{Id: 0, Agency: "NOAA", FileSize: 10}))
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 1, types.Entry{Id: 1, Agency: "NOAA", FileSize: 5}))
+	require.NoError(t, f.keeper.Entry.Set(f.ctx, 2, types.Entry{Id: 2, Agency: "NOAA", Status: types.ENTRY_STATUS_RETRACTED}))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate7to8(ctx))
+
+	totals, err := f.keeper.EntryTotals.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.EntryTotals{Entries: 2, Bytes: 15, RetractedEntries: 1}, totals)
+
+	msg, broken := keeper.EntryStatsInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestMigrate8to9(t *testing.T) {
+	f := initFixture(t)
+	params := types.DefaultParams()
+	params.SnapshotIntervalBlocks = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.CurrentPeriod.Remove(f.ctx))
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(42)
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate8to9(ctx))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), got)
+
+	current, err := f.keeper.CurrentPeriod.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentPeriod{PeriodId: 1, StartHeight: 42}, current)
+}
+
+func TestMigrate9to10(t *testing.T) {
+	f := initFixture(t)
+	params := types.DefaultParams()
+	params.PinAttestationWindowBlocks = 0
+	params.MaxAllowedPinners = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate9to10(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), got)
+}
+
+func TestMigrate10to11(t *testing.T) {
+	f := initFixture(t)
+	params := types.DefaultParams()
+	params.ChallengesPerBlock = 0
+	params.ChallengeResponseBlocks = 0
+	params.ChallengeFailurePenalty = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.Pinner.Set(f.ctx, "pinner", types.Pinner{Address: "pinner", Moniker: "pinner", RegisteredHeight: 3}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate10to11(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), got)
+
+	pinner, err := f.keeper.Pinner.Get(f.ctx, "pinner")
+	require.NoError(t, err)
+	require.Equal(t, types.Pinner{Address: "pinner", Moniker: "pinner", RegisteredHeight: 3, Reputation: types.MaxPinnerReputation}, pinner)
+}
+
+func TestMigrate11to12(t *testing.T) {
+	f := initFixture(t)
+	params := types.DefaultParams()
+	params.MaxBatchEntries = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate11to12(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), got)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RegisterAgency(ctx context.Context, msg *types.MsgRegisterAgency) (*types.MsgRegisterAgencyResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := k.validateAgency(msg.Agency); err != nil {
+		return nil, err
+	}
+
+	found, err := k.Agency.Has(ctx, msg.Agency.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get agency")
+	}
+	if found {
+		return nil, errorsmod.Wrapf(types.ErrAgencyExists, "%s", msg.Agency.Id)
+	}
+
+	if err := k.Agency.Set(ctx, msg.Agency.Id, msg.Agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set agency")
+	}
+
+	return &types.MsgRegisterAgencyResponse{}, nil
+}
+
+func (k msgServer) UpdateAgency(ctx context.Context, msg *types.MsgUpdateAgency) (*types.MsgUpdateAgencyResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := k.validateAgency(msg.Agency); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.GetAgency(ctx, msg.Agency.Id); err != nil {
+		return nil, err
+	}
+
+	if err := k.Agency.Set(ctx, msg.Agency.Id, msg.Agency); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update agency")
+	}
+
+	return &types.MsgUpdateAgencyResponse{}, nil
+}
+
+func (k msgServer) DeregisterAgency(ctx context.Context, msg *types.MsgDeregisterAgency) (*types.MsgDeregisterAgencyResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.GetAgency(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	// Entries already published under the agency are kept; only new
+	// publications are rejected once the agency is gone.
+	if err := k.Agency.Remove(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete agency")
+	}
+
+	return &types.MsgDeregisterAgencyResponse{}, nil
+}
+
+// validateAgency validates an agency, including its publisher addresses.
+func (k msgServer) validateAgency(agency types.Agency) error {
+	if err := agency.Validate(); err != nil {
+		return err
+	}
+	for _, publisher := range agency.Publishers {
+		if _, err := k.addressCodec.StringToBytes(publisher); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid publisher address %s: %s", publisher, err))
+		}
+	}
+	return nil
+}
+
+// GetAgency returns the registered agency with the given id.
+func (k Keeper) GetAgency(ctx context.Context, id string) (types.Agency, error) {
+	agency, err := k.Agency.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Agency{}, errorsmod.Wrapf(types.ErrAgencyNotFound, "%s", id)
+		}
+		return types.Agency{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get agency")
+	}
+	return agency, nil
+}
+
+// checkPublisher returns an error unless publisher is an authorized publisher
+// of the registered agency agencyId.
+func (k Keeper) checkPublisher(ctx context.Context, agencyId, publisher string) error {
+	agency, err := k.GetAgency(ctx, agencyId)
+	if err != nil {
+		return err
+	}
+	if !agency.HasPublisher(publisher) {
+		return errorsmod.Wrapf(types.ErrNotAgencyPublisher, "%s is not a publisher of %s", publisher, agencyId)
+	}
+	return nil
+}
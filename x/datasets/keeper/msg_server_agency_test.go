@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+// registerAgency stores an agency with the given publishers directly in state.
+func registerAgency(t *testing.T, f *fixture, id string, publishers ...string) {
+	t.Helper()
+
+	require.NoError(t, f.keeper.Agency.Set(f.ctx, id, types.Agency{
+		Id:         id,
+		Name:       id,
+		Publishers: publishers,
+	}))
+}
+
+func TestAgencyMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	publisher, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	agency := types.Agency{
+		Id:           "NOAA",
+		Name:         "National Oceanic and Atmospheric Administration",
+		Jurisdiction: "US",
+		Publishers:   []string{publisher},
+	}
+
+	t.Run("register", func(t *testing.T) {
+		_, err := srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: publisher, Agency: agency})
+		require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+		invalid := agency
+		invalid.Publishers = []string{"invalid"}
+		_, err = srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: authority, Agency: invalid})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+		invalid = agency
+		invalid.Name = ""
+		_, err = srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: authority, Agency: invalid})
+		require.ErrorIs(t, err, types.ErrInvalidAgency)
+
+		_, err = srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: authority, Agency: agency})
+		require.NoError(t, err)
+
+		_, err = srv.RegisterAgency(f.ctx, &types.MsgRegisterAgency{Authority: authority, Agency: agency})
+		require.ErrorIs(t, err, types.ErrAgencyExists)
+
+		got, err := f.keeper.Agency.Get(f.ctx, agency.Id)
+		require.NoError(t, err)
+		require.EqualExportedValues(t, agency, got)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated := agency
+		updated.Contact = "data@noaa.gov"
+
+		_, err := srv.UpdateAgency(f.ctx, &types.MsgUpdateAgency{Authority: publisher, Agency: updated})
+		require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+		missing := updated
+		missing.Id = "NASA"
+		_, err = srv.UpdateAgency(f.ctx, &types.MsgUpdateAgency{Authority: authority, Agency: missing})
+		require.ErrorIs(t, err, types.ErrAgencyNotFound)
+
+		_, err = srv.UpdateAgency(f.ctx, &types.MsgUpdateAgency{Authority: authority, Agency: updated})
+		require.NoError(t, err)
+
+		got, err := f.keeper.Agency.Get(f.ctx, agency.Id)
+		require.NoError(t, err)
+		require.Equal(t, "data@noaa.gov", got.Contact)
+	})
+
+	t.Run("deregister", func(t *testing.T) {
+		_, err := srv.DeregisterAgency(f.ctx, &types.MsgDeregisterAgency{Authority: publisher, Id: agency.Id})
+		require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+		_, err = srv.DeregisterAgency(f.ctx, &types.MsgDeregisterAgency{Authority: authority, Id: "NASA"})
+		require.ErrorIs(t, err, types.ErrAgencyNotFound)
+
+		_, err = srv.DeregisterAgency(f.ctx, &types.MsgDeregisterAgency{Authority: authority, Id: agency.Id})
+		require.NoError(t, err)
+
+		found, err := f.keeper.Agency.Has(f.ctx, agency.Id)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+}
+
+func TestEntryMsgServerAgencyPublisher(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	publisher, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	outsider, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	registerAgency(t, f, "NOAA", publisher)
+
+	_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: publisher, Agency: "NASA"})
+	require.ErrorIs(t, err, types.ErrAgencyNotFound)
+
+	_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: outsider, Agency: "NOAA"})
+	require.ErrorIs(t, err, types.ErrNotAgencyPublisher)
+
+	resp, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: publisher, Agency: "NOAA"})
+	require.NoError(t, err)
+
+	// revoking the publisher prevents further edits of its entries
+	registerAgency(t, f, "NOAA", outsider)
+	_, err = srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: publisher, Id: resp.Id, Agency: "NOAA"})
+	require.ErrorIs(t, err, types.ErrNotAgencyPublisher)
+}
@@ -0,0 +1,359 @@
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"govchain/ipfs"
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+// addContent imports content into store as the upload flow does and returns
+// its CID.
+func addContent(t *testing.T, store *ipfs.MemStore, content []byte) cid.Cid {
+	t.Helper()
+
+	c, err := ipfs.Add(context.Background(), store, bytes.NewReader(content))
+	require.NoError(t, err)
+	return c
+}
+
+// respondChallenge answers challenge with the chunk path read from store.
+func respondChallenge(t *testing.T, store *ipfs.MemStore, challenge types.Challenge) *types.MsgRespondChallenge {
+	t.Helper()
+
+	chunk, path, err := types.ChunkPath(ipfs.GetFunc(context.Background(), store), cid.MustParse(challenge.Cid), challenge.Offset)
+	require.NoError(t, err)
+	return &types.MsgRespondChallenge{Pinner: challenge.Pinner, ChallengeId: challenge.Id, Chunk: chunk, Path: path}
+}
+
+// openChallenge returns the only open challenge of pinner.
+func openChallenge(t *testing.T, f *fixture, pinner string) types.Challenge {
+	t.Helper()
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).Challenges(f.ctx, &types.QueryChallengesRequest{Pinner: pinner})
+	require.NoError(t, err)
+	require.Len(t, res.Challenges, 1)
+	return res.Challenges[0]
+}
+
+func TestChallenges(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithHeaderHash(bytes.Repeat([]byte{0xab}, 32))
+
+	params := types.DefaultParams()
+	params.ChallengesPerBlock = 1
+	params.ChallengeResponseBlocks = 10
+	params.ChallengeFailurePenalty = 100
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	pinnerA, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	pinnerB, err := f.addressCodec.BytesToString([]byte("pinnerB_____________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	// content of several chunks, so that the path holds the root
+	store := ipfs.NewMemStore()
+	content := bytes.Repeat([]byte("rainfall,2024,"), 3*types.UnixFSChunkSize/14+100)
+	root := addContent(t, store, content)
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{
+		Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: root.String(), FileSize: uint64(len(content)),
+	})
+	require.NoError(t, err)
+	attestPin(t, f, resp.Id, root.String(), pinnerA)
+	attestPin(t, f, resp.Id, root.String(), pinnerB)
+
+	t.Run("Issue", func(t *testing.T) {
+		require.NoError(t, f.keeper.BeginBlock(ctx))
+
+		for _, pinner := range []string{pinnerA, pinnerB} {
+			challenge := openChallenge(t, f, pinner)
+			require.Equal(t, resp.Id, challenge.EntryId)
+			require.Equal(t, root.String(), challenge.Cid)
+			require.Less(t, challenge.Offset, uint64(len(content)))
+			require.Equal(t, int64(10), challenge.IssuedHeight)
+			require.Equal(t, int64(20), challenge.DeadlineHeight)
+		}
+
+		// the open challenges are not issued again
+		require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(11)))
+		openChallenge(t, f, pinnerA)
+	})
+	t.Run("InvalidResponse", func(t *testing.T) {
+		challenge := openChallenge(t, f, pinnerA)
+		msg := respondChallenge(t, store, challenge)
+
+		_, err := srv.RespondChallenge(ctx, &types.MsgRespondChallenge{Pinner: pinnerA, ChallengeId: 42, Chunk: msg.Chunk})
+		require.ErrorIs(t, err, types.ErrChallengeNotFound)
+		_, err = srv.RespondChallenge(ctx, &types.MsgRespondChallenge{Pinner: pinnerB, ChallengeId: challenge.Id, Chunk: msg.Chunk, Path: msg.Path})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Pass", func(t *testing.T) {
+		challenge := openChallenge(t, f, pinnerA)
+		res, err := srv.RespondChallenge(ctx.WithBlockHeight(20), respondChallenge(t, store, challenge))
+		require.NoError(t, err)
+		require.True(t, res.Passed)
+		require.Equal(t, uint64(types.MaxPinnerReputation), res.Reputation)
+
+		pinner, err := f.keeper.Pinner.Get(f.ctx, pinnerA)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), pinner.ChallengesPassed)
+		found, err := f.keeper.Challenge.Has(f.ctx, challenge.Id)
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+	t.Run("Fail", func(t *testing.T) {
+		challenge := openChallenge(t, f, pinnerB)
+		// the chunk of another offset does not hold the challenged byte
+		other := challenge
+		other.Offset = (challenge.Offset + types.UnixFSChunkSize) % uint64(len(content))
+		res, err := srv.RespondChallenge(ctx, respondChallenge(t, store, other))
+		require.NoError(t, err)
+		require.False(t, res.Passed)
+		require.Equal(t, uint64(types.MaxPinnerReputation-100), res.Reputation)
+
+		pinner, err := f.keeper.Pinner.Get(f.ctx, pinnerB)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), pinner.ChallengesFailed)
+	})
+	t.Run("Miss", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(30)
+		require.NoError(t, f.keeper.BeginBlock(ctx))
+		challenge := openChallenge(t, f, pinnerB)
+
+		// the challenge can be answered up to its deadline
+		require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(40)))
+		openChallenge(t, f, pinnerB)
+		require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(41)))
+		found, err := f.keeper.Challenge.Has(f.ctx, challenge.Id)
+		require.NoError(t, err)
+		require.False(t, found)
+
+		pinner, err := f.keeper.Pinner.Get(f.ctx, pinnerB)
+		require.NoError(t, err)
+		require.Equal(t, uint64(types.MaxPinnerReputation-200), pinner.Reputation)
+		require.Equal(t, uint64(2), pinner.ChallengesFailed)
+
+		_, err = srv.RespondChallenge(ctx.WithBlockHeight(41), respondChallenge(t, store, challenge))
+		require.ErrorIs(t, err, types.ErrChallengeNotFound)
+	})
+	t.Run("Reregister", func(t *testing.T) {
+		_, err := srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: pinnerB, Moniker: "beta"})
+		require.NoError(t, err)
+		pinner, err := f.keeper.Pinner.Get(f.ctx, pinnerB)
+		require.NoError(t, err)
+		require.Equal(t, uint64(types.MaxPinnerReputation-200), pinner.Reputation)
+	})
+}
+
+func TestChallengesPurgedEntry(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithHeaderHash(bytes.Repeat([]byte{0xab}, 32))
+
+	params := types.DefaultParams()
+	params.ChallengesPerBlock = 1
+	params.ChallengeResponseBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	pinner, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	store := ipfs.NewMemStore()
+	content := []byte("rainfall,2024,12.5\n")
+	root := addContent(t, store, content)
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{
+		Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: root.String(), FileSize: uint64(len(content)),
+	})
+	require.NoError(t, err)
+	attestPin(t, f, resp.Id, root.String(), pinner)
+	require.NoError(t, f.keeper.BeginBlock(ctx))
+	challenge := openChallenge(t, f, pinner)
+
+	_, err = srv.PurgeEntry(ctx, &types.MsgPurgeEntry{Authority: authority, Id: resp.Id, Reason: "court order"})
+	require.NoError(t, err)
+
+	// the challenge, its queues and the attestation are removed, and the
+	// deadline passes without failing the pinner
+	found, err := f.keeper.Challenge.Has(f.ctx, challenge.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+	res, err := keeper.NewQueryServerImpl(f.keeper).Challenges(f.ctx, &types.QueryChallengesRequest{Pinner: pinner})
+	require.NoError(t, err)
+	require.Empty(t, res.Challenges)
+	found, err = f.keeper.PinAttestation.Has(f.ctx, collections.Join(resp.Id, pinner))
+	require.NoError(t, err)
+	require.False(t, found)
+	iter, err := f.keeper.PinExpiryQueue.Iterate(f.ctx, nil)
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(challenge.DeadlineHeight+1)))
+	got, err := f.keeper.Pinner.Get(f.ctx, pinner)
+	require.NoError(t, err)
+	require.Zero(t, got.ChallengesFailed)
+	require.Equal(t, uint64(types.MaxPinnerReputation), got.Reputation)
+}
+
+func TestChallengesUpdatedCid(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithHeaderHash(bytes.Repeat([]byte{0xab}, 32))
+
+	params := types.DefaultParams()
+	params.ChallengesPerBlock = 1
+	params.ChallengeResponseBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	pinner, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	store := ipfs.NewMemStore()
+	content := []byte("rainfall,2024,12.5\n")
+	root := addContent(t, store, content)
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{
+		Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: root.String(), FileSize: uint64(len(content)),
+	})
+	require.NoError(t, err)
+	attestPin(t, f, resp.Id, root.String(), pinner)
+	require.NoError(t, f.keeper.BeginBlock(ctx))
+	challenge := openChallenge(t, f, pinner)
+	// a challenge of another entry, which the update leaves open
+	other := types.Challenge{Id: challenge.Id + 1, EntryId: resp.Id + 1, Pinner: pinner, Cid: root.String(), IssuedHeight: 10, DeadlineHeight: 20}
+	require.NoError(t, f.keeper.Challenge.Set(f.ctx, other.Id, other))
+
+	updated := addContent(t, store, []byte("rainfall,2024,13.1\n"))
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, IpfsCid: updated.String(), NewRevision: true})
+	require.NoError(t, err)
+
+	// the challenge of the previous content is removed with its queues, and
+	// the deadline passes without failing the pinner
+	found, err := f.keeper.Challenge.Has(f.ctx, challenge.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+	iter, err := f.keeper.Challenge.Indexes.Entry.MatchExact(f.ctx, resp.Id)
+	require.NoError(t, err)
+	ids, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Empty(t, ids)
+	found, err = f.keeper.ChallengeDeadlineQueue.Has(f.ctx, collections.Join(challenge.DeadlineHeight, challenge.Id))
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = f.keeper.Challenge.Has(f.ctx, other.Id)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(challenge.DeadlineHeight+1)))
+	got, err := f.keeper.Pinner.Get(f.ctx, pinner)
+	require.NoError(t, err)
+	require.Zero(t, got.ChallengesFailed)
+}
+
+func TestChallengesOversizedDeclaration(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithHeaderHash(bytes.Repeat([]byte{0xab}, 32))
+
+	params := types.DefaultParams()
+	params.ChallengesPerBlock = 1
+	params.ChallengeResponseBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	pinnerA, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	pinnerB, err := f.addressCodec.BytesToString([]byte("pinnerB_____________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	// content of several chunks declared a thousand times larger, so that
+	// the offsets are past the content
+	store := ipfs.NewMemStore()
+	content := bytes.Repeat([]byte("rainfall,2024,"), 3*types.UnixFSChunkSize/14+100)
+	root := addContent(t, store, content)
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{
+		Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: root.String(), FileSize: 1000 * uint64(len(content)),
+	})
+	require.NoError(t, err)
+	attestPin(t, f, resp.Id, root.String(), pinnerA)
+	attestPin(t, f, resp.Id, root.String(), pinnerB)
+	require.NoError(t, f.keeper.BeginBlock(ctx))
+
+	// the root block proves that the offset is past the content
+	challenge := openChallenge(t, f, pinnerA)
+	require.GreaterOrEqual(t, challenge.Offset, uint64(len(content)))
+	msg := respondChallenge(t, store, challenge)
+	require.Empty(t, msg.Path)
+	res, err := srv.RespondChallenge(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Passed)
+	require.Equal(t, uint64(types.MaxPinnerReputation), res.Reputation)
+
+	// a chunk of the content does not
+	challenge = openChallenge(t, f, pinnerB)
+	require.GreaterOrEqual(t, challenge.Offset, uint64(len(content)))
+	chunk, path, err := types.ChunkPath(ipfs.GetFunc(context.Background(), store), root, 0)
+	require.NoError(t, err)
+	res, err = srv.RespondChallenge(ctx, &types.MsgRespondChallenge{Pinner: pinnerB, ChallengeId: challenge.Id, Chunk: chunk, Path: path})
+	require.NoError(t, err)
+	require.False(t, res.Passed)
+}
+
+func TestChallengesDeterministic(t *testing.T) {
+	issue := func(hash []byte) []types.Challenge {
+		f := initFixture(t)
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithHeaderHash(hash)
+
+		params := types.DefaultParams()
+		params.ChallengesPerBlock = 3
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+		creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+		require.NoError(t, err)
+		pinner, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+		require.NoError(t, err)
+		registerAgency(t, f, "NOAA", creator)
+
+		store := ipfs.NewMemStore()
+		for i := range 10 {
+			c := addContent(t, store, bytes.Repeat([]byte{byte(i)}, 1000+i))
+			resp, err := keeper.NewMsgServerImpl(f.keeper).CreateEntry(ctx, &types.MsgCreateEntry{
+				Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: c.String(), FileSize: uint64(1000 + i),
+			})
+			require.NoError(t, err)
+			attestPin(t, f, resp.Id, c.String(), pinner)
+		}
+
+		require.NoError(t, f.keeper.BeginBlock(ctx))
+		res, err := keeper.NewQueryServerImpl(f.keeper).Challenges(f.ctx, &types.QueryChallengesRequest{Pinner: pinner})
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Challenges)
+		return res.Challenges
+	}
+
+	hash := bytes.Repeat([]byte{0x01}, 32)
+	require.Equal(t, issue(hash), issue(hash))
+	require.NotEqual(t, issue(hash), issue(bytes.Repeat([]byte{0x02}, 32)))
+}
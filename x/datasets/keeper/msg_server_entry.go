@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"govchain/x/datasets/types"
+	"slices"
+	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -21,17 +23,7 @@ func (k msgServer) CreateEntry(ctx context.Context, msg *types.MsgCreateEntry) (
 	// Get SDK context to access transaction information
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	nextId, err := k.EntrySeq.Next(ctx)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
-	}
-
-	// Extract transaction hash
-	txBytes := sdkCtx.TxBytes()
-	txHash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
-
 	var entry = types.Entry{
-		Id:              nextId,
 		Creator:         msg.Creator,
 		Title:           msg.Title,
 		Description:     msg.Description,
@@ -45,46 +37,84 @@ func (k msgServer) CreateEntry(ctx context.Context, msg *types.MsgCreateEntry) (
 		Agency:          msg.Agency,
 		Category:        msg.Category,
 		Submitter:       msg.Submitter,
-		Timestamp:       msg.Timestamp,
-		PinCount:        msg.PinCount,
-		TxHash:          txHash,
+		PublishedAt:     msg.PublishedAt,
+		MirrorOf:        msg.MirrorOf,
+		CreatedTxHash:   txHash(sdkCtx),
+		CreatedHeight:   sdkCtx.BlockHeight(),
+		CreatedAt:       sdkCtx.BlockTime(),
+		Revision:        1,
 	}
+	setUpdated(sdkCtx, &entry)
 
-	if err = k.Entry.Set(
-		ctx,
-		nextId,
-		entry,
-	); err != nil {
+	nextId, err := k.EntrySeq.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+	entry.Id = nextId
+
+	if err := k.validateEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetEntry(ctx, entry); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set entry")
 	}
 
+	if err := k.appendRevision(ctx, entry, "", msg.Creator, ""); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set entry revision")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventEntryCreated{
+		EntryId:  entry.Id,
+		Agency:   entry.Agency,
+		Category: entry.Category,
+		IpfsCid:  entry.IpfsCid,
+		Creator:  entry.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateEntryResponse{
 		Id: nextId,
 	}, nil
 }
 
-func (k msgServer) UpdateEntry(ctx context.Context, msg *types.MsgUpdateEntry) (*types.MsgUpdateEntryResponse, error) {
+// CreateEntriesBatch creates the entries of the batch in order, as
+// CreateEntry does. A failing entry fails the message, whose transaction is
+// then reverted, so that it leaves none created.
+func (k msgServer) CreateEntriesBatch(ctx context.Context, msg *types.MsgCreateEntriesBatch) (*types.MsgCreateEntriesBatchResponse, error) {
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
-	var entry = types.Entry{
-		Creator:         msg.Creator,
-		Id:              msg.Id,
-		Title:           msg.Title,
-		Description:     msg.Description,
-		IpfsCid:         msg.IpfsCid,
-		MimeType:        msg.MimeType,
-		FileName:        msg.FileName,
-		FileUrl:         msg.FileUrl,
-		FallbackUrl:     msg.FallbackUrl,
-		FileSize:        msg.FileSize,
-		ChecksumSha_256: msg.ChecksumSha_256,
-		Agency:          msg.Agency,
-		Category:        msg.Category,
-		Submitter:       msg.Submitter,
-		Timestamp:       msg.Timestamp,
-		PinCount:        msg.PinCount,
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	switch {
+	case params.MaxBatchEntries == 0:
+		return nil, errorsmod.Wrap(types.ErrInvalidBatch, "entry batches are disabled")
+	case len(msg.Entries) == 0:
+		return nil, errorsmod.Wrap(types.ErrInvalidBatch, "no entries")
+	case len(msg.Entries) > int(params.MaxBatchEntries):
+		return nil, errorsmod.Wrapf(types.ErrInvalidBatch, "%d entries exceed the maximum of %d", len(msg.Entries), params.MaxBatchEntries)
+	}
+
+	ids := make([]uint64, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		res, err := k.CreateEntry(ctx, entry.MsgCreateEntry(msg.Creator))
+		if err != nil {
+			return nil, types.WrapBatchEntryError(err, i)
+		}
+		ids[i] = res.Id
+	}
+
+	return &types.MsgCreateEntriesBatchResponse{Ids: ids}, nil
+}
+
+func (k msgServer) UpdateEntry(ctx context.Context, msg *types.MsgUpdateEntry) (*types.MsgUpdateEntryResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
 	// Checks that the element exists
@@ -102,10 +132,78 @@ func (k msgServer) UpdateEntry(ctx context.Context, msg *types.MsgUpdateEntry) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	if err := k.Entry.Set(ctx, msg.Id, entry); err != nil {
+	if val.IsRetracted() {
+		return nil, errorsmod.Wrapf(types.ErrEntryRetracted, "entry %d cannot be updated", msg.Id)
+	}
+
+	// Only the fields selected by the update mask are replaced; the creator,
+	// provenance and status of the entry are preserved.
+	paths, err := msg.UpdatePaths()
+	if err != nil {
+		return nil, err
+	}
+	entry := msg.ApplyTo(val, paths)
+
+	// The content of a pinned entry is only replaced by a new revision.
+	pinCount, err := k.pinCount(ctx, msg.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get pin count")
+	}
+	if pinCount > 0 && !msg.NewRevision {
+		var immutable []string
+		for _, field := range val.ChangedFields(entry) {
+			if slices.Contains(types.ContentEntryFields, field) {
+				immutable = append(immutable, field)
+			}
+		}
+		if len(immutable) > 0 {
+			return nil, errorsmod.Wrapf(types.ErrImmutableField, "%s of pinned entry %d; set new_revision to publish new content", strings.Join(immutable, ", "), msg.Id)
+		}
+	}
+
+	if err := k.validateEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	// An update changing nothing is not recorded as a revision.
+	changed := val.ChangedFields(entry)
+	if len(changed) == 0 {
+		return &types.MsgUpdateEntryResponse{}, nil
+	}
+	entry.Revision++
+	setUpdated(sdk.UnwrapSDKContext(ctx), &entry)
+
+	// The attestations and challenges are for the previous content and no
+	// longer count.
+	if types.NormalizeCid(entry.IpfsCid) != types.NormalizeCid(val.IpfsCid) {
+		if err := k.clearPinAttestations(ctx, entry.Id); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear pin attestations")
+		}
+		if err := k.clearChallenges(ctx, entry.Id); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear challenges")
+		}
+	}
+
+	if err := k.SetEntry(ctx, entry); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update entry")
 	}
 
+	if err := k.appendRevision(ctx, entry, val.IpfsCid, msg.Creator, msg.ChangeReason); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set entry revision")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventEntryUpdated{
+		EntryId:       entry.Id,
+		Agency:        entry.Agency,
+		Category:      entry.Category,
+		IpfsCid:       entry.IpfsCid,
+		Creator:       entry.Creator,
+		Revision:      entry.Revision,
+		ChangedFields: changed,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateEntryResponse{}, nil
 }
 
@@ -129,9 +227,145 @@ func (k msgServer) DeleteEntry(ctx context.Context, msg *types.MsgDeleteEntry) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
-	if err := k.Entry.Remove(ctx, msg.Id); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete entry")
+	if val.IsRetracted() {
+		return nil, errorsmod.Wrapf(types.ErrEntryRetracted, "entry %d is already retracted", msg.Id)
+	}
+
+	// Published entries are never removed by their publisher: the entry is
+	// kept, flagged as retracted and the retraction recorded in its history.
+	previousCid := val.IpfsCid
+	val.Status = types.ENTRY_STATUS_RETRACTED
+	val.Retraction = &types.Retraction{
+		Reason: msg.Reason,
+		Height: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Signer: msg.Creator,
+	}
+	val.Revision++
+	setUpdated(sdk.UnwrapSDKContext(ctx), &val)
+
+	// The retracted content is no longer attested to nor challenged.
+	if err := k.clearPinAttestations(ctx, val.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear pin attestations")
+	}
+	if err := k.clearChallenges(ctx, val.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear challenges")
+	}
+
+	if err := k.SetEntry(ctx, val); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to retract entry")
+	}
+
+	if err := k.appendRevision(ctx, val, previousCid, msg.Creator, msg.Reason); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set entry revision")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventEntryDeleted{
+		EntryId:  val.Id,
+		Agency:   val.Agency,
+		Category: val.Category,
+		IpfsCid:  val.IpfsCid,
+		Creator:  val.Creator,
+		Reason:   msg.Reason,
+	}); err != nil {
+		return nil, err
 	}
 
 	return &types.MsgDeleteEntryResponse{}, nil
 }
+
+// validateEntry performs the checks of an entry that depend on chain state:
+// the creator must be an authorized publisher of the registered agency, the
+// publication time must not lie after the current block time and the entry
+// must comply with the module parameters.
+func (k msgServer) validateEntry(ctx context.Context, entry types.Entry) error {
+	if err := k.checkPublisher(ctx, entry.Agency, entry.Creator); err != nil {
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if entry.PublishedAt.After(blockTime) {
+		return errorsmod.Wrapf(types.ErrInvalidPublishedAt, "%s is after the current block time %s", entry.PublishedAt.Format(time.RFC3339), blockTime.Format(time.RFC3339))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	if params.MaxTitleLength > 0 && len(entry.Title) > int(params.MaxTitleLength) {
+		return errorsmod.Wrapf(types.ErrInvalidTitle, "title exceeds %d characters", params.MaxTitleLength)
+	}
+	if params.MaxDescriptionLength > 0 && len(entry.Description) > int(params.MaxDescriptionLength) {
+		return errorsmod.Wrapf(types.ErrInvalidDescription, "description exceeds %d characters", params.MaxDescriptionLength)
+	}
+	if params.MaxFileSizeBytes > 0 && entry.FileSize > params.MaxFileSizeBytes {
+		return errorsmod.Wrapf(types.ErrFileTooLarge, "%d bytes exceeds %d", entry.FileSize, params.MaxFileSizeBytes)
+	}
+	if !params.IsMimeTypeAllowed(entry.MimeType) {
+		return errorsmod.Wrapf(types.ErrMimeTypeNotAllowed, "%s", entry.MimeType)
+	}
+	if !params.IsCategoryAllowed(entry.Category) {
+		return errorsmod.Wrapf(types.ErrCategoryNotAllowed, "%s", entry.Category)
+	}
+	if params.RequireFallbackUrl && entry.FallbackUrl == "" {
+		return types.ErrMissingFallbackURL
+	}
+	return k.checkDuplicate(ctx, entry)
+}
+
+func (k msgServer) PurgeEntry(ctx context.Context, msg *types.MsgPurgeEntry) (*types.MsgPurgeEntryResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateReason(msg.Reason); err != nil {
+		return nil, err
+	}
+
+	val, err := k.Entry.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get entry")
+	}
+
+	// The mirrors would be left linked to a missing entry.
+	mirrors, err := k.mirrorsOf(ctx, msg.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get entry mirrors")
+	}
+	if len(mirrors) > 0 {
+		return nil, errorsmod.Wrapf(types.ErrEntryMirrored, "entry %d is mirrored by entries %v; purge them first", msg.Id, mirrors)
+	}
+
+	if err := k.clearPinAttestations(ctx, val.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to purge pin attestations")
+	}
+
+	if err := k.clearChallenges(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to purge challenges")
+	}
+
+	if err := k.RemoveEntry(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to purge entry")
+	}
+
+	// The revisions hold copies of the entry metadata and are purged with it.
+	if err := k.EntryRevision.Clear(ctx, collections.NewPrefixedPairRange[uint64, uint64](msg.Id)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to purge entry revisions")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventEntryDeleted{
+		EntryId:  val.Id,
+		Agency:   val.Agency,
+		Category: val.Category,
+		IpfsCid:  val.IpfsCid,
+		Creator:  val.Creator,
+		Reason:   msg.Reason,
+		Purged:   true,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurgeEntryResponse{}, nil
+}
@@ -1,9 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"github.com/stretchr/testify/require"
 
 	"govchain/x/datasets/keeper"
@@ -16,9 +22,10 @@ func TestEntryMsgServerCreate(t *testing.T) {
 
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
 
 	for i := 0; i < 5; i++ {
-		resp, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator})
+		resp, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA"})
 		require.NoError(t, err)
 		require.Equal(t, i, int(resp.Id))
 	}
@@ -30,11 +37,12 @@ func TestEntryMsgServerUpdate(t *testing.T) {
 
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
 
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator})
+	_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA"})
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -59,7 +67,7 @@ func TestEntryMsgServerUpdate(t *testing.T) {
 		},
 		{
 			desc:    "completed",
-			request: &types.MsgUpdateEntry{Creator: creator},
+			request: &types.MsgUpdateEntry{Creator: creator, Agency: "NOAA"},
 		},
 	}
 	for _, tc := range tests {
@@ -74,17 +82,50 @@ func TestEntryMsgServerUpdate(t *testing.T) {
 	}
 }
 
+func TestEntryMsgServerUpdateNoop(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "title"})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	for _, msg := range []*types.MsgUpdateEntry{
+		{Creator: creator, Id: resp.Id, Title: "title"},
+		{Creator: creator, Id: resp.Id, UpdateMask: &gogotypes.FieldMask{Paths: []string{"title", "agency"}}, Title: "title", Agency: "NOAA"},
+		{Creator: creator, Id: resp.Id, ChangeReason: "no change", NewRevision: true},
+	} {
+		_, err := srv.UpdateEntry(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	entry, err := f.keeper.Entry.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), entry.Revision)
+	require.Equal(t, int64(10), entry.UpdatedHeight)
+	history, err := qs.EntryHistory(ctx, &types.QueryEntryHistoryRequest{EntryId: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 1)
+}
+
 func TestEntryMsgServerDelete(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
 
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
 
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator})
+	_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA"})
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -109,7 +150,12 @@ func TestEntryMsgServerDelete(t *testing.T) {
 		},
 		{
 			desc:    "completed",
-			request: &types.MsgDeleteEntry{Creator: creator},
+			request: &types.MsgDeleteEntry{Creator: creator, Reason: "superseded by a corrected release"},
+		},
+		{
+			desc:    "already retracted",
+			request: &types.MsgDeleteEntry{Creator: creator, Reason: "superseded by a corrected release"},
+			err:     types.ErrEntryRetracted,
 		},
 	}
 	for _, tc := range tests {
@@ -123,3 +169,487 @@ func TestEntryMsgServerDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestEntryMsgServerCreatePublishedAt(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	blockTime := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(blockTime)
+
+	_, err = srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", PublishedAt: blockTime.Add(time.Hour)})
+	require.ErrorIs(t, err, types.ErrInvalidPublishedAt)
+
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", PublishedAt: blockTime.Add(-time.Hour)})
+	require.NoError(t, err)
+
+	entry, err := f.keeper.Entry.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(-time.Hour), entry.PublishedAt)
+	require.Equal(t, blockTime, entry.CreatedAt)
+}
+
+func TestEntryMsgServerParams(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{
+		MaxTitleLength:       5,
+		MaxDescriptionLength: 5,
+		MaxFileSizeBytes:     100,
+		AllowedMimeTypes:     []string{"text/*"},
+		AllowedCategories:    []string{"budget"},
+		RequireFallbackUrl:   true,
+	}))
+
+	valid := types.MsgCreateEntry{
+		Creator:     creator,
+		Agency:      "NOAA",
+		Title:       "title",
+		MimeType:    "text/csv",
+		Category:    "budget",
+		FileSize:    100,
+		FallbackUrl: "https://example.gov/data.csv",
+	}
+
+	tests := []struct {
+		desc   string
+		modify func(msg *types.MsgCreateEntry)
+		err    error
+	}{
+		{desc: "valid", modify: func(msg *types.MsgCreateEntry) {}},
+		{desc: "title too long", modify: func(msg *types.MsgCreateEntry) { msg.Title = "titles" }, err: types.ErrInvalidTitle},
+		{desc: "description too long", modify: func(msg *types.MsgCreateEntry) { msg.Description = "longer" }, err: types.ErrInvalidDescription},
+		{desc: "file too large", modify: func(msg *types.MsgCreateEntry) { msg.FileSize = 101 }, err: types.ErrFileTooLarge},
+		{desc: "mime type not allowed", modify: func(msg *types.MsgCreateEntry) { msg.MimeType = "application/pdf" }, err: types.ErrMimeTypeNotAllowed},
+		{desc: "category not allowed", modify: func(msg *types.MsgCreateEntry) { msg.Category = "climate" }, err: types.ErrCategoryNotAllowed},
+		{desc: "missing fallback url", modify: func(msg *types.MsgCreateEntry) { msg.FallbackUrl = "" }, err: types.ErrMissingFallbackURL},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := valid
+			tc.modify(&msg)
+			_, err := srv.CreateEntry(f.ctx, &msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	resp, err := srv.CreateEntry(f.ctx, &valid)
+	require.NoError(t, err)
+	_, err = srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{
+		Creator:     creator,
+		Id:          resp.Id,
+		Agency:      valid.Agency,
+		Title:       valid.Title,
+		MimeType:    "application/pdf",
+		Category:    valid.Category,
+		FallbackUrl: valid.FallbackUrl,
+	})
+	require.ErrorIs(t, err, types.ErrMimeTypeNotAllowed)
+}
+
+func TestEntryMsgServerRetraction(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	for i := 0; i < 2; i++ {
+		_, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: "cid-" + strconv.Itoa(i)})
+		require.NoError(t, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(42)
+	_, err = srv.DeleteEntry(ctx, &types.MsgDeleteEntry{Creator: creator, Id: 0, Reason: "wrong file"})
+	require.NoError(t, err)
+
+	t.Run("RetrievableById", func(t *testing.T) {
+		resp, err := qs.GetEntry(ctx, &types.QueryGetEntryRequest{Id: 0})
+		require.NoError(t, err)
+		require.True(t, resp.Entry.IsRetracted())
+		require.Equal(t, &types.Retraction{Reason: "wrong file", Height: 42, Signer: creator}, resp.Entry.Retraction)
+		require.Equal(t, uint64(2), resp.Entry.Revision)
+	})
+	t.Run("RecordedInHistory", func(t *testing.T) {
+		resp, err := qs.EntryRevision(ctx, &types.QueryEntryRevisionRequest{EntryId: 0, Revision: 2})
+		require.NoError(t, err)
+		require.Equal(t, "wrong file", resp.Revision.ChangeReason)
+		require.True(t, resp.Revision.Entry.IsRetracted())
+	})
+	t.Run("HiddenFromListings", func(t *testing.T) {
+		resp, err := qs.ListEntry(ctx, &types.QueryAllEntryRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Entry, 1)
+		require.Equal(t, uint64(1), resp.Entry[0].Id)
+
+		resp, err = qs.ListEntry(ctx, &types.QueryAllEntryRequest{IncludeRetracted: true})
+		require.NoError(t, err)
+		require.Len(t, resp.Entry, 2)
+
+		byAgency, err := qs.EntriesByAgency(ctx, &types.QueryEntriesByAgencyRequest{Agency: "NOAA"})
+		require.NoError(t, err)
+		require.Len(t, byAgency.Entry, 1)
+		require.Equal(t, uint64(1), byAgency.Pagination.Total)
+	})
+	t.Run("NotUpdatable", func(t *testing.T) {
+		_, err := srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: 0, Agency: "NOAA"})
+		require.ErrorIs(t, err, types.ErrEntryRetracted)
+	})
+}
+
+func TestEntryMsgServerPurge(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	for i := 0; i < 2; i++ {
+		resp, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA"})
+		require.NoError(t, err)
+		_, err = srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, Agency: "NOAA", Title: "title"})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		desc    string
+		request *types.MsgPurgeEntry
+		err     error
+	}{
+		{
+			desc:    "not the authority",
+			request: &types.MsgPurgeEntry{Authority: creator, Id: 0, Reason: "court order"},
+			err:     types.ErrInvalidSigner,
+		},
+		{
+			desc:    "missing reason",
+			request: &types.MsgPurgeEntry{Authority: authority, Id: 0},
+			err:     types.ErrInvalidReason,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgPurgeEntry{Authority: authority, Id: 10, Reason: "court order"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgPurgeEntry{Authority: authority, Id: 0, Reason: "court order"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.PurgeEntry(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, err = qs.GetEntry(f.ctx, &types.QueryGetEntryRequest{Id: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	history, err := qs.EntryHistory(f.ctx, &types.QueryEntryHistoryRequest{EntryId: 0})
+	require.NoError(t, err)
+	require.Empty(t, history.Revisions)
+
+	// the other entry and its history are untouched
+	history, err = qs.EntryHistory(f.ctx, &types.QueryEntryHistoryRequest{EntryId: 1})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 2)
+}
+
+func TestEntryMsgServerProvenance(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	created := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithBlockTime(created).WithTxBytes([]byte("create"))
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "v1"})
+	require.NoError(t, err)
+
+	entry, err := f.keeper.Entry.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), entry.CreatedHeight)
+	require.Equal(t, created, entry.CreatedAt)
+	require.NotEmpty(t, entry.CreatedTxHash)
+	require.Equal(t, entry.CreatedTxHash, entry.UpdatedTxHash)
+	require.Equal(t, entry.CreatedHeight, entry.UpdatedHeight)
+	require.Equal(t, entry.CreatedAt, entry.UpdatedAt)
+
+	updated := created.Add(time.Hour)
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(updated).WithTxBytes([]byte("update"))
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, Agency: "NOAA", Title: "v2"})
+	require.NoError(t, err)
+
+	got, err := f.keeper.Entry.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, "v2", got.Title)
+	require.Equal(t, entry.Creator, got.Creator)
+	require.Equal(t, entry.CreatedTxHash, got.CreatedTxHash)
+	require.Equal(t, entry.CreatedHeight, got.CreatedHeight)
+	require.Equal(t, entry.CreatedAt, got.CreatedAt)
+	require.NotEqual(t, entry.CreatedTxHash, got.UpdatedTxHash)
+	require.Equal(t, int64(11), got.UpdatedHeight)
+	require.Equal(t, updated, got.UpdatedAt)
+
+	// entries modified outside of a transaction have no transaction hash
+	ctx = ctx.WithBlockHeight(12).WithTxBytes(nil)
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, Agency: "NOAA", Title: "v3"})
+	require.NoError(t, err)
+	got, err = f.keeper.Entry.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Empty(t, got.UpdatedTxHash)
+	require.Equal(t, int64(12), got.UpdatedHeight)
+}
+
+func TestEntryMsgServerUpdateMask(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	resp, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{
+		Creator:     creator,
+		Agency:      "NOAA",
+		Title:       "title",
+		Description: "description",
+		IpfsCid:     "cid-1",
+		FallbackUrl: "https://example.gov/data.csv",
+	})
+	require.NoError(t, err)
+	attestPin(t, f, resp.Id, "cid-1", creator)
+
+	t.Run("UnsetFieldsPreserved", func(t *testing.T) {
+		_, err := srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, Title: "new title"})
+		require.NoError(t, err)
+
+		entry, err := f.keeper.Entry.Get(f.ctx, resp.Id)
+		require.NoError(t, err)
+		require.Equal(t, "new title", entry.Title)
+		require.Equal(t, "description", entry.Description)
+		require.Equal(t, "NOAA", entry.Agency)
+		require.Equal(t, "cid-1", entry.IpfsCid)
+	})
+	t.Run("MaskClearsField", func(t *testing.T) {
+		_, err := srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{
+			Creator:    creator,
+			Id:         resp.Id,
+			Title:      "ignored",
+			UpdateMask: &gogotypes.FieldMask{Paths: []string{"fallback_url"}},
+		})
+		require.NoError(t, err)
+
+		entry, err := f.keeper.Entry.Get(f.ctx, resp.Id)
+		require.NoError(t, err)
+		require.Empty(t, entry.FallbackUrl)
+		require.Equal(t, "new title", entry.Title)
+	})
+	t.Run("PinnedContentRequiresNewRevision", func(t *testing.T) {
+		_, err := srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, IpfsCid: "cid-2", FileSize: 10})
+		require.ErrorIs(t, err, types.ErrImmutableField)
+		require.ErrorContains(t, err, "ipfs_cid, file_size")
+
+		// resubmitting the current cid is not a change
+		_, err = srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, IpfsCid: "cid-1"})
+		require.NoError(t, err)
+
+		_, err = srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, IpfsCid: "cid-2", NewRevision: true})
+		require.NoError(t, err)
+
+		// the attestations of the previous content no longer count
+		entry, err := f.keeper.Entry.Get(f.ctx, resp.Id)
+		require.NoError(t, err)
+		require.Equal(t, "cid-2", entry.IpfsCid)
+		require.Zero(t, pinCount(t, f, resp.Id))
+	})
+	t.Run("ImmutableFieldInMask", func(t *testing.T) {
+		_, err := srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{
+			Creator:    creator,
+			Id:         resp.Id,
+			UpdateMask: &gogotypes.FieldMask{Paths: []string{"created_tx_hash"}},
+		})
+		require.ErrorIs(t, err, types.ErrImmutableField)
+		require.ErrorContains(t, err, "created_tx_hash")
+	})
+}
+
+func TestEntryMsgServerDuplicate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	const (
+		cidV0 = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+		cidV1 = "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"
+	)
+	original, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: cidV0, ChecksumSha_256: "ABCDEF"})
+	require.NoError(t, err)
+	var mirrorId uint64
+
+	t.Run("SameCidInOtherVersion", func(t *testing.T) {
+		_, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: cidV1})
+		require.ErrorIs(t, err, types.ErrDuplicateEntry)
+		require.ErrorContains(t, err, "registered by entry 0")
+	})
+	t.Run("SameChecksumInOtherCase", func(t *testing.T) {
+		_, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", ChecksumSha_256: "abcdef"})
+		require.ErrorIs(t, err, types.ErrDuplicateEntry)
+	})
+	t.Run("UpdateToDuplicate", func(t *testing.T) {
+		other, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: "cid-other"})
+		require.NoError(t, err)
+
+		_, err = srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: other.Id, IpfsCid: cidV1})
+		require.ErrorIs(t, err, types.ErrDuplicateEntry)
+	})
+	t.Run("Mirror", func(t *testing.T) {
+		mirror, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{
+			Creator:  creator,
+			Agency:   "NOAA",
+			IpfsCid:  cidV1,
+			MirrorOf: &types.MirrorLink{EntryId: original.Id},
+		})
+		require.NoError(t, err)
+
+		mirrorId = mirror.Id
+		entry, err := f.keeper.Entry.Get(f.ctx, mirror.Id)
+		require.NoError(t, err)
+		require.True(t, entry.IsMirror())
+
+		id, err := f.keeper.EntryByCid.Get(f.ctx, types.NormalizeCid(cidV0))
+		require.NoError(t, err)
+		require.Equal(t, original.Id, id)
+	})
+	t.Run("MirrorOfUnknownEntry", func(t *testing.T) {
+		_, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: cidV0, MirrorOf: &types.MirrorLink{EntryId: 100}})
+		require.ErrorIs(t, err, types.ErrInvalidMirror)
+	})
+	t.Run("MirrorWithOtherContent", func(t *testing.T) {
+		_, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: "cid-mirror", MirrorOf: &types.MirrorLink{EntryId: original.Id}})
+		require.ErrorIs(t, err, types.ErrInvalidMirror)
+	})
+	t.Run("PurgeMirrored", func(t *testing.T) {
+		authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+		require.NoError(t, err)
+		_, err = srv.PurgeEntry(f.ctx, &types.MsgPurgeEntry{Authority: authority, Id: original.Id, Reason: "duplicate upload"})
+		require.ErrorIs(t, err, types.ErrEntryMirrored)
+		require.ErrorContains(t, err, fmt.Sprintf("mirrored by entries [%d]", mirrorId))
+
+		_, err = srv.PurgeEntry(f.ctx, &types.MsgPurgeEntry{Authority: authority, Id: mirrorId, Reason: "duplicate upload"})
+		require.NoError(t, err)
+		has, err := f.keeper.EntryMirrors.Has(f.ctx, collections.Join(original.Id, mirrorId))
+		require.NoError(t, err)
+		require.False(t, has)
+	})
+	t.Run("PurgeReleasesContent", func(t *testing.T) {
+		authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+		require.NoError(t, err)
+		_, err = srv.PurgeEntry(f.ctx, &types.MsgPurgeEntry{Authority: authority, Id: original.Id, Reason: "duplicate upload"})
+		require.NoError(t, err)
+
+		_, err = srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", ChecksumSha_256: "abcdef"})
+		require.NoError(t, err)
+	})
+}
+
+func TestEntryMsgServerCreateBatch(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	batch := func(cids ...string) *types.MsgCreateEntriesBatch {
+		msg := &types.MsgCreateEntriesBatch{Creator: creator}
+		for _, cid := range cids {
+			msg.Entries = append(msg.Entries, types.BatchEntry{Agency: "NOAA", IpfsCid: cid})
+		}
+		return msg
+	}
+
+	resp, err := srv.CreateEntriesBatch(f.ctx, batch("cid-0", "cid-1", "cid-2"))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, resp.Ids)
+	for i, id := range resp.Ids {
+		entry, err := f.keeper.Entry.Get(f.ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, creator, entry.Creator)
+		require.Equal(t, "cid-"+strconv.Itoa(i), entry.IpfsCid)
+	}
+
+	t.Run("FailingEntry", func(t *testing.T) {
+		// the failing message is reverted with its transaction
+		cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+		_, err := srv.CreateEntriesBatch(cacheCtx, batch("cid-3", "cid-4", "cid-3"))
+		require.ErrorIs(t, err, types.ErrDuplicateEntry)
+		i, ok := types.BatchEntryIndex(err)
+		require.True(t, ok)
+		require.Equal(t, 2, i)
+
+		_, err = f.keeper.EntryByCid.Get(f.ctx, "cid-3")
+		require.Error(t, err)
+		resp, err := srv.CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: "cid-3"})
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), resp.Id)
+	})
+	t.Run("InvalidAddress", func(t *testing.T) {
+		msg := batch("cid-5")
+		msg.Creator = "invalid"
+		_, err := srv.CreateEntriesBatch(f.ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	})
+	t.Run("Empty", func(t *testing.T) {
+		_, err := srv.CreateEntriesBatch(f.ctx, batch())
+		require.ErrorIs(t, err, types.ErrInvalidBatch)
+	})
+	t.Run("AboveMaximum", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxBatchEntries = 2
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+		_, err := srv.CreateEntriesBatch(f.ctx, batch("cid-5", "cid-6", "cid-7"))
+		require.ErrorIs(t, err, types.ErrInvalidBatch)
+		_, err = srv.CreateEntriesBatch(f.ctx, batch("cid-5", "cid-6"))
+		require.NoError(t, err)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MaxBatchEntries = 0
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+		_, err := srv.CreateEntriesBatch(f.ctx, batch("cid-8"))
+		require.ErrorIs(t, err, types.ErrInvalidBatch)
+	})
+}
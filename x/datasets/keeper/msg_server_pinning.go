@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ipfs/go-cid"
+)
+
+func (k msgServer) RegisterPinner(ctx context.Context, msg *types.MsgRegisterPinner) (*types.MsgRegisterPinnerResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Pinner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	allowed, err := k.isPinnerAllowed(ctx, msg.Pinner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get allowed pinner")
+	}
+	if !allowed {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an allowed pinner", msg.Pinner)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	pinner := types.Pinner{
+		Address:          msg.Pinner,
+		RegisteredHeight: sdkCtx.BlockHeight(),
+		Reputation:       types.MaxPinnerReputation,
+	}
+
+	// Registering again only updates the moniker and endpoint.
+	old, err := k.Pinner.Get(ctx, msg.Pinner)
+	switch {
+	case err == nil:
+		pinner = old
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get pinner")
+	}
+	pinner.Moniker, pinner.Endpoint = msg.Moniker, msg.Endpoint
+	if err := pinner.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Pinner.Set(ctx, pinner.Address, pinner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set pinner")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPinnerRegistered{
+		Pinner:   pinner.Address,
+		Moniker:  pinner.Moniker,
+		Endpoint: pinner.Endpoint,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterPinnerResponse{}, nil
+}
+
+func (k msgServer) AttestPin(ctx context.Context, msg *types.MsgAttestPin) (*types.MsgAttestPinResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Pinner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	found, err := k.Pinner.Has(ctx, msg.Pinner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get pinner")
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPinnerNotFound, "%s", msg.Pinner)
+	}
+
+	allowed, err := k.isPinnerAllowed(ctx, msg.Pinner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get allowed pinner")
+	}
+	if !allowed {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is no longer an allowed pinner", msg.Pinner)
+	}
+
+	entry, err := k.Entry.Get(ctx, msg.EntryId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.EntryId))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get entry")
+	}
+	if entry.IsRetracted() {
+		return nil, errorsmod.Wrapf(types.ErrEntryRetracted, "entry %d cannot be pinned", msg.EntryId)
+	}
+	if entry.IpfsCid == "" || types.NormalizeCid(msg.Cid) != types.NormalizeCid(entry.IpfsCid) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPinAttestation, "cid %s is not the cid of entry %d", msg.Cid, msg.EntryId)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	if params.PinAttestationWindowBlocks == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPinAttestation, "pin attestations are disabled")
+	}
+
+	// The proof must lie within the window, so that the attestation counts
+	// for at least one block.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+	expires := msg.ProofHeight + int64(params.PinAttestationWindowBlocks)
+	if msg.ProofHeight > height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPinAttestation, "proof height %d is after the current height %d", msg.ProofHeight, height)
+	}
+	if expires <= height {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPinAttestation, "proof height %d is older than the attestation window of %d blocks", msg.ProofHeight, params.PinAttestationWindowBlocks)
+	}
+
+	old, found, err := k.getPinAttestation(ctx, msg.EntryId, msg.Pinner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get pin attestation")
+	}
+	if found && msg.ProofHeight < old.ProofHeight {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPinAttestation, "proof height %d is before the attested proof height %d", msg.ProofHeight, old.ProofHeight)
+	}
+
+	att := types.PinAttestation{
+		EntryId:        msg.EntryId,
+		Pinner:         msg.Pinner,
+		Cid:            msg.Cid,
+		ProofHeight:    msg.ProofHeight,
+		AttestedHeight: height,
+		ExpiresHeight:  expires,
+	}
+	if err := k.setPinAttestation(ctx, att); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set pin attestation")
+	}
+
+	// The entry itself is left unchanged: the attestations describe the
+	// availability of its content, not the entry.
+	pinCount, err := k.pinCount(ctx, msg.EntryId)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get pin count")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPinAttested{
+		EntryId:       att.EntryId,
+		Pinner:        att.Pinner,
+		Cid:           att.Cid,
+		ProofHeight:   att.ProofHeight,
+		ExpiresHeight: att.ExpiresHeight,
+		PinCount:      pinCount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestPinResponse{ExpiresHeight: expires}, nil
+}
+
+func (k msgServer) RespondChallenge(ctx context.Context, msg *types.MsgRespondChallenge) (*types.MsgRespondChallengeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Pinner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	// Resolved challenges are removed, and BeginBlock fails the missed ones,
+	// so any stored challenge can still be answered.
+	challenge, err := k.Challenge.Get(ctx, msg.ChallengeId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrChallengeNotFound, "challenge %d is not open", msg.ChallengeId)
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get challenge")
+	}
+	if challenge.Pinner != msg.Pinner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "challenge %d is not for %s", msg.ChallengeId, msg.Pinner)
+	}
+
+	root, err := cid.Decode(challenge.Cid)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "invalid challenge cid")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+
+	// An invalid proof fails the challenge rather than the transaction, so
+	// that it counts against the pinner.
+	failure := types.VerifyChunkPath(root, challenge.Offset, msg.Chunk, msg.Path)
+	pinner, err := k.resolveChallenge(ctx, params, challenge, failure)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to resolve challenge")
+	}
+
+	return &types.MsgRespondChallengeResponse{Passed: failure == nil, Reputation: pinner.Reputation}, nil
+}
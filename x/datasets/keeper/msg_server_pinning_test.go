@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+// attestPin registers pinner and attests that it pins the content of entry
+// id, with a proof at the current block height.
+func attestPin(t *testing.T, f *fixture, id uint64, cid, pinner string) {
+	t.Helper()
+
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	if ctx.BlockHeight() < 1 {
+		ctx = ctx.WithBlockHeight(1)
+	}
+	allowPinners(t, f, pinner)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	_, err := srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: pinner, Moniker: "pinner"})
+	require.NoError(t, err)
+	_, err = srv.AttestPin(ctx, &types.MsgAttestPin{Pinner: pinner, EntryId: id, Cid: cid, ProofHeight: ctx.BlockHeight()})
+	require.NoError(t, err)
+}
+
+// allowPinners adds pinners to the allowed pinners.
+func allowPinners(t *testing.T, f *fixture, pinners ...string) {
+	t.Helper()
+
+	for _, pinner := range pinners {
+		addr, err := f.addressCodec.StringToBytes(pinner)
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.AllowedPinners.Set(f.ctx, addr))
+	}
+}
+
+// pinCount returns the pin count of the entry with the given id.
+func pinCount(t *testing.T, f *fixture, id uint64) uint32 {
+	t.Helper()
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).GetEntry(f.ctx, &types.QueryGetEntryRequest{Id: id})
+	require.NoError(t, err)
+	return res.PinCount
+}
+
+func TestPinningMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	pinnerA, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	pinnerB, err := f.addressCodec.BytesToString([]byte("pinnerB_____________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.PinAttestationWindowBlocks = 50
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	allowPinners(t, f, pinnerA)
+	registerAgency(t, f, "NOAA", creator)
+
+	const cidV0 = "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"
+	const cidV1 = "bafybeicg2rebjoofv4kbyovkw7af3rpiitvnl6i7ckcywaq6xjcxnc2mby"
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: cidV0})
+	require.NoError(t, err)
+
+	t.Run("Register", func(t *testing.T) {
+		_, err := srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: pinnerA, Moniker: "alpha"})
+		require.NoError(t, err)
+		_, err = srv.RegisterPinner(ctx.WithBlockHeight(120), &types.MsgRegisterPinner{Pinner: pinnerA, Moniker: "alpha", Endpoint: "/dns4/alpha.example.org/tcp/4001"})
+		require.NoError(t, err)
+
+		pinner, err := f.keeper.Pinner.Get(f.ctx, pinnerA)
+		require.NoError(t, err)
+		require.Equal(t, types.Pinner{Address: pinnerA, Moniker: "alpha", Endpoint: "/dns4/alpha.example.org/tcp/4001", RegisteredHeight: 100, Reputation: types.MaxPinnerReputation}, pinner)
+
+		// registration is restricted to the allowed pinners
+		_, err = srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: pinnerB, Moniker: "beta"})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		_, err = srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: "invalid", Moniker: "beta"})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+		allowPinners(t, f, pinnerB)
+		_, err = srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: pinnerB})
+		require.ErrorIs(t, err, types.ErrInvalidPinner)
+	})
+	t.Run("InvalidAttestation", func(t *testing.T) {
+		for _, tc := range []struct {
+			desc string
+			msg  types.MsgAttestPin
+			err  error
+		}{
+			{"unregistered pinner", types.MsgAttestPin{Pinner: pinnerB, EntryId: resp.Id, Cid: cidV0, ProofHeight: 100}, types.ErrPinnerNotFound},
+			{"missing entry", types.MsgAttestPin{Pinner: pinnerA, EntryId: 42, Cid: cidV0, ProofHeight: 100}, sdkerrors.ErrKeyNotFound},
+			{"other cid", types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH", ProofHeight: 100}, types.ErrInvalidPinAttestation},
+			{"future proof", types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV0, ProofHeight: 101}, types.ErrInvalidPinAttestation},
+			{"stale proof", types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV0, ProofHeight: 50}, types.ErrInvalidPinAttestation},
+		} {
+			t.Run(tc.desc, func(t *testing.T) {
+				_, err := srv.AttestPin(ctx, &tc.msg)
+				require.ErrorIs(t, err, tc.err)
+			})
+		}
+	})
+	t.Run("Attest", func(t *testing.T) {
+		// either CID version of the entry content is accepted
+		res, err := srv.AttestPin(ctx, &types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV1, ProofHeight: 90})
+		require.NoError(t, err)
+		require.Equal(t, int64(140), res.ExpiresHeight)
+
+		_, err = srv.RegisterPinner(ctx, &types.MsgRegisterPinner{Pinner: pinnerB, Moniker: "beta"})
+		require.NoError(t, err)
+		_, err = srv.AttestPin(ctx, &types.MsgAttestPin{Pinner: pinnerB, EntryId: resp.Id, Cid: cidV0, ProofHeight: 100})
+		require.NoError(t, err)
+
+		require.Equal(t, uint32(2), pinCount(t, f, resp.Id))
+		// the pinners are returned with the entry, and with each listed entry
+		qs := keeper.NewQueryServerImpl(f.keeper)
+		got, err := qs.GetEntry(f.ctx, &types.QueryGetEntryRequest{Id: resp.Id})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{pinnerA, pinnerB}, got.Pinners)
+		list, err := qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{})
+		require.NoError(t, err)
+		require.Len(t, list.Pins, len(list.Entry))
+		require.Equal(t, resp.Id, list.Pins[0].EntryId)
+		require.Equal(t, uint32(2), list.Pins[0].PinCount)
+		require.ElementsMatch(t, []string{pinnerA, pinnerB}, list.Pins[0].Pinners)
+		// the entry itself is left unchanged
+		entry, err := f.keeper.Entry.Get(f.ctx, resp.Id)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), entry.Revision)
+	})
+	t.Run("Renew", func(t *testing.T) {
+		_, err := srv.AttestPin(ctx.WithBlockHeight(130), &types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV0, ProofHeight: 80})
+		require.ErrorIs(t, err, types.ErrInvalidPinAttestation)
+
+		res, err := srv.AttestPin(ctx.WithBlockHeight(130), &types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV0, ProofHeight: 125})
+		require.NoError(t, err)
+		require.Equal(t, int64(175), res.ExpiresHeight)
+
+		att, err := f.keeper.PinAttestation.Get(f.ctx, collections.Join(resp.Id, pinnerA))
+		require.NoError(t, err)
+		require.Equal(t, types.PinAttestation{EntryId: resp.Id, Pinner: pinnerA, Cid: cidV0, ProofHeight: 125, AttestedHeight: 130, ExpiresHeight: 175}, att)
+		found, err := f.keeper.PinExpiryQueue.Has(f.ctx, collections.Join3(int64(140), resp.Id, pinnerA))
+		require.NoError(t, err)
+		require.False(t, found)
+	})
+	t.Run("Expire", func(t *testing.T) {
+		require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(149)))
+		require.Equal(t, uint32(2), pinCount(t, f, resp.Id))
+
+		require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(150)))
+		require.Equal(t, uint32(1), pinCount(t, f, resp.Id))
+		found, err := f.keeper.PinAttestation.Has(f.ctx, collections.Join(resp.Id, pinnerB))
+		require.NoError(t, err)
+		require.False(t, found)
+
+		require.NoError(t, f.keeper.BeginBlock(ctx.WithBlockHeight(175)))
+		require.Zero(t, pinCount(t, f, resp.Id))
+		found, err = f.keeper.PinCount.Has(f.ctx, resp.Id)
+		require.NoError(t, err)
+		require.False(t, found)
+
+		msg, broken := keeper.PinCountsInvariant(f.keeper)(ctx)
+		require.False(t, broken, msg)
+	})
+	t.Run("Retracted", func(t *testing.T) {
+		_, err := srv.AttestPin(ctx.WithBlockHeight(178), &types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV0, ProofHeight: 178})
+		require.NoError(t, err)
+
+		// the retraction clears the attestations and their expiry
+		_, err = srv.DeleteEntry(ctx.WithBlockHeight(180), &types.MsgDeleteEntry{Creator: creator, Id: resp.Id, Reason: "superseded"})
+		require.NoError(t, err)
+		require.Zero(t, pinCount(t, f, resp.Id))
+		found, err := f.keeper.PinExpiryQueue.Has(f.ctx, collections.Join3(int64(228), resp.Id, pinnerA))
+		require.NoError(t, err)
+		require.False(t, found)
+		msg, broken := keeper.PinCountsInvariant(f.keeper)(ctx)
+		require.False(t, broken, msg)
+
+		_, err = srv.AttestPin(ctx.WithBlockHeight(180), &types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: cidV0, ProofHeight: 180})
+		require.ErrorIs(t, err, types.ErrEntryRetracted)
+	})
+	t.Run("NoLongerAllowed", func(t *testing.T) {
+		resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "allowed", IpfsCid: "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"})
+		require.NoError(t, err)
+
+		addrB, err := f.addressCodec.StringToBytes(pinnerB)
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.AllowedPinners.Remove(f.ctx, addrB))
+		_, err = srv.AttestPin(ctx, &types.MsgAttestPin{Pinner: pinnerB, EntryId: resp.Id, Cid: "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH", ProofHeight: 100})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "other", IpfsCid: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"})
+		require.NoError(t, err)
+
+		params.PinAttestationWindowBlocks = 0
+		require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+		_, err = srv.AttestPin(ctx, &types.MsgAttestPin{Pinner: pinnerA, EntryId: resp.Id, Cid: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", ProofHeight: 100})
+		require.ErrorIs(t, err, types.ErrInvalidPinAttestation)
+		require.ErrorContains(t, err, "disabled")
+	})
+}
+
+func TestPinningContentChange(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	pinner, err := f.addressCodec.BytesToString([]byte("pinnerA_____________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: "cid-1"})
+	require.NoError(t, err)
+	attestPin(t, f, resp.Id, "cid-1", pinner)
+
+	// a metadata change keeps the attestations
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, Title: "new title"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), pinCount(t, f, resp.Id))
+
+	// the pin count is not a field of the entry
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, UpdateMask: &gogotypes.FieldMask{Paths: []string{"pin_count"}}})
+	require.ErrorIs(t, err, types.ErrInvalidFieldMask)
+
+	_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{Creator: creator, Id: resp.Id, IpfsCid: "cid-2", NewRevision: true})
+	require.NoError(t, err)
+	require.Zero(t, pinCount(t, f, resp.Id))
+	found, err := f.keeper.PinAttestation.Has(f.ctx, collections.Join(resp.Id, pinner))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	attestPin(t, f, resp.Id, "cid-2", pinner)
+	_, err = srv.PurgeEntry(ctx, &types.MsgPurgeEntry{Authority: authority, Id: resp.Id, Reason: "court order"})
+	require.NoError(t, err)
+	found, err = f.keeper.PinAttestation.Has(f.ctx, collections.Join(resp.Id, pinner))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	msg, broken := keeper.PinCountsInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestPinnersQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+	resp, err := keeper.NewMsgServerImpl(f.keeper).CreateEntry(f.ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "title", IpfsCid: "cid-1"})
+	require.NoError(t, err)
+
+	var pinners []string
+	for _, name := range []string{"pinnerA", "pinnerB", "pinnerC"} {
+		pinner, err := f.addressCodec.BytesToString([]byte(name + "_____________________"))
+		require.NoError(t, err)
+		attestPin(t, f, resp.Id, "cid-1", pinner)
+		pinners = append(pinners, pinner)
+	}
+
+	res, err := qs.Pinners(f.ctx, &types.QueryPinnersRequest{EntryId: resp.Id, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	for _, att := range res.Attestations {
+		require.Contains(t, pinners, att.Pinner)
+		require.Equal(t, "cid-1", att.Cid)
+	}
+
+	res, err = qs.Pinners(f.ctx, &types.QueryPinnersRequest{EntryId: resp.Id + 1})
+	require.NoError(t, err)
+	require.Empty(t, res.Attestations)
+
+	pinner, err := qs.GetPinner(f.ctx, &types.QueryGetPinnerRequest{Address: pinners[0]})
+	require.NoError(t, err)
+	require.Equal(t, "pinner", pinner.Pinner.Moniker)
+	_, err = qs.GetPinner(f.ctx, &types.QueryGetPinnerRequest{Address: creator})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"govchain/x/datasets/types"
+)
+
+func (k msgServer) UpdateAllowedPinners(ctx context.Context, req *types.MsgUpdateAllowedPinners) (*types.MsgUpdateAllowedPinnersResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	allow, err := k.allowedPinnerAddresses(req.Allow)
+	if err != nil {
+		return nil, err
+	}
+	revoke, err := k.allowedPinnerAddresses(req.Revoke)
+	if err != nil {
+		return nil, err
+	}
+	if len(allow) == 0 && len(revoke) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no pinner to allow or revoke")
+	}
+
+	for _, addr := range revoke {
+		if err := k.AllowedPinners.Remove(ctx, addr); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to revoke allowed pinner")
+		}
+	}
+	for _, addr := range allow {
+		if err := k.AllowedPinners.Set(ctx, addr); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set allowed pinner")
+		}
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	count, err := k.allowedPinnerCount(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to count allowed pinners")
+	}
+	if len(allow) > 0 && count > int(params.MaxAllowedPinners) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%d allowed pinners exceed the maximum of %d", count, params.MaxAllowedPinners)
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAllowedPinnersUpdated{
+		Authority: req.Authority,
+		Allowed:   req.Allow,
+		Revoked:   req.Revoke,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAllowedPinnersResponse{}, nil
+}
+
+// allowedPinnerAddresses decodes the addresses of an allowed pinner update,
+// rejecting the duplicates.
+func (k msgServer) allowedPinnerAddresses(addresses []string) ([]sdk.AccAddress, error) {
+	seen := make(map[string]bool, len(addresses))
+	decoded := make([]sdk.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		if seen[address] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated pinner %s", address)
+		}
+		seen[address] = true
+
+		addr, err := k.addressCodec.StringToBytes(address)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pinner %s: %s", address, err)
+		}
+		decoded = append(decoded, addr)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestMsgUpdateAllowedPinners(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.MaxAllowedPinners = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	var pinners []string
+	for _, raw := range []string{"pinnerA_____________________", "pinnerB_____________________", "pinnerC_____________________"} {
+		pinner, err := f.addressCodec.BytesToString([]byte(raw))
+		require.NoError(t, err)
+		pinners = append(pinners, pinner)
+	}
+	allowed := func() []string {
+		res, err := keeper.NewQueryServerImpl(f.keeper).AllowedPinners(f.ctx, &types.QueryAllowedPinnersRequest{})
+		require.NoError(t, err)
+		return res.Addresses
+	}
+
+	t.Run("Allow", func(t *testing.T) {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+		_, err := srv.UpdateAllowedPinners(ctx, &types.MsgUpdateAllowedPinners{Authority: authority, Allow: pinners[:2]})
+		require.NoError(t, err)
+		require.Equal(t, pinners[:2], allowed())
+		// typed events decode empty lists as non-nil
+		require.Equal(t, []proto.Message{&types.EventAllowedPinnersUpdated{Authority: authority, Allowed: pinners[:2], Revoked: []string{}}}, typedEvents(t, ctx))
+	})
+	t.Run("AboveMax", func(t *testing.T) {
+		_, err := srv.UpdateAllowedPinners(f.ctx, &types.MsgUpdateAllowedPinners{Authority: authority, Allow: pinners[2:]})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+	t.Run("RevokeAndAllow", func(t *testing.T) {
+		// the revocations are applied first, making room for the allowed pinners
+		_, err := srv.UpdateAllowedPinners(f.ctx, &types.MsgUpdateAllowedPinners{Authority: authority, Allow: pinners[2:], Revoke: pinners[:1]})
+		require.NoError(t, err)
+		require.Equal(t, pinners[1:], allowed())
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := srv.UpdateAllowedPinners(f.ctx, &types.MsgUpdateAllowedPinners{Authority: pinners[0], Allow: pinners[:1]})
+		require.ErrorIs(t, err, types.ErrInvalidSigner)
+		_, err = srv.UpdateAllowedPinners(f.ctx, &types.MsgUpdateAllowedPinners{Authority: authority, Allow: []string{"invalid"}})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+		_, err = srv.UpdateAllowedPinners(f.ctx, &types.MsgUpdateAllowedPinners{Authority: authority, Revoke: []string{pinners[0], pinners[0]}})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+		_, err = srv.UpdateAllowedPinners(f.ctx, &types.MsgUpdateAllowedPinners{Authority: authority})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
@@ -5,22 +5,22 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"govchain/x/datasets/types"
 )
 
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authority, err := k.addressCodec.StringToBytes(req.Authority)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
 	}
 
-	if !bytes.Equal(k.GetAuthority(), authority) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
 	}
 
-	if err := req.Params.Validate(); err != nil {
+	previous, err := k.Params.Get(ctx)
+	if err != nil {
 		return nil, err
 	}
 
@@ -28,5 +28,27 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		Authority:     req.Authority,
+		Params:        req.Params,
+		ChangedFields: previous.ChangedFields(req.Params),
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// checkAuthority returns an error unless authority is the module authority.
+func (k msgServer) checkAuthority(authority string) error {
+	authorityBytes, err := k.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authorityBytes) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, authority)
+	}
+	return nil
+}
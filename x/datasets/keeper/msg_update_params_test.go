@@ -43,6 +43,15 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "invalid params",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{AllowedMimeTypes: []string{"not-a-mime-type"}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid allowed mime type",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"govchain/x/datasets/types"
+)
+
+// EndBlock closes the current reporting period when it is due: at every
+// height multiple of the snapshot interval or, when the periods follow an
+// epoch, once the epoch has ended.
+func (k Keeper) EndBlock(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	current, err := k.CurrentPeriod.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	due := current.EpochEnded
+	if params.SnapshotEpochIdentifier == "" {
+		due = params.SnapshotIntervalBlocks > 0 && uint64(height)%params.SnapshotIntervalBlocks == 0
+	}
+	if !due {
+		return nil
+	}
+	return k.closePeriod(ctx, current)
+}
+
+// closePeriod stores the Merkle root over the entries changed during the
+// current period, as of the current block, and opens the next period. Entries
+// purged during the period are left out.
+func (k Keeper) closePeriod(ctx context.Context, current types.CurrentPeriod) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var leaves []types.PeriodLeaf
+	err := k.PeriodChanges.Walk(ctx, nil, func(id uint64) (bool, error) {
+		entry, err := k.Entry.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return true, err
+		}
+		hash, err := entry.ContentHash()
+		if err != nil {
+			return true, err
+		}
+		leaves = append(leaves, types.PeriodLeaf{PeriodId: current.PeriodId, EntryId: id, ContentHash: hash})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, leaf := range leaves {
+		if err := k.PeriodLeaf.Set(ctx, collections.Join(leaf.PeriodId, leaf.EntryId), leaf); err != nil {
+			return err
+		}
+	}
+	root := types.PeriodRoot{
+		PeriodId:    current.PeriodId,
+		Root:        types.PeriodMerkleRoot(leaves),
+		StartHeight: current.StartHeight,
+		EndHeight:   sdkCtx.BlockHeight(),
+		ClosedAt:    sdkCtx.BlockTime(),
+		EntryCount:  uint64(len(leaves)),
+	}
+	if err := k.PeriodRoot.Set(ctx, root.PeriodId, root); err != nil {
+		return err
+	}
+
+	if err := k.PeriodChanges.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.CurrentPeriod.Set(ctx, types.CurrentPeriod{
+		PeriodId:    current.PeriodId + 1,
+		StartHeight: sdkCtx.BlockHeight() + 1,
+	}); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventPeriodClosed{
+		PeriodId:    root.PeriodId,
+		Root:        hex.EncodeToString(root.Root),
+		StartHeight: root.StartHeight,
+		EndHeight:   root.EndHeight,
+		EntryCount:  root.EntryCount,
+	})
+}
+
+// periodLeaves returns the leaves of a closed period, ordered by entry id.
+func (k Keeper) periodLeaves(ctx context.Context, periodId uint64) ([]types.PeriodLeaf, error) {
+	var leaves []types.PeriodLeaf
+	err := k.PeriodLeaf.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](periodId), func(_ collections.Pair[uint64, uint64], leaf types.PeriodLeaf) (bool, error) {
+		leaves = append(leaves, leaf)
+		return false, nil
+	})
+	return leaves, err
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestPeriodSnapshots(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.SnapshotIntervalBlocks = 10
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateEntry(ctx.WithBlockHeight(3), &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", Title: "title"})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(5)))
+	_, err = qs.PeriodRoot(f.ctx, &types.QueryPeriodRootRequest{PeriodId: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(10)))
+	period1, err := qs.PeriodRoot(f.ctx, &types.QueryPeriodRootRequest{PeriodId: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), period1.PeriodRoot.EntryCount)
+	require.Equal(t, int64(1), period1.PeriodRoot.StartHeight)
+	require.Equal(t, int64(10), period1.PeriodRoot.EndHeight)
+
+	current, err := f.keeper.CurrentPeriod.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentPeriod{PeriodId: 2, StartHeight: 11}, current)
+
+	// entry 1 changes and entry 2 is purged during the second period
+	_, err = srv.UpdateEntry(ctx.WithBlockHeight(12), &types.MsgUpdateEntry{Creator: creator, Id: 1, Title: "new title"})
+	require.NoError(t, err)
+	_, err = srv.UpdateEntry(ctx.WithBlockHeight(12), &types.MsgUpdateEntry{Creator: creator, Id: 2, Title: "new title"})
+	require.NoError(t, err)
+	_, err = srv.PurgeEntry(ctx.WithBlockHeight(13), &types.MsgPurgeEntry{Authority: authority, Id: 2, Reason: "court order"})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(20)))
+
+	period2, err := qs.PeriodRoot(f.ctx, &types.QueryPeriodRootRequest{PeriodId: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), period2.PeriodRoot.EntryCount)
+
+	t.Run("InclusionProof", func(t *testing.T) {
+		resp, err := qs.EntryInclusionProof(f.ctx, &types.QueryEntryInclusionProofRequest{PeriodId: 1, EntryId: 1})
+		require.NoError(t, err)
+		require.Equal(t, period1.PeriodRoot.Root, resp.Root)
+		require.Equal(t, int64(1), resp.Proof.Index)
+		require.Equal(t, int64(3), resp.Proof.Total)
+		require.NoError(t, resp.Proof.Verify(resp.Root))
+
+		// the leaf is the entry as recorded by its first revision
+		rev, err := f.keeper.EntryRevision.Get(f.ctx, collections.Join(uint64(1), uint64(1)))
+		require.NoError(t, err)
+		hash, err := rev.Entry.ContentHash()
+		require.NoError(t, err)
+		require.Equal(t, hash, resp.Proof.ContentHash)
+
+		_, err = qs.EntryInclusionProof(f.ctx, &types.QueryEntryInclusionProofRequest{PeriodId: 1, EntryId: 1, ContentHash: hash})
+		require.NoError(t, err)
+
+		require.ErrorIs(t, resp.Proof.Verify(period2.PeriodRoot.Root), types.ErrInvalidInclusionProof)
+		tampered := resp.Proof
+		tampered.EntryId = 0
+		require.ErrorIs(t, tampered.Verify(resp.Root), types.ErrInvalidInclusionProof)
+
+		resp, err = qs.EntryInclusionProof(f.ctx, &types.QueryEntryInclusionProofRequest{PeriodId: 2, EntryId: 1})
+		require.NoError(t, err)
+		require.NoError(t, resp.Proof.Verify(period2.PeriodRoot.Root))
+		require.NotEqual(t, hash, resp.Proof.ContentHash)
+	})
+
+	t.Run("NotIncluded", func(t *testing.T) {
+		_, err := qs.EntryInclusionProof(f.ctx, &types.QueryEntryInclusionProofRequest{PeriodId: 2, EntryId: 0})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+		_, err = qs.EntryInclusionProof(f.ctx, &types.QueryEntryInclusionProofRequest{PeriodId: 3, EntryId: 0})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+		_, err = qs.EntryInclusionProof(f.ctx, &types.QueryEntryInclusionProofRequest{PeriodId: 1, EntryId: 0, ContentHash: []byte{1}})
+		require.ErrorIs(t, err, types.ErrInvalidInclusionProof)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.PeriodRoot(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = qs.EntryInclusionProof(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+
+	msg, broken := keeper.PeriodRootsInvariant(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestPeriodSnapshotsEpoch(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.SnapshotIntervalBlocks = 10
+	params.SnapshotEpochIdentifier = "week"
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// the interval is ignored when the periods follow an epoch
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(10)))
+	require.NoError(t, f.keeper.Hooks().AfterEpochEnd(f.ctx, "day", 1))
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(11)))
+	has, err := f.keeper.PeriodRoot.Has(f.ctx, 1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, f.keeper.Hooks().AfterEpochEnd(f.ctx, "week", 1))
+	require.NoError(t, f.keeper.EndBlock(ctx.WithBlockHeight(12)))
+	root, err := f.keeper.PeriodRoot.Get(f.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.PeriodMerkleRoot(nil), root.Root)
+	require.Equal(t, int64(12), root.EndHeight)
+
+	current, err := f.keeper.CurrentPeriod.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentPeriod{PeriodId: 2, StartHeight: 13}, current)
+}
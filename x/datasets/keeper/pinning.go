@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"govchain/x/datasets/types"
+)
+
+// BeginBlock expires the pin attestations whose window ends at the current
+// height, so that they no longer count towards the pin count of their entry,
+// fails the challenges whose deadline has passed and issues the challenges of
+// the block.
+func (k Keeper) BeginBlock(ctx context.Context) error {
+	if err := k.expirePinAttestations(ctx); err != nil {
+		return err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.expireChallenges(ctx, params); err != nil {
+		return err
+	}
+	return k.issueChallenges(ctx, params)
+}
+
+// expirePinAttestations removes the pin attestations expiring at the current
+// height.
+func (k Keeper) expirePinAttestations(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var expired []collections.Triple[int64, uint64, string]
+	if err := k.PinExpiryQueue.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, uint64, string](height), func(key collections.Triple[int64, uint64, string]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		att, err := k.PinAttestation.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return err
+		}
+		if err := k.removePinAttestation(ctx, att); err != nil {
+			return err
+		}
+		pinCount, err := k.pinCount(ctx, att.EntryId)
+		if err != nil {
+			return err
+		}
+		if err := emitPinExpired(ctx, att, pinCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setPinAttestation stores att, replacing the previous attestation of the
+// same pinner for the entry, if any, and counts it for the entry.
+func (k Keeper) setPinAttestation(ctx context.Context, att types.PinAttestation) error {
+	old, found, err := k.getPinAttestation(ctx, att.EntryId, att.Pinner)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.PinExpiryQueue.Remove(ctx, collections.Join3(old.ExpiresHeight, old.EntryId, old.Pinner)); err != nil {
+			return err
+		}
+	} else {
+		pinCount, err := k.pinCount(ctx, att.EntryId)
+		if err != nil {
+			return err
+		}
+		if err := k.PinCount.Set(ctx, att.EntryId, pinCount+1); err != nil {
+			return err
+		}
+	}
+	if err := k.PinAttestation.Set(ctx, collections.Join(att.EntryId, att.Pinner), att); err != nil {
+		return err
+	}
+	return k.PinExpiryQueue.Set(ctx, collections.Join3(att.ExpiresHeight, att.EntryId, att.Pinner))
+}
+
+// getPinAttestation returns the attestation of pinner for the entry with the
+// given id.
+func (k Keeper) getPinAttestation(ctx context.Context, id uint64, pinner string) (types.PinAttestation, bool, error) {
+	att, err := k.PinAttestation.Get(ctx, collections.Join(id, pinner))
+	switch {
+	case err == nil:
+		return att, true, nil
+	case errors.Is(err, collections.ErrNotFound):
+		return types.PinAttestation{}, false, nil
+	default:
+		return types.PinAttestation{}, false, err
+	}
+}
+
+// removePinAttestation removes att and its expiry, and no longer counts it
+// for the entry.
+func (k Keeper) removePinAttestation(ctx context.Context, att types.PinAttestation) error {
+	if err := k.PinExpiryQueue.Remove(ctx, collections.Join3(att.ExpiresHeight, att.EntryId, att.Pinner)); err != nil {
+		return err
+	}
+	if err := k.PinAttestation.Remove(ctx, collections.Join(att.EntryId, att.Pinner)); err != nil {
+		return err
+	}
+	pinCount, err := k.pinCount(ctx, att.EntryId)
+	if err != nil {
+		return err
+	}
+	if pinCount <= 1 {
+		return k.PinCount.Remove(ctx, att.EntryId)
+	}
+	return k.PinCount.Set(ctx, att.EntryId, pinCount-1)
+}
+
+// clearPinAttestations removes every attestation of the entry with the given
+// id, whose content is no longer the attested one or no longer published.
+func (k Keeper) clearPinAttestations(ctx context.Context, id uint64) error {
+	atts, err := k.pinAttestations(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, att := range atts {
+		if err := k.removePinAttestation(ctx, att); err != nil {
+			return err
+		}
+		if err := emitPinExpired(ctx, att, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pinCount returns the number of current attestations of the entry with the
+// given id.
+func (k Keeper) pinCount(ctx context.Context, id uint64) (uint32, error) {
+	pinCount, err := k.PinCount.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return pinCount, err
+}
+
+// entryPins returns the pin count and the current pinners of the entry with
+// the given id.
+func (k Keeper) entryPins(ctx context.Context, id uint64) (types.EntryPins, error) {
+	pins := types.EntryPins{EntryId: id}
+	atts, err := k.pinAttestations(ctx, id)
+	if err != nil {
+		return pins, err
+	}
+	for _, att := range atts {
+		pins.Pinners = append(pins.Pinners, att.Pinner)
+	}
+	pins.PinCount = uint32(len(pins.Pinners))
+	return pins, nil
+}
+
+// pinAttestations returns the attestations of the entry with the given id, by
+// pinner address.
+func (k Keeper) pinAttestations(ctx context.Context, id uint64) ([]types.PinAttestation, error) {
+	var atts []types.PinAttestation
+	err := k.PinAttestation.Walk(ctx, collections.NewPrefixedPairRange[uint64, string](id), func(_ collections.Pair[uint64, string], att types.PinAttestation) (bool, error) {
+		atts = append(atts, att)
+		return false, nil
+	})
+	return atts, err
+}
+
+// isPinnerAllowed reports whether address is allowed to register as a pinner
+// and to attest to pins.
+func (k Keeper) isPinnerAllowed(ctx context.Context, address string) (bool, error) {
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return false, err
+	}
+	return k.AllowedPinners.Has(ctx, addr)
+}
+
+// allowedPinnerCount returns the number of allowed pinners, which the
+// max_allowed_pinners param bounds.
+func (k Keeper) allowedPinnerCount(ctx context.Context) (int, error) {
+	var count int
+	err := k.AllowedPinners.Walk(ctx, nil, func(sdk.AccAddress) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
+
+func emitPinExpired(ctx context.Context, att types.PinAttestation, pinCount uint32) error {
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventPinExpired{
+		EntryId:  att.EntryId,
+		Pinner:   att.Pinner,
+		Cid:      att.Cid,
+		PinCount: pinCount,
+	})
+}
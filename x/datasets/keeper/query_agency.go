@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListAgency(ctx context.Context, req *types.QueryAllAgencyRequest) (*types.QueryAllAgencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	agencies, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Agency,
+		req.Pagination,
+		func(_ string, value types.Agency) (types.Agency, error) {
+			return value, nil
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAgencyResponse{Agency: agencies, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetAgency(ctx context.Context, req *types.QueryGetAgencyRequest) (*types.QueryGetAgencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	agency, err := q.k.Agency.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAgencyResponse{Agency: agency}, nil
+}
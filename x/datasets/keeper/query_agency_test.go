@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func createNAgency(keeper keeper.Keeper, ctx context.Context, n int) []types.Agency {
+	items := make([]types.Agency, n)
+	for i := range items {
+		items[i].Id = fmt.Sprintf("agency-%d", i)
+		items[i].Name = fmt.Sprintf("Agency %d", i)
+		_ = keeper.Agency.Set(ctx, items[i].Id, items[i])
+	}
+	return items
+}
+
+func TestAgencyQuerySingle(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNAgency(f.keeper, f.ctx, 2)
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetAgencyRequest
+		response *types.QueryGetAgencyResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetAgencyRequest{Id: msgs[0].Id},
+			response: &types.QueryGetAgencyResponse{Agency: msgs[0]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetAgencyRequest{Id: "missing"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.GetAgency(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualExportedValues(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestAgencyQueryPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	msgs := createNAgency(f.keeper, f.ctx, 5)
+
+	var next []byte
+	for i := 0; i < len(msgs); i += 2 {
+		resp, err := qs.ListAgency(f.ctx, &types.QueryAllAgencyRequest{
+			Pagination: &query.PageRequest{Key: next, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.Agency), 2)
+		require.Subset(t, msgs, resp.Agency)
+		next = resp.Pagination.NextKey
+	}
+
+	resp, err := qs.ListAgency(f.ctx, &types.QueryAllAgencyRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, len(msgs), int(resp.Pagination.Total))
+	require.EqualExportedValues(t, msgs, resp.Agency)
+
+	_, err = qs.ListAgency(f.ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
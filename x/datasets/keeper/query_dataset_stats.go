@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) DatasetStats(ctx context.Context, req *types.QueryDatasetStatsRequest) (*types.QueryDatasetStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	totals, err := q.k.EntryTotals.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	buckets := make(map[string][]types.StatsBucket, len(types.StatsDimensions))
+	if err := q.k.EntryStats.Walk(ctx, nil, func(key collections.Pair[string, string], count types.EntryCount) (bool, error) {
+		buckets[key.K1()] = append(buckets[key.K1()], types.StatsBucket{
+			Key:     key.K2(),
+			Entries: count.Entries,
+			Bytes:   count.Bytes,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDatasetStatsResponse{
+		Totals:         totals,
+		ByAgency:       buckets[types.StatsDimensionAgency],
+		ByCategory:     buckets[types.StatsDimensionCategory],
+		ByMimeType:     buckets[types.StatsDimensionMimeType],
+		ByCreatedMonth: buckets[types.StatsDimensionCreatedMonth],
+	}, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestDatasetStatsQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+	registerAgency(t, f, "PAGASA", creator)
+
+	may := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC))
+	june := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	for _, msg := range []struct {
+		ctx      sdk.Context
+		agency   string
+		category string
+		size     uint64
+	}{
+		{may, "NOAA", "climate", 100},
+		{may, "NOAA", "budget", 200},
+		{june, "PAGASA", "climate", 300},
+		{june, "PAGASA", "climate", 400},
+	} {
+		_, err := srv.CreateEntry(msg.ctx, &types.MsgCreateEntry{
+			Creator:  creator,
+			Agency:   msg.agency,
+			Category: msg.category,
+			MimeType: "text/csv",
+			FileSize: msg.size,
+		})
+		require.NoError(t, err)
+	}
+
+	// entry 1 moves to another category, entry 2 is retracted and entry 3 purged
+	_, err = srv.UpdateEntry(june, &types.MsgUpdateEntry{Creator: creator, Id: 1, Category: "climate", FileSize: 250})
+	require.NoError(t, err)
+	_, err = srv.DeleteEntry(june, &types.MsgDeleteEntry{Creator: creator, Id: 2, Reason: "wrong file"})
+	require.NoError(t, err)
+	_, err = srv.PurgeEntry(june, &types.MsgPurgeEntry{Authority: authority, Id: 3, Reason: "court order"})
+	require.NoError(t, err)
+
+	resp, err := qs.DatasetStats(f.ctx, &types.QueryDatasetStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryDatasetStatsResponse{
+		Totals:         types.EntryTotals{Entries: 2, Bytes: 350, RetractedEntries: 1},
+		ByAgency:       []types.StatsBucket{{Key: "NOAA", Entries: 2, Bytes: 350}},
+		ByCategory:     []types.StatsBucket{{Key: "climate", Entries: 2, Bytes: 350}},
+		ByMimeType:     []types.StatsBucket{{Key: "text/csv", Entries: 2, Bytes: 350}},
+		ByCreatedMonth: []types.StatsBucket{{Key: "2024-05", Entries: 2, Bytes: 350}},
+	}, resp)
+
+	msg, broken := keeper.EntryStatsInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.DatasetStats(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
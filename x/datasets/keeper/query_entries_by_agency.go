@@ -14,7 +14,10 @@ func (q queryServer) EntriesByAgency(ctx context.Context, req *types.QueryEntrie
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	// TODO: Process the query
+	entries, pageRes, err := q.k.paginateEntryIndex(ctx, q.k.Entry.Indexes.Agency, req.Agency, req.Pagination)
+	if err != nil {
+		return nil, paginationError(err)
+	}
 
-	return &types.QueryEntriesByAgencyResponse{}, nil
+	return &types.QueryEntriesByAgencyResponse{Entry: entries, Pagination: pageRes}, nil
 }
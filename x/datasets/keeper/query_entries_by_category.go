@@ -14,7 +14,10 @@ func (q queryServer) EntriesByCategory(ctx context.Context, req *types.QueryEntr
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	// TODO: Process the query
+	entries, pageRes, err := q.k.paginateEntryIndex(ctx, q.k.Entry.Indexes.Category, req.Category, req.Pagination)
+	if err != nil {
+		return nil, paginationError(err)
+	}
 
-	return &types.QueryEntriesByCategoryResponse{}, nil
+	return &types.QueryEntriesByCategoryResponse{Entry: entries, Pagination: pageRes}, nil
 }
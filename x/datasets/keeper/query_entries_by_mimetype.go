@@ -14,7 +14,10 @@ func (q queryServer) EntriesByMimetype(ctx context.Context, req *types.QueryEntr
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	// TODO: Process the query
+	entries, pageRes, err := q.k.paginateEntryIndex(ctx, q.k.Entry.Indexes.MimeType, req.MimeType, req.Pagination)
+	if err != nil {
+		return nil, paginationError(err)
+	}
 
-	return &types.QueryEntriesByMimetypeResponse{}, nil
+	return &types.QueryEntriesByMimetypeResponse{Entry: entries, Pagination: pageRes}, nil
 }
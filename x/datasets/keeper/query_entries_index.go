@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// entryIterator iterates over the entry ids of an ordering in key order.
+type entryIterator[K any] interface {
+	Valid() bool
+	Next()
+	Close() error
+	FullKey() (K, error)
+	PrimaryKey() (uint64, error)
+}
+
+// entryOrdering is a key space ordering the entries, bounded by the inclusive
+// keys start and end.
+type entryOrdering[K any] struct {
+	keyCodec   collcodec.KeyCodec[K]
+	start, end K
+	iterate    func(ctx context.Context, ranger collections.Ranger[K]) (entryIterator[K], error)
+}
+
+// contains reports whether the encoded key lies between the bounds of o.
+func (o entryOrdering[K]) contains(key []byte) (bool, error) {
+	start, err := collections.EncodeKeyWithPrefix(nil, o.keyCodec, o.start)
+	if err != nil {
+		return false, err
+	}
+	end, err := collections.EncodeKeyWithPrefix(nil, o.keyCodec, o.end)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Compare(key, start) >= 0 && bytes.Compare(key, end) <= 0, nil
+}
+
+// primaryIterator adapts an iterator over the Entry map to entryIterator.
+type primaryIterator struct {
+	collections.Iterator[uint64, types.Entry]
+}
+
+func (i primaryIterator) FullKey() (uint64, error)    { return i.Key() }
+func (i primaryIterator) PrimaryKey() (uint64, error) { return i.Key() }
+
+// entriesById orders the entries by id.
+func (k Keeper) entriesById() entryOrdering[uint64] {
+	return entryOrdering[uint64]{
+		keyCodec: collections.Uint64Key,
+		start:    0,
+		end:      math.MaxUint64,
+		iterate: func(ctx context.Context, ranger collections.Ranger[uint64]) (entryIterator[uint64], error) {
+			iter, err := k.Entry.Iterate(ctx, ranger)
+			return primaryIterator{iter}, err
+		},
+	}
+}
+
+// entriesByIndex orders the entries referenced by idx between the reference
+// keys start and end, then by id.
+func entriesByIndex[R any](idx *indexes.Multi[R, uint64, types.Entry], start, end R) entryOrdering[collections.Pair[R, uint64]] {
+	return entryOrdering[collections.Pair[R, uint64]]{
+		keyCodec: idx.KeyCodec(),
+		start:    collections.Join(start, uint64(0)),
+		end:      collections.Join(end, uint64(math.MaxUint64)),
+		iterate: func(ctx context.Context, ranger collections.Ranger[collections.Pair[R, uint64]]) (entryIterator[collections.Pair[R, uint64]], error) {
+			return idx.Iterate(ctx, ranger)
+		},
+	}
+}
+
+// paginateEntries returns the entries of ordering for which match returns
+// true. It follows the key, offset, limit, count_total and reverse semantics
+// of query.CollectionPaginate; next_key is the encoded ordering key of the
+// first entry of the following page. Invalid page requests are reported with
+// the InvalidArgument status code.
+func paginateEntries[K any](
+	ctx context.Context,
+	k Keeper,
+	ordering entryOrdering[K],
+	match func(types.Entry) bool,
+	pageReq *query.PageRequest,
+) ([]types.Entry, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	ranger := new(collections.Range[K]).StartInclusive(ordering.start).EndInclusive(ordering.end)
+	if len(pageReq.Key) != 0 {
+		n, start, err := ordering.keyCodec.Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid pagination key: %s", err)
+		}
+		if n != len(pageReq.Key) {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid pagination key: trailing bytes")
+		}
+		// keys are order preserving, so a key of another listing is
+		// detected by comparing its encoding with the bounds
+		if inRange, err := ordering.contains(pageReq.Key); err != nil {
+			return nil, nil, err
+		} else if !inRange {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid request, key is out of the listing range")
+		}
+		if pageReq.Reverse {
+			ranger = ranger.EndInclusive(start)
+		} else {
+			ranger = ranger.StartInclusive(start)
+		}
+		// totals are only computed on the first page, as in query.CollectionPaginate
+		countTotal = false
+	}
+	if pageReq.Reverse {
+		ranger = ranger.Descending()
+	}
+
+	iter, err := ordering.iterate(ctx, ranger)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		entries []types.Entry
+		nextKey []byte
+		count   uint64
+		end     = pageReq.Offset + limit
+	)
+	for ; iter.Valid(); iter.Next() {
+		id, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		entry, err := k.Entry.Get(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !match(entry) {
+			continue
+		}
+
+		switch {
+		case count >= pageReq.Offset && count < end:
+			entries = append(entries, entry)
+		case count == end:
+			key, err := iter.FullKey()
+			if err != nil {
+				return nil, nil, err
+			}
+			nextKey = make([]byte, ordering.keyCodec.Size(key))
+			if _, err := ordering.keyCodec.Encode(nextKey, key); err != nil {
+				return nil, nil, err
+			}
+		}
+		count++
+		if count > end && !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return entries, pageRes, nil
+}
+
+// paginationError returns the gRPC status of an error of paginateEntries,
+// Internal unless the page request was invalid.
+func paginationError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// paginateEntryIndex returns the entries referenced by refKey in the given
+// secondary index, leaving out retracted entries.
+func (k Keeper) paginateEntryIndex(
+	ctx context.Context,
+	idx *indexes.Multi[string, uint64, types.Entry],
+	refKey string,
+	pageReq *query.PageRequest,
+) ([]types.Entry, *query.PageResponse, error) {
+	return paginateEntries(ctx, k, entriesByIndex(idx, refKey, refKey), func(entry types.Entry) bool {
+		return !entry.IsRetracted()
+	}, pageReq)
+}
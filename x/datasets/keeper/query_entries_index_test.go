@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestEntriesByIndexQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	agencies := []string{"NOAA", "PAGASA", "NOAA", "NOAA", "PAGASA"}
+	categories := []string{"climate", "climate", "budget", "climate", "budget"}
+	mimeTypes := []string{"text/csv", "application/pdf", "text/csv", "application/json", "text/csv"}
+	for i := range agencies {
+		entry := types.Entry{
+			Id:       uint64(i),
+			Agency:   agencies[i],
+			Category: categories[i],
+			MimeType: mimeTypes[i],
+		}
+		require.NoError(t, f.keeper.Entry.Set(f.ctx, entry.Id, entry))
+	}
+
+	ids := func(entries []types.Entry) []uint64 {
+		res := make([]uint64, 0, len(entries))
+		for _, entry := range entries {
+			res = append(res, entry.Id)
+		}
+		return res
+	}
+
+	t.Run("ByAgency", func(t *testing.T) {
+		resp, err := qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{Agency: "NOAA"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0, 2, 3}, ids(resp.Entry))
+		require.Equal(t, uint64(3), resp.Pagination.Total)
+		require.Nil(t, resp.Pagination.NextKey)
+	})
+	t.Run("ByCategory", func(t *testing.T) {
+		resp, err := qs.EntriesByCategory(f.ctx, &types.QueryEntriesByCategoryRequest{Category: "budget"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2, 4}, ids(resp.Entry))
+	})
+	t.Run("ByMimetype", func(t *testing.T) {
+		resp, err := qs.EntriesByMimetype(f.ctx, &types.QueryEntriesByMimetypeRequest{MimeType: "text/csv"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0, 2, 4}, ids(resp.Entry))
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		resp, err := qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{Agency: "NASA"})
+		require.NoError(t, err)
+		require.Empty(t, resp.Entry)
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		var (
+			next []byte
+			got  []uint64
+		)
+		for {
+			resp, err := qs.EntriesByMimetype(f.ctx, &types.QueryEntriesByMimetypeRequest{
+				MimeType:   "text/csv",
+				Pagination: &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Entry), 2)
+			got = append(got, ids(resp.Entry)...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []uint64{0, 2, 4}, got)
+	})
+	t.Run("ByOffset", func(t *testing.T) {
+		resp, err := qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{
+			Agency:     "NOAA",
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2}, ids(resp.Entry))
+		require.Equal(t, uint64(3), resp.Pagination.Total)
+		require.NotNil(t, resp.Pagination.NextKey)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		resp, err := qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{
+			Agency:     "NOAA",
+			Pagination: &query.PageRequest{Reverse: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{3, 2, 0}, ids(resp.Entry))
+	})
+	t.Run("IndexFollowsUpdates", func(t *testing.T) {
+		entry, err := f.keeper.Entry.Get(f.ctx, 2)
+		require.NoError(t, err)
+		entry.Agency = "PAGASA"
+		require.NoError(t, f.keeper.Entry.Set(f.ctx, entry.Id, entry))
+		require.NoError(t, f.keeper.Entry.Remove(f.ctx, 3))
+
+		resp, err := qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{Agency: "NOAA"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0}, ids(resp.Entry))
+
+		resp, err = qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{Agency: "PAGASA"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2, 4}, ids(resp.Entry))
+	})
+	t.Run("BadKey", func(t *testing.T) {
+		for _, key := range [][]byte{{0xff}, {'N', 'O', 'A', 'A', 0}, append([]byte("NOAA\x00"), make([]byte, 9)...)} {
+			_, err := qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{
+				Agency:     "NOAA",
+				Pagination: &query.PageRequest{Key: key},
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err), "key %x", key)
+		}
+		_, err := qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{Pagination: &query.PageRequest{Key: []byte{1, 2, 3}}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = qs.EntriesByAgency(f.ctx, &types.QueryEntriesByAgencyRequest{
+			Agency:     "NOAA",
+			Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.EntriesByAgency(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"math"
 
 	"govchain/x/datasets/types"
 
@@ -18,20 +19,64 @@ func (q queryServer) ListEntry(ctx context.Context, req *types.QueryAllEntryRequ
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	entrys, pageRes, err := query.CollectionPaginate(
-		ctx,
-		q.k.Entry,
-		req.Pagination,
-		func(_ uint64, value types.Entry) (types.Entry, error) {
-			return value, nil
-		},
+	var filter types.EntryFilter
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	match := func(entry types.Entry) bool {
+		return (req.IncludeRetracted || !entry.IsRetracted()) && filter.Matches(entry)
+	}
+
+	var (
+		entrys  []types.Entry
+		pageRes *query.PageResponse
+		err     error
 	)
+	// The listing iterates over the index giving the requested order,
+	// narrowed by the most selective criterion it supports; the remaining
+	// criteria are checked on each entry.
+	switch req.OrderBy {
+	case types.ENTRY_ORDER_BY_ID:
+		indexes := q.k.Entry.Indexes
+		switch {
+		case filter.Agency != "":
+			entrys, pageRes, err = paginateEntries(ctx, q.k, entriesByIndex(indexes.Agency, filter.Agency, filter.Agency), match, req.Pagination)
+		case filter.Category != "":
+			entrys, pageRes, err = paginateEntries(ctx, q.k, entriesByIndex(indexes.Category, filter.Category, filter.Category), match, req.Pagination)
+		case filter.MimeType != "":
+			entrys, pageRes, err = paginateEntries(ctx, q.k, entriesByIndex(indexes.MimeType, filter.MimeType, filter.MimeType), match, req.Pagination)
+		default:
+			entrys, pageRes, err = paginateEntries(ctx, q.k, q.k.entriesById(), match, req.Pagination)
+		}
+	case types.ENTRY_ORDER_BY_CREATED:
+		minHeight, maxHeight := filter.CreatedHeightRange()
+		entrys, pageRes, err = paginateEntries(ctx, q.k, entriesByIndex(q.k.Entry.Indexes.CreatedHeight, minHeight, maxHeight), match, req.Pagination)
+	case types.ENTRY_ORDER_BY_FILE_SIZE:
+		entrys, pageRes, err = paginateEntries(ctx, q.k, entriesByIndex(q.k.Entry.Indexes.FileSize, 0, math.MaxUint64), match, req.Pagination)
+	case types.ENTRY_ORDER_BY_UPDATED:
+		minHeight, maxHeight := filter.UpdatedHeightRange()
+		entrys, pageRes, err = paginateEntries(ctx, q.k, entriesByIndex(q.k.Entry.Indexes.UpdatedHeight, minHeight, maxHeight), match, req.Pagination)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown order_by %d", req.OrderBy)
+	}
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, paginationError(err)
 	}
 
-	return &types.QueryAllEntryResponse{Entry: entrys, Pagination: pageRes}, nil
+	pins := make([]types.EntryPins, 0, len(entrys))
+	for _, entry := range entrys {
+		entryPins, err := q.k.entryPins(ctx, entry.Id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		pins = append(pins, entryPins)
+	}
+
+	return &types.QueryAllEntryResponse{Entry: entrys, Pagination: pageRes, Pins: pins}, nil
 }
 
 func (q queryServer) GetEntry(ctx context.Context, req *types.QueryGetEntryRequest) (*types.QueryGetEntryResponse, error) {
@@ -47,6 +92,10 @@ func (q queryServer) GetEntry(ctx context.Context, req *types.QueryGetEntryReque
 
 		return nil, status.Error(codes.Internal, "internal error")
 	}
+	pins, err := q.k.entryPins(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
 
-	return &types.QueryGetEntryResponse{Entry: entry}, nil
+	return &types.QueryGetEntryResponse{Entry: entry, PinCount: pins.PinCount, Pinners: pins.Pinners}, nil
 }
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) EntryByCid(ctx context.Context, req *types.QueryEntryByCidRequest) (*types.QueryEntryByCidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entry, err := q.entryByUniqueKey(ctx, q.k.EntryByCid, types.NormalizeCid(req.IpfsCid))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEntryByCidResponse{Entry: entry}, nil
+}
+
+func (q queryServer) EntryByChecksum(ctx context.Context, req *types.QueryEntryByChecksumRequest) (*types.QueryEntryByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entry, err := q.entryByUniqueKey(ctx, q.k.EntryByChecksum, types.NormalizeChecksum(req.ChecksumSha_256))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEntryByChecksumResponse{Entry: entry}, nil
+}
+
+// entryByUniqueKey returns the entry registered under key in idx.
+func (q queryServer) entryByUniqueKey(ctx context.Context, idx collections.Map[string, uint64], key string) (types.Entry, error) {
+	id, found, err := entryByUniqueKey(ctx, idx, key)
+	if err != nil {
+		return types.Entry{}, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return types.Entry{}, sdkerrors.ErrKeyNotFound
+	}
+
+	entry, err := q.k.Entry.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Entry{}, sdkerrors.ErrKeyNotFound
+		}
+		return types.Entry{}, status.Error(codes.Internal, "internal error")
+	}
+	return entry, nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestEntryByContentQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	entry := types.Entry{Id: 3, IpfsCid: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", ChecksumSha_256: "ABCDEF"}
+	require.NoError(t, f.keeper.SetEntry(f.ctx, entry))
+
+	t.Run("ByCid", func(t *testing.T) {
+		for _, cid := range []string{entry.IpfsCid, "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"} {
+			resp, err := qs.EntryByCid(f.ctx, &types.QueryEntryByCidRequest{IpfsCid: cid})
+			require.NoError(t, err)
+			require.Equal(t, entry.Id, resp.Entry.Id)
+		}
+	})
+	t.Run("ByChecksum", func(t *testing.T) {
+		resp, err := qs.EntryByChecksum(f.ctx, &types.QueryEntryByChecksumRequest{ChecksumSha_256: "abcdef"})
+		require.NoError(t, err)
+		require.Equal(t, entry.Id, resp.Entry.Id)
+	})
+	t.Run("KeyNotFound", func(t *testing.T) {
+		_, err := qs.EntryByCid(f.ctx, &types.QueryEntryByCidRequest{IpfsCid: "unknown"})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+		_, err = qs.EntryByChecksum(f.ctx, &types.QueryEntryByChecksumRequest{})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.EntryByCid(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = qs.EntryByChecksum(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) EntryHistory(ctx context.Context, req *types.QueryEntryHistoryRequest) (*types.QueryEntryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	revisions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.EntryRevision,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.EntryRevision) (types.EntryRevision, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.EntryId),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEntryHistoryResponse{Revisions: revisions, Pagination: pageRes}, nil
+}
+
+func (q queryServer) EntryRevision(ctx context.Context, req *types.QueryEntryRevisionRequest) (*types.QueryEntryRevisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	revision, err := q.k.EntryRevision.Get(ctx, collections.Join(req.EntryId, req.Revision))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryEntryRevisionResponse{Revision: revision}, nil
+}
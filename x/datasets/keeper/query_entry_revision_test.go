@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestEntryHistory(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "NOAA", creator)
+
+	cids := []string{"cid-1", "cid-2", "cid-3"}
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	resp, err := srv.CreateEntry(ctx, &types.MsgCreateEntry{Creator: creator, Agency: "NOAA", IpfsCid: cids[0]})
+	require.NoError(t, err)
+	for i, cid := range cids[1:] {
+		ctx = ctx.WithBlockHeight(int64(11 + i))
+		_, err = srv.UpdateEntry(ctx, &types.MsgUpdateEntry{
+			Creator:      creator,
+			Id:           resp.Id,
+			Agency:       "NOAA",
+			IpfsCid:      cid,
+			ChangeReason: "new data release",
+		})
+		require.NoError(t, err)
+	}
+
+	entry, err := f.keeper.Entry.Get(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(cids)), entry.Revision)
+
+	t.Run("History", func(t *testing.T) {
+		history, err := qs.EntryHistory(ctx, &types.QueryEntryHistoryRequest{EntryId: resp.Id})
+		require.NoError(t, err)
+		require.Len(t, history.Revisions, len(cids))
+		for i, rev := range history.Revisions {
+			require.Equal(t, uint64(i+1), rev.Revision)
+			require.Equal(t, cids[i], rev.Entry.IpfsCid)
+			require.Equal(t, creator, rev.Editor)
+			require.Equal(t, int64(10+i), rev.BlockHeight)
+			if i == 0 {
+				require.Empty(t, rev.PreviousIpfsCid)
+				require.Empty(t, rev.ChangeReason)
+			} else {
+				require.Equal(t, cids[i-1], rev.PreviousIpfsCid)
+				require.Equal(t, "new data release", rev.ChangeReason)
+			}
+		}
+	})
+	t.Run("HistoryPaginated", func(t *testing.T) {
+		var next []byte
+		var got []types.EntryRevision
+		for {
+			history, err := qs.EntryHistory(ctx, &types.QueryEntryHistoryRequest{
+				EntryId:    resp.Id,
+				Pagination: &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(history.Revisions), 2)
+			got = append(got, history.Revisions...)
+			next = history.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Len(t, got, len(cids))
+	})
+	t.Run("HistoryOfOtherEntry", func(t *testing.T) {
+		history, err := qs.EntryHistory(ctx, &types.QueryEntryHistoryRequest{EntryId: resp.Id + 1})
+		require.NoError(t, err)
+		require.Empty(t, history.Revisions)
+	})
+	t.Run("Revision", func(t *testing.T) {
+		rev, err := qs.EntryRevision(ctx, &types.QueryEntryRevisionRequest{EntryId: resp.Id, Revision: 2})
+		require.NoError(t, err)
+		require.Equal(t, cids[1], rev.Revision.Entry.IpfsCid)
+		require.Equal(t, cids[0], rev.Revision.PreviousIpfsCid)
+
+		_, err = qs.EntryRevision(ctx, &types.QueryEntryRevisionRequest{EntryId: resp.Id, Revision: 4})
+		require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.EntryHistory(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = qs.EntryRevision(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
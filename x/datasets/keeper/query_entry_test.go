@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -27,13 +28,12 @@ func createNEntry(keeper keeper.Keeper, ctx context.Context, n int) []types.Entr
 		items[i].FileName = strconv.Itoa(i)
 		items[i].FileUrl = strconv.Itoa(i)
 		items[i].FallbackUrl = strconv.Itoa(i)
-		items[i].FileSize = strconv.Itoa(i)
+		items[i].FileSize = iu
 		items[i].ChecksumSha_256 = strconv.Itoa(i)
 		items[i].Agency = strconv.Itoa(i)
 		items[i].Category = strconv.Itoa(i)
 		items[i].Submitter = strconv.Itoa(i)
-		items[i].Timestamp = strconv.Itoa(i)
-		items[i].PinCount = strconv.Itoa(i)
+		items[i].PublishedAt = time.Unix(int64(i), 0).UTC()
 		_ = keeper.Entry.Set(ctx, iu, items[i])
 		_ = keeper.EntrySeq.Set(ctx, iu)
 	}
@@ -129,3 +129,129 @@ func TestEntryQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestEntryQueryFiltered(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	entries := []types.Entry{
+		{Id: 0, Agency: "NOAA", Category: "climate", MimeType: "text/csv", CreatedHeight: 30, UpdatedHeight: 70, FileSize: 500},
+		{Id: 1, Agency: "NOAA", Category: "climate", MimeType: "text/csv", CreatedHeight: 10, UpdatedHeight: 10, FileSize: 100},
+		{Id: 2, Agency: "PAGASA", Category: "climate", MimeType: "text/csv", CreatedHeight: 20, UpdatedHeight: 20, FileSize: 300},
+		{Id: 3, Agency: "NOAA", Category: "budget", MimeType: "text/csv", CreatedHeight: 40, UpdatedHeight: 65, FileSize: 200},
+		{Id: 4, Agency: "NOAA", Category: "climate", MimeType: "application/pdf", CreatedHeight: 50, UpdatedHeight: 50, FileSize: 400},
+		{Id: 5, Agency: "NOAA", Category: "climate", MimeType: "text/csv", CreatedHeight: 60, UpdatedHeight: 60, FileSize: 50},
+	}
+	for _, entry := range entries {
+		require.NoError(t, f.keeper.Entry.Set(f.ctx, entry.Id, entry))
+	}
+
+	ids := func(entries []types.Entry) []uint64 {
+		res := make([]uint64, 0, len(entries))
+		for _, entry := range entries {
+			res = append(res, entry.Id)
+		}
+		return res
+	}
+	csv := &types.EntryFilter{Agency: "NOAA", Category: "climate", MimeType: "text/csv"}
+
+	tests := []struct {
+		desc    string
+		request *types.QueryAllEntryRequest
+		ids     []uint64
+	}{
+		{
+			desc:    "CompositeFilter",
+			request: &types.QueryAllEntryRequest{Filter: csv},
+			ids:     []uint64{0, 1, 5},
+		},
+		{
+			desc:    "HeightRange",
+			request: &types.QueryAllEntryRequest{Filter: &types.EntryFilter{MinCreatedHeight: 20, MaxCreatedHeight: 40}},
+			ids:     []uint64{0, 2, 3},
+		},
+		{
+			desc:    "OrderByCreated",
+			request: &types.QueryAllEntryRequest{Filter: csv, OrderBy: types.ENTRY_ORDER_BY_CREATED},
+			ids:     []uint64{1, 0, 5},
+		},
+		{
+			desc: "OrderByCreatedInRange",
+			request: &types.QueryAllEntryRequest{
+				Filter:  &types.EntryFilter{Agency: "NOAA", MinCreatedHeight: 30},
+				OrderBy: types.ENTRY_ORDER_BY_CREATED,
+			},
+			ids: []uint64{0, 3, 4, 5},
+		},
+		{
+			desc: "OrderByUpdatedInRange",
+			request: &types.QueryAllEntryRequest{
+				Filter:  &types.EntryFilter{Agency: "NOAA", MinUpdatedHeight: 50},
+				OrderBy: types.ENTRY_ORDER_BY_UPDATED,
+			},
+			ids: []uint64{4, 5, 3, 0},
+		},
+		{
+			desc: "OrderByFileSizeReverse",
+			request: &types.QueryAllEntryRequest{
+				Filter:     &types.EntryFilter{Category: "climate"},
+				OrderBy:    types.ENTRY_ORDER_BY_FILE_SIZE,
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			ids: []uint64{0, 4, 2, 1, 5},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := qs.ListEntry(f.ctx, tc.request)
+			require.NoError(t, err)
+			require.Equal(t, tc.ids, ids(resp.Entry))
+			require.Equal(t, len(tc.ids), int(resp.Pagination.Total))
+		})
+	}
+
+	t.Run("ByKey", func(t *testing.T) {
+		var (
+			next []byte
+			got  []uint64
+		)
+		for {
+			resp, err := qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{
+				Filter:     &types.EntryFilter{Agency: "NOAA"},
+				OrderBy:    types.ENTRY_ORDER_BY_FILE_SIZE,
+				Pagination: &query.PageRequest{Key: next, Limit: 2},
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Entry), 2)
+			got = append(got, ids(resp.Entry)...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []uint64{5, 1, 3, 4, 0}, got)
+	})
+	t.Run("KeyOfOtherListing", func(t *testing.T) {
+		resp, err := qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{
+			Filter:     &types.EntryFilter{Agency: "NOAA"},
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+
+		_, err = qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{
+			Filter:     &types.EntryFilter{Agency: "PAGASA"},
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		require.ErrorContains(t, err, "out of the listing range")
+	})
+	t.Run("InvalidHeightRange", func(t *testing.T) {
+		_, err := qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{Filter: &types.EntryFilter{MinCreatedHeight: 5, MaxCreatedHeight: 4}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{Filter: &types.EntryFilter{MinUpdatedHeight: 5, MaxUpdatedHeight: 4}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("UnknownOrder", func(t *testing.T) {
+		_, err := qs.ListEntry(f.ctx, &types.QueryAllEntryRequest{OrderBy: types.EntryOrderBy(9)})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
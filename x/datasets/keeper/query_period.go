@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/types"
+)
+
+func (q queryServer) PeriodRoot(ctx context.Context, req *types.QueryPeriodRootRequest) (*types.QueryPeriodRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	root, err := q.k.PeriodRoot.Get(ctx, req.PeriodId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryPeriodRootResponse{PeriodRoot: root}, nil
+}
+
+func (q queryServer) EntryInclusionProof(ctx context.Context, req *types.QueryEntryInclusionProofRequest) (*types.QueryEntryInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	root, err := q.k.PeriodRoot.Get(ctx, req.PeriodId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	leaves, err := q.k.periodLeaves(ctx, req.PeriodId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	for i, leaf := range leaves {
+		if leaf.EntryId != req.EntryId {
+			continue
+		}
+		if len(req.ContentHash) > 0 && !bytes.Equal(req.ContentHash, leaf.ContentHash) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidInclusionProof, "entry %d is included in period %d with content hash %X", leaf.EntryId, leaf.PeriodId, leaf.ContentHash)
+		}
+		return &types.QueryEntryInclusionProofResponse{
+			Proof: types.NewEntryInclusionProof(leaves, i),
+			Root:  root.Root,
+		}, nil
+	}
+
+	return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "entry %d was not changed during period %d", req.EntryId, req.PeriodId)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) Pinners(ctx context.Context, req *types.QueryPinnersRequest) (*types.QueryPinnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestations, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PinAttestation,
+		req.Pagination,
+		func(_ collections.Pair[uint64, string], value types.PinAttestation) (types.PinAttestation, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, string](req.EntryId),
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPinnersResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetPinner(ctx context.Context, req *types.QueryGetPinnerRequest) (*types.QueryGetPinnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pinner, err := q.k.Pinner.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPinnerResponse{Pinner: pinner}, nil
+}
+
+func (q queryServer) AllowedPinners(ctx context.Context, req *types.QueryAllowedPinnersRequest) (*types.QueryAllowedPinnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AllowedPinners,
+		req.Pagination,
+		func(addr sdk.AccAddress, _ collections.NoValue) (string, error) {
+			return q.k.addressCodec.BytesToString(addr)
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowedPinnersResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Challenges(ctx context.Context, req *types.QueryChallengesRequest) (*types.QueryChallengesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenges, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PinnerChallenges,
+		req.Pagination,
+		func(key collections.Triple[string, uint64, uint64], _ collections.NoValue) (types.Challenge, error) {
+			return q.k.Challenge.Get(ctx, key.K3())
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[string, uint64, uint64]]) {
+			prefix := collections.TriplePrefix[string, uint64, uint64](req.Pinner)
+			o.Prefix = &prefix
+		},
+	)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChallengesResponse{Challenges: challenges, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetChallenge(ctx context.Context, req *types.QueryGetChallengeRequest) (*types.QueryGetChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenge, err := q.k.Challenge.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetChallengeResponse{Challenge: challenge}, nil
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	"govchain/x/datasets/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) SearchEntries(ctx context.Context, req *types.QuerySearchEntriesRequest) (*types.QuerySearchEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	terms := types.Tokenize(req.Query)
+	if len(terms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "query has no searchable terms")
+	}
+	if len(terms) > types.MaxSearchQueryTerms {
+		return nil, status.Errorf(codes.InvalidArgument, "query has more than %d terms", types.MaxSearchQueryTerms)
+	}
+
+	ranked, err := q.k.rankEntries(ctx, terms)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	page, pageRes, err := paginateRanked(ranked, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results := make([]types.EntrySearchResult, len(page))
+	for i, match := range page {
+		entry, err := q.k.Entry.Get(ctx, match.id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		results[i] = types.EntrySearchResult{Entry: entry, MatchedTerms: match.matched, Score: match.score}
+	}
+
+	return &types.QuerySearchEntriesResponse{Results: results, Pagination: pageRes}, nil
+}
+
+// searchMatch is the rank of an entry matching a search query.
+type searchMatch struct {
+	id      uint64
+	matched uint32
+	score   uint64
+}
+
+// rankEntries returns the entries matching any of terms, ordered by number of
+// matched terms, then score, then id. Only the types.MaxSearchTermPostings
+// most recent entries of each term are considered, which bounds the cost of
+// queries for common terms.
+func (k Keeper) rankEntries(ctx context.Context, terms []string) ([]searchMatch, error) {
+	matches := make(map[uint64]*searchMatch)
+	for _, term := range terms {
+		iter, err := k.EntrySearchIndex.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](term).Descending())
+		if err != nil {
+			return nil, err
+		}
+		for n := 0; n < types.MaxSearchTermPostings && iter.Valid(); n++ {
+			kv, err := iter.KeyValue()
+			if err != nil {
+				iter.Close()
+				return nil, err
+			}
+			id := kv.Key.K2()
+			match, ok := matches[id]
+			if !ok {
+				match = &searchMatch{id: id}
+				matches[id] = match
+			}
+			match.matched++
+			match.score += uint64(kv.Value)
+			iter.Next()
+		}
+		iter.Close()
+	}
+
+	ranked := make([]searchMatch, 0, len(matches))
+	for _, match := range matches {
+		ranked = append(ranked, *match)
+	}
+	slices.SortFunc(ranked, func(a, b searchMatch) int {
+		switch {
+		case a.matched != b.matched:
+			return int(b.matched) - int(a.matched)
+		case a.score != b.score:
+			if a.score > b.score {
+				return -1
+			}
+			return 1
+		case a.id < b.id:
+			return -1
+		case a.id > b.id:
+			return 1
+		}
+		return 0
+	})
+	return ranked, nil
+}
+
+// paginateRanked returns the requested page of ranked results. The key of a
+// page request is the big endian encoded offset of the first result.
+func paginateRanked(ranked []searchMatch, pageReq *query.PageRequest) ([]searchMatch, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	offset := pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, fmt.Errorf("invalid pagination key")
+		}
+		offset = binary.BigEndian.Uint64(pageReq.Key)
+	}
+	if offset > types.MaxSearchResults {
+		return nil, nil, fmt.Errorf("offset %d exceeds the maximum of %d results", offset, types.MaxSearchResults)
+	}
+	limit, countTotal := min(pageReq.Limit, types.MaxSearchResults), pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = len(pageReq.Key) == 0
+	}
+	if pageReq.Reverse {
+		ranked = slices.Clone(ranked)
+		slices.Reverse(ranked)
+	}
+
+	total := uint64(len(ranked))
+	start, end := min(offset, total), min(offset+limit, total)
+
+	pageRes := &query.PageResponse{}
+	if end < total {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, end)
+	}
+	if countTotal {
+		pageRes.Total = total
+	}
+	return ranked[start:end], pageRes, nil
+}
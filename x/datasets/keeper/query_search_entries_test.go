@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+func TestSearchEntries(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registerAgency(t, f, "FEMA", creator)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	for _, msg := range []*types.MsgCreateEntry{
+		{Title: "Flood budget 2024", Category: "budget"},
+		{Title: "Flood maps", Description: "Flood zones 2024"},
+		{Title: "Wildfire budget 2023", Category: "budget"},
+		{Title: "Census", Description: "Population counts"},
+	} {
+		msg.Creator = creator
+		msg.Agency = "FEMA"
+		_, err := srv.CreateEntry(f.ctx, msg)
+		require.NoError(t, err)
+	}
+
+	search := func(q string, pageReq *query.PageRequest) *types.QuerySearchEntriesResponse {
+		t.Helper()
+		resp, err := qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: q, Pagination: pageReq})
+		require.NoError(t, err)
+		return resp
+	}
+	ids := func(resp *types.QuerySearchEntriesResponse) []uint64 {
+		var ids []uint64
+		for _, result := range resp.Results {
+			ids = append(ids, result.Entry.Id)
+		}
+		return ids
+	}
+
+	t.Run("Ranked", func(t *testing.T) {
+		resp := search("flood 2024 budget", nil)
+		require.Equal(t, []uint64{0, 1, 2}, ids(resp))
+		require.Equal(t, uint32(3), resp.Results[0].MatchedTerms)
+		require.Equal(t, uint32(2), resp.Results[1].MatchedTerms)
+		require.Equal(t, uint64(3), resp.Pagination.Total)
+	})
+	t.Run("CaseInsensitiveAgency", func(t *testing.T) {
+		require.Len(t, search("fema", nil).Results, 4)
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		var (
+			got  []uint64
+			next []byte
+		)
+		for {
+			resp := search("flood 2024 budget", &query.PageRequest{Key: next, Limit: 2})
+			require.LessOrEqual(t, len(resp.Results), 2)
+			got = append(got, ids(resp)...)
+			next = resp.Pagination.NextKey
+			if next == nil {
+				break
+			}
+		}
+		require.Equal(t, []uint64{0, 1, 2}, got)
+
+		require.Equal(t, []uint64{1, 2}, ids(search("flood 2024 budget", &query.PageRequest{Offset: 1})))
+	})
+	t.Run("UpdateReindexes", func(t *testing.T) {
+		_, err := srv.UpdateEntry(f.ctx, &types.MsgUpdateEntry{Creator: creator, Id: 3, Title: "Household income"})
+		require.NoError(t, err)
+		require.Empty(t, search("census", nil).Results)
+		require.Equal(t, []uint64{3}, ids(search("income", nil)))
+	})
+	t.Run("RetractedAndPurgedHidden", func(t *testing.T) {
+		_, err := srv.DeleteEntry(f.ctx, &types.MsgDeleteEntry{Creator: creator, Id: 0, Reason: "duplicate"})
+		require.NoError(t, err)
+		_, err = srv.PurgeEntry(f.ctx, &types.MsgPurgeEntry{Authority: authority, Id: 1, Reason: "court order"})
+		require.NoError(t, err)
+		require.Equal(t, []uint64{2}, ids(search("flood 2024 budget", nil)))
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.SearchEntries(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: "a of"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSearchEntriesBounded(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// a term indexed for more entries than a query considers
+	for id := uint64(0); id <= types.MaxSearchTermPostings; id++ {
+		require.NoError(t, f.keeper.EntrySearchIndex.Set(f.ctx, collections.Join("data", id), 1))
+	}
+	require.NoError(t, f.keeper.EntrySearchIndex.Set(f.ctx, collections.Join("rare", uint64(0)), 3))
+	for _, id := range []uint64{0, 1} {
+		require.NoError(t, f.keeper.Entry.Set(f.ctx, id, types.Entry{Id: id}))
+	}
+
+	resp, err := qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: "data", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.MaxSearchTermPostings), resp.Pagination.Total)
+	require.Equal(t, uint64(1), resp.Results[0].Entry.Id)
+
+	// the postings of the other terms are still considered
+	resp, err = qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: "data rare", Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Results[0].Entry.Id)
+
+	_, err = qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: "data", Pagination: &query.PageRequest{Offset: types.MaxSearchResults + 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: "data", Pagination: &query.PageRequest{Key: binary.BigEndian.AppendUint64(nil, math.MaxUint64)}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	resp, err = qs.SearchEntries(f.ctx, &types.QuerySearchEntriesRequest{Query: "data", Pagination: &query.PageRequest{Offset: 1000, Limit: math.MaxUint64}})
+	require.NoError(t, err)
+	require.Empty(t, resp.Results)
+}
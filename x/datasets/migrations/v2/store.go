@@ -0,0 +1,145 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	v1 "govchain/x/datasets/migrations/v1"
+	"govchain/x/datasets/types"
+)
+
+// InvalidValue reports a legacy string value that could not be converted to
+// its typed counterpart. The entry is still migrated with the zero value for
+// that field.
+type InvalidValue struct {
+	EntryId uint64
+	Field   string
+	Value   string
+	Err     error
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2. Entries are
+// converted from the legacy string-typed file_size and timestamp values to the
+// typed Entry, without the legacy pin_count, and written back through setEntry, which also
+// (re)builds the secondary indexes. Values that fail to parse are returned
+// rather than aborting the migration, so that no entry is dropped.
+func MigrateStore(
+	ctx context.Context,
+	storeService store.KVStoreService,
+	cdc codec.BinaryCodec,
+	setEntry func(context.Context, types.Entry) error,
+) ([]InvalidValue, error) {
+	entryStore := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), types.EntryKey)
+
+	var legacy []v1.Entry
+	iter := entryStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var entry v1.Entry
+		if err := cdc.Unmarshal(iter.Value(), &entry); err != nil {
+			iter.Close()
+			return nil, fmt.Errorf("failed to decode legacy entry %X: %w", iter.Key(), err)
+		}
+		legacy = append(legacy, entry)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	var invalid []InvalidValue
+	for _, old := range legacy {
+		entry, errs := convertEntry(old)
+		invalid = append(invalid, errs...)
+
+		// Remove the legacy value first: it can no longer be decoded as the new
+		// Entry type, which the indexed map does when replacing a value.
+		key := make([]byte, collections.Uint64Key.Size(old.Id))
+		if _, err := collections.Uint64Key.Encode(key, old.Id); err != nil {
+			return nil, err
+		}
+		entryStore.Delete(key)
+
+		if err := setEntry(ctx, entry); err != nil {
+			return nil, fmt.Errorf("failed to store migrated entry %d: %w", old.Id, err)
+		}
+	}
+
+	return invalid, nil
+}
+
+func convertEntry(old v1.Entry) (types.Entry, []InvalidValue) {
+	var invalid []InvalidValue
+	report := func(field, value string, err error) {
+		invalid = append(invalid, InvalidValue{EntryId: old.Id, Field: field, Value: value, Err: err})
+	}
+
+	fileSize, err := parseUint(old.FileSize, 64)
+	if err != nil {
+		report("file_size", old.FileSize, err)
+	}
+	publishedAt, err := ParseLegacyTimestamp(old.Timestamp)
+	if err != nil {
+		report("timestamp", old.Timestamp, err)
+	}
+
+	// The legacy pin count, declared by the publishers, is dropped: pins are
+	// counted from the attestations of the pinners.
+	return types.Entry{
+		Id:              old.Id,
+		Title:           old.Title,
+		Description:     old.Description,
+		IpfsCid:         old.IpfsCid,
+		MimeType:        old.MimeType,
+		FileName:        old.FileName,
+		FileUrl:         old.FileUrl,
+		FallbackUrl:     old.FallbackUrl,
+		FileSize:        fileSize,
+		ChecksumSha_256: old.ChecksumSha_256,
+		Agency:          old.Agency,
+		Category:        old.Category,
+		Submitter:       old.Submitter,
+		PublishedAt:     publishedAt,
+		Creator:         old.Creator,
+		CreatedTxHash:   old.TxHash,
+	}, invalid
+}
+
+func parseUint(s string, bitSize int) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, bitSize)
+}
+
+// ParseLegacyTimestamp parses the free-form timestamp strings accepted by the
+// v1 store. Integers are read as Unix seconds, or as Unix milliseconds when they
+// have 13 or more digits (as produced by JavaScript clients); anything else
+// must be RFC 3339. An empty value yields the zero time.
+func ParseLegacyTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if len(strings.TrimPrefix(s, "-")) >= 13 {
+			return time.UnixMilli(n).UTC(), nil
+		}
+		return time.Unix(n, 0).UTC(), nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
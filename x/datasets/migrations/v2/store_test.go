@@ -0,0 +1,94 @@
+package v2_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/keeper"
+	v1 "govchain/x/datasets/migrations/v1"
+	v2 "govchain/x/datasets/migrations/v2"
+	module "govchain/x/datasets/module"
+	"govchain/x/datasets/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	cdc := encCfg.Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		storeService,
+		cdc,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+	)
+
+	legacy := []v1.Entry{
+		{Id: 0, Title: "valid", Agency: "NOAA", FileSize: "1024", PinCount: "3", Timestamp: "1700000000", TxHash: "AB"},
+		{Id: 1, Title: "rfc3339", Agency: "NOAA", FileSize: " 42 ", PinCount: "", Timestamp: "2024-06-01T10:00:00+02:00"},
+		{Id: 2, Title: "millis", Agency: "PAGASA", FileSize: "", PinCount: "0", Timestamp: "1700000000123"},
+		{Id: 3, Title: "garbage", Agency: "PAGASA", FileSize: "1.5MB", PinCount: "-1", Timestamp: "yesterday"},
+	}
+	kvStore := storeService.OpenKVStore(ctx)
+	for _, entry := range legacy {
+		key := make([]byte, collections.Uint64Key.Size(entry.Id))
+		_, err := collections.Uint64Key.Encode(key, entry.Id)
+		require.NoError(t, err)
+		require.NoError(t, kvStore.Set(append(types.EntryKey.Bytes(), key...), cdc.MustMarshal(&entry)))
+	}
+
+	invalid, err := v2.MigrateStore(ctx, storeService, cdc, func(ctx context.Context, entry types.Entry) error {
+		return k.Entry.Set(ctx, entry.Id, entry)
+	})
+	require.NoError(t, err)
+
+	var fields []string
+	for _, v := range invalid {
+		require.Equal(t, uint64(3), v.EntryId)
+		require.Error(t, v.Err)
+		fields = append(fields, v.Field)
+	}
+	require.Equal(t, []string{"file_size", "timestamp"}, fields)
+
+	entry, err := k.Entry.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1024), entry.FileSize)
+	require.Equal(t, time.Unix(1700000000, 0).UTC(), entry.PublishedAt)
+	require.Equal(t, "AB", entry.CreatedTxHash)
+
+	entry, err = k.Entry.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), entry.FileSize)
+	require.Equal(t, time.Date(2024, 6, 1, 8, 0, 0, 0, time.UTC), entry.PublishedAt)
+
+	entry, err = k.Entry.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, time.UnixMilli(1700000000123).UTC(), entry.PublishedAt)
+
+	// entries with unparsable values are kept with zeroed fields
+	entry, err = k.Entry.Get(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, "garbage", entry.Title)
+	require.Zero(t, entry.FileSize)
+	require.True(t, entry.PublishedAt.IsZero())
+
+	// secondary indexes are built for the migrated entries
+	iter, err := k.Entry.Indexes.Agency.MatchExact(ctx, "PAGASA")
+	require.NoError(t, err)
+	ids, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, ids)
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: types.Query_serviceDesc.ServiceName,
+			Service:              types.Query_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true, // adds the generated commands to the custom query command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -21,6 +22,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "ListEntry",
 					Use:       "list-entry",
 					Short:     "List all entry",
+					Long:      "List entries matching every criterion of --filter, sorted by --order-by (id, created, file-size or updated). Use --page-reverse for a descending order.",
+					Example:   "list-entry --filter '{\"agency\":\"NOAA\",\"category\":\"climate\",\"minCreatedHeight\":\"1000\"}' --order-by file-size --page-reverse",
 				},
 				{
 					RpcMethod:      "GetEntry",
@@ -50,6 +53,96 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "mime_type"}},
 				},
 
+				{
+					RpcMethod: "ListAgency",
+					Use:       "list-agency",
+					Short:     "List all registered agencies",
+				},
+				{
+					RpcMethod:      "GetAgency",
+					Use:            "get-agency [id]",
+					Short:          "Gets a registered agency by id",
+					Alias:          []string{"show-agency"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "EntryHistory",
+					Use:            "entry-history [entry-id]",
+					Short:          "List the revisions of an entry, oldest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entry_id"}},
+				},
+				{
+					RpcMethod:      "EntryRevision",
+					Use:            "entry-revision [entry-id] [revision]",
+					Short:          "Gets a single revision of an entry",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entry_id"}, {ProtoField: "revision"}},
+				},
+				{
+					RpcMethod:      "SearchEntries",
+					Use:            "search-entries [query]",
+					Short:          "Full-text search over entry titles, descriptions, agencies and categories",
+					Example:        "search-entries \"flood 2024 budget\"",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "query"}},
+				},
+				{
+					RpcMethod:      "EntryByCid",
+					Use:            "entry-by-cid [ipfs-cid]",
+					Short:          "Get the entry that registered an IPFS CID, in either CID version",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "ipfs_cid"}},
+				},
+				{
+					RpcMethod:      "EntryByChecksum",
+					Use:            "entry-by-checksum [checksum-sha-256]",
+					Short:          "Get the entry that registered a SHA-256 checksum",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "checksum_sha_256"}},
+				},
+				{
+					RpcMethod: "DatasetStats",
+					Use:       "dataset-stats",
+					Short:     "Show entry totals and counts per agency, category, MIME type and creation month",
+				},
+				{
+					RpcMethod:      "PeriodRoot",
+					Use:            "period-root [period-id]",
+					Short:          "Show the Merkle root snapshot of a closed reporting period",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "period_id"}},
+				},
+				{
+					RpcMethod:      "EntryInclusionProof",
+					Use:            "entry-inclusion-proof [period-id] [entry-id]",
+					Short:          "Show the proof that an entry is included in the Merkle root of a reporting period",
+					Long:           "Show the proof that an entry is included in the Merkle root of a reporting period. With --content-hash, given in hex, the entry must have been included with this content hash.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "period_id"}, {ProtoField: "entry_id"}},
+				},
+				{
+					RpcMethod:      "Pinners",
+					Use:            "pinners [entry-id]",
+					Short:          "List the current pin attestations of an entry",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entry_id"}},
+				},
+				{
+					RpcMethod:      "GetPinner",
+					Use:            "get-pinner [address]",
+					Short:          "Gets a registered pinner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "AllowedPinners",
+					Use:       "allowed-pinners",
+					Short:     "List the addresses allowed to register as pinners",
+				},
+				{
+					RpcMethod:      "Challenges",
+					Use:            "challenges [pinner]",
+					Short:          "List the open retrievability challenges of a pinner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pinner"}},
+				},
+				{
+					RpcMethod:      "GetChallenge",
+					Use:            "get-challenge [id]",
+					Short:          "Gets an open retrievability challenge",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -61,23 +154,71 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "RegisterAgency",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateAgency",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "DeregisterAgency",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "PurgeEntry",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateAllowedPinners",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreateEntry",
-					Use:            "create-entry [title] [description] [ipfs-cid] [mime-type] [file-name] [file-url] [fallback-url] [file-size] [checksum-sha-256] [agency] [category] [submitter] [timestamp] [pin-count]",
+					Use:            "create-entry [title] [description] [ipfs-cid] [mime-type] [file-name] [file-url] [fallback-url] [file-size] [checksum-sha-256] [agency] [category] [submitter] [published-at]",
 					Short:          "Create entry",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "ipfs_cid"}, {ProtoField: "mime_type"}, {ProtoField: "file_name"}, {ProtoField: "file_url"}, {ProtoField: "fallback_url"}, {ProtoField: "file_size"}, {ProtoField: "checksum_sha_256"}, {ProtoField: "agency"}, {ProtoField: "category"}, {ProtoField: "submitter"}, {ProtoField: "timestamp"}, {ProtoField: "pin_count"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "ipfs_cid"}, {ProtoField: "mime_type"}, {ProtoField: "file_name"}, {ProtoField: "file_url"}, {ProtoField: "fallback_url"}, {ProtoField: "file_size"}, {ProtoField: "checksum_sha_256"}, {ProtoField: "agency"}, {ProtoField: "category"}, {ProtoField: "submitter"}, {ProtoField: "published_at"}},
 				},
 				{
-					RpcMethod:      "UpdateEntry",
-					Use:            "update-entry [id] [title] [description] [ipfs-cid] [mime-type] [file-name] [file-url] [fallback-url] [file-size] [checksum-sha-256] [agency] [category] [submitter] [timestamp] [pin-count]",
-					Short:          "Update entry",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "title"}, {ProtoField: "description"}, {ProtoField: "ipfs_cid"}, {ProtoField: "mime_type"}, {ProtoField: "file_name"}, {ProtoField: "file_url"}, {ProtoField: "fallback_url"}, {ProtoField: "file_size"}, {ProtoField: "checksum_sha_256"}, {ProtoField: "agency"}, {ProtoField: "category"}, {ProtoField: "submitter"}, {ProtoField: "timestamp"}, {ProtoField: "pin_count"}},
+					RpcMethod: "UpdateEntry",
+					Use:       "update-entry [id]",
+					Short:     "Update entry",
+					Long: "Update the fields of an entry given as flags; fields that are not given keep their value. " +
+						"Use --update-mask with a JSON field mask to list the fields to replace explicitly, e.g. to clear a field.",
+					Example:        "update-entry 1 --title \"Climate Data 2024\" --change-reason \"typo\"\nupdate-entry 1 --update-mask '\"fallbackUrl\"'",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
 				{
 					RpcMethod:      "DeleteEntry",
-					Use:            "delete-entry [id]",
-					Short:          "Delete entry",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+					Use:            "delete-entry [id] [reason]",
+					Short:          "Retract entry",
+					Long:           "Retract an entry. The entry stays retrievable by id and in its history, but is hidden from default listings.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "RegisterPinner",
+					Use:            "register-pinner [moniker] [endpoint]",
+					Short:          "Register as a pinner, or update the registration",
+					Long:           "Register the signer as a pinner of entry content, or update its moniker and endpoint. The endpoint optionally locates its IPFS node. The signer must be an allowed pinner.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "moniker"}, {ProtoField: "endpoint", Optional: true}},
+				},
+				{
+					RpcMethod: "AttestPin",
+					Use:       "attest-pin [entry-id] [cid] [proof-height]",
+					Short:     "Attest that the signer pins the content of an entry",
+					Long: "Attest that the signer, a registered pinner, pins the content of an entry, or renew its attestation. " +
+						"The proof height is the height at which the pinner last checked that it holds the content; the attestation " +
+						"counts towards the pin count of the entry until the attestation window elapses after it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "entry_id"}, {ProtoField: "cid"}, {ProtoField: "proof_height"}},
+				},
+				{
+					RpcMethod: "RespondChallenge",
+					Skip:      true, // skipped because the proof is built by the custom respond-challenge command
+				},
+				{
+					RpcMethod: "CreateEntriesBatch",
+					Skip:      true, // skipped because the batches are built by the custom import-manifest command
 				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"govchain/x/datasets/keeper"
 	"govchain/x/datasets/types"
@@ -42,6 +43,7 @@ type ModuleOutputs struct {
 
 	DatasetsKeeper keeper.Keeper
 	Module         appmodule.AppModule
+	EpochHooks     epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -58,5 +60,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{DatasetsKeeper: k, Module: m}
+	return ModuleOutputs{
+		DatasetsKeeper: k,
+		Module:         m,
+		EpochHooks:     epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()},
+	}
 }
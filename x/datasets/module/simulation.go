@@ -7,34 +7,48 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
-	"govchain/testutil/sample"
 	datasetssimulation "govchain/x/datasets/simulation"
-	"govchain/x/datasets/types"
+)
+
+// Simulation operation weights. Each weight can be overridden through the
+// simulation params file using its key.
+const (
+	opWeightMsgCreateEntry          = "op_weight_msg_create_entry"
+	defaultWeightMsgCreateEntry int = 100
+
+	opWeightMsgCreateEntriesBatch          = "op_weight_msg_create_entries_batch"
+	defaultWeightMsgCreateEntriesBatch int = 20
+
+	opWeightMsgCreateInvalidEntry          = "op_weight_msg_create_invalid_entry"
+	defaultWeightMsgCreateInvalidEntry int = 20
+
+	opWeightMsgUpdateEntry          = "op_weight_msg_update_entry"
+	defaultWeightMsgUpdateEntry int = 50
+
+	opWeightMsgDeleteEntry          = "op_weight_msg_delete_entry"
+	defaultWeightMsgDeleteEntry int = 10
+
+	opWeightMsgRegisterPinner          = "op_weight_msg_register_pinner"
+	defaultWeightMsgRegisterPinner int = 10
+
+	opWeightMsgAttestPin          = "op_weight_msg_attest_pin"
+	defaultWeightMsgAttestPin int = 60
+
+	opWeightMsgRespondChallenge          = "op_weight_msg_respond_challenge"
+	defaultWeightMsgRespondChallenge int = 20
 )
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	datasetsGenesis := types.GenesisState{
-		Params:    types.DefaultParams(),
-		EntryList: []types.Entry{{Id: 0, Creator: sample.AccAddress()}, {Id: 1, Creator: sample.AccAddress()}}, EntryCount: 2,
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&datasetsGenesis)
+	datasetssimulation.RandomizedGenState(simState)
 }
 
 // RegisterStoreDecoder registers a decoder.
 func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the datasets module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
-	const (
-		opWeightMsgCreateEntry          = "op_weight_msg_datasets"
-		defaultWeightMsgCreateEntry int = 100
-	)
 
 	var weightMsgCreateEntry int
 	simState.AppParams.GetOrGenerate(opWeightMsgCreateEntry, &weightMsgCreateEntry, nil,
@@ -46,10 +60,28 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgCreateEntry,
 		datasetssimulation.SimulateMsgCreateEntry(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
-	const (
-		opWeightMsgUpdateEntry          = "op_weight_msg_datasets"
-		defaultWeightMsgUpdateEntry int = 100
+
+	var weightMsgCreateEntriesBatch int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateEntriesBatch, &weightMsgCreateEntriesBatch, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateEntriesBatch = defaultWeightMsgCreateEntriesBatch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateEntriesBatch,
+		datasetssimulation.SimulateMsgCreateEntriesBatch(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreateInvalidEntry int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateInvalidEntry, &weightMsgCreateInvalidEntry, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateInvalidEntry = defaultWeightMsgCreateInvalidEntry
+		},
 	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateInvalidEntry,
+		datasetssimulation.SimulateMsgCreateInvalidEntry(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 
 	var weightMsgUpdateEntry int
 	simState.AppParams.GetOrGenerate(opWeightMsgUpdateEntry, &weightMsgUpdateEntry, nil,
@@ -61,10 +93,6 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgUpdateEntry,
 		datasetssimulation.SimulateMsgUpdateEntry(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
-	const (
-		opWeightMsgDeleteEntry          = "op_weight_msg_datasets"
-		defaultWeightMsgDeleteEntry int = 100
-	)
 
 	var weightMsgDeleteEntry int
 	simState.AppParams.GetOrGenerate(opWeightMsgDeleteEntry, &weightMsgDeleteEntry, nil,
@@ -77,10 +105,43 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		datasetssimulation.SimulateMsgDeleteEntry(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
+	var weightMsgRegisterPinner int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterPinner, &weightMsgRegisterPinner, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterPinner = defaultWeightMsgRegisterPinner
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterPinner,
+		datasetssimulation.SimulateMsgRegisterPinner(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAttestPin int
+	simState.AppParams.GetOrGenerate(opWeightMsgAttestPin, &weightMsgAttestPin, nil,
+		func(_ *rand.Rand) {
+			weightMsgAttestPin = defaultWeightMsgAttestPin
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAttestPin,
+		datasetssimulation.SimulateMsgAttestPin(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRespondChallenge int
+	simState.AppParams.GetOrGenerate(opWeightMsgRespondChallenge, &weightMsgRespondChallenge, nil,
+		func(_ *rand.Rand) {
+			weightMsgRespondChallenge = defaultWeightMsgRespondChallenge
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRespondChallenge,
+		datasetssimulation.SimulateMsgRespondChallenge(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
 	return operations
 }
 
 // ProposalMsgs returns msgs used for governance proposals for simulations.
 func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+	return datasetssimulation.ProposalMsgs()
 }
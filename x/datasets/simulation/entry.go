@@ -1,18 +1,35 @@
 package simulation
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"slices"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"govchain/x/datasets/keeper"
 	"govchain/x/datasets/types"
 )
 
+// updatableEntryFields lists the entry fields randomly replaced by
+// SimulateMsgUpdateEntry, besides the content fields.
+var updatableEntryFields = []string{
+	types.FieldTitle, types.FieldDescription, types.FieldMimeType, types.FieldFileName,
+	types.FieldFileUrl, types.FieldFallbackUrl, types.FieldCategory, types.FieldSubmitter,
+	types.FieldPublishedAt,
+}
+
+// SimulateMsgCreateEntry publishes a random valid entry on behalf of an agency
+// one of the simulation accounts publishes for. Some of the entries are
+// mirrors of existing entries.
 func SimulateMsgCreateEntry(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -21,29 +38,183 @@ func SimulateMsgCreateEntry(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msgType := sdk.MsgTypeURL(&types.MsgCreateEntry{})
 
-		msg := &types.MsgCreateEntry{
-			Creator: simAccount.Address.String(),
+		agency, simAccount, found, err := randomPublisher(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read agencies"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no agency publisher among the accounts"), nil, nil
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get params"), nil, err
+		}
+		entry := RandomEntry(r, params, agency.Id, simAccount.Address.String(), ctx.BlockTime())
+
+		if r.Intn(10) == 0 {
+			target, _, found, err := randomEntry(r, ctx, k, nil, func(e types.Entry) bool { return !e.IsMirror() })
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read entries"), nil, err
+			}
+			if found {
+				entry.IpfsCid = target.IpfsCid
+				entry.ChecksumSha_256 = target.ChecksumSha_256
+				entry.FileSize = target.FileSize
+				entry.MirrorOf = &types.MirrorLink{EntryId: target.Id}
+			}
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, newMsgCreateEntry(entry))
+	}
+}
+
+// SimulateMsgCreateEntriesBatch publishes a batch of random valid entries on
+// behalf of an agency one of the simulation accounts publishes for, up to the
+// maximum size of a batch.
+func SimulateMsgCreateEntriesBatch(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateEntriesBatch{})
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get params"), nil, err
+		}
+		if params.MaxBatchEntries == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "entry batches are disabled"), nil, nil
 		}
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		agency, simAccount, found, err := randomPublisher(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read agencies"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no agency publisher among the accounts"), nil, nil
+		}
+
+		msg := &types.MsgCreateEntriesBatch{Creator: simAccount.Address.String()}
+		for range simtypes.RandIntBetween(r, 1, min(int(params.MaxBatchEntries), 5)+1) {
+			entry := RandomEntry(r, params, agency.Id, msg.Creator, ctx.BlockTime())
+			msg.Entries = append(msg.Entries, newBatchEntry(entry))
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg)
+	}
+}
+
+// SimulateMsgCreateInvalidEntry sends an entry breaking one of the stateless
+// or stateful validation rules, and checks that it is rejected with the
+// expected error. The message is never committed.
+func SimulateMsgCreateInvalidEntry(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateEntry{})
+
+		agency, simAccount, found, err := randomPublisher(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read agencies"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no agency publisher among the accounts"), nil, nil
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get params"), nil, err
+		}
+		msg := newMsgCreateEntry(RandomEntry(r, params, agency.Id, simAccount.Address.String(), ctx.BlockTime()))
+
+		violations := []struct {
+			name     string
+			expected *errorsmod.Error
+			apply    func() bool
+		}{
+			{"empty title", types.ErrInvalidTitle, func() bool {
+				msg.Title = ""
+				return true
+			}},
+			{"malformed cid", types.ErrInvalidCid, func() bool {
+				msg.IpfsCid = "not-a-cid"
+				return true
+			}},
+			{"truncated checksum", types.ErrInvalidChecksum, func() bool {
+				msg.ChecksumSha_256 = msg.ChecksumSha_256[:32]
+				return true
+			}},
+			{"malformed mime type", types.ErrInvalidMimeType, func() bool {
+				msg.MimeType = "csv"
+				return true
+			}},
+			{"future publication", types.ErrInvalidPublishedAt, func() bool {
+				msg.PublishedAt = ctx.BlockTime().Add(time.Duration(1 + r.Int63n(int64(24*time.Hour)))).UTC()
+				return true
+			}},
+			{"unregistered agency", types.ErrAgencyNotFound, func() bool {
+				msg.Agency = agency.Id + "-unregistered"
+				return true
+			}},
+			{"non-publisher creator", types.ErrNotAgencyPublisher, func() bool {
+				for _, i := range r.Perm(len(accs)) {
+					if !agency.HasPublisher(accs[i].Address.String()) {
+						simAccount = accs[i]
+						msg.Creator = simAccount.Address.String()
+						return true
+					}
+				}
+				return false
+			}},
+			{"oversized file", types.ErrFileTooLarge, func() bool {
+				if params.MaxFileSizeBytes == 0 {
+					return false
+				}
+				msg.FileSize = params.MaxFileSizeBytes + 1
+				return true
+			}},
+			{"duplicate content", types.ErrDuplicateEntry, func() bool {
+				target, _, found, err := randomEntry(r, ctx, k, nil, func(e types.Entry) bool { return !e.IsMirror() })
+				if err != nil || !found {
+					return false
+				}
+				msg.IpfsCid = target.IpfsCid
+				return true
+			}},
+		}
+		violation := violations[r.Intn(len(violations))]
+		if !violation.apply() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "cannot simulate "+violation.name), nil, nil
+		}
+
+		err = msg.ValidateBasic()
+		if err == nil {
+			cacheCtx, _ := ctx.CacheContext()
+			_, err = keeper.NewMsgServerImpl(k).CreateEntry(cacheCtx, msg)
+		}
+		switch {
+		case err == nil:
+			return simtypes.NoOpMsg(types.ModuleName, msgType, violation.name), nil, fmt.Errorf("entry with %s was accepted", violation.name)
+		case !errors.Is(err, violation.expected):
+			return simtypes.NoOpMsg(types.ModuleName, msgType, violation.name), nil, fmt.Errorf("entry with %s was rejected with an unexpected error: %w", violation.name, err)
+		}
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "rejected entry with "+violation.name), nil, nil
 	}
 }
 
+// SimulateMsgUpdateEntry replaces random fields of an active entry created by
+// one of the simulation accounts. Fields that no longer comply with the
+// params are always replaced, and content changes of pinned entries are
+// published as a new revision.
 func SimulateMsgUpdateEntry(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -52,57 +223,83 @@ func SimulateMsgUpdateEntry(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount = simtypes.Account{}
-			entry      = types.Entry{}
-			msg        = &types.MsgUpdateEntry{}
-			found      = false
-		)
-
-		var allEntry []types.Entry
-		err := k.Entry.Walk(ctx, nil, func(key uint64, value types.Entry) (stop bool, err error) {
-			allEntry = append(allEntry, value)
-			return false, nil
+		msgType := sdk.MsgTypeURL(&types.MsgUpdateEntry{})
+
+		// Mirrors are left untouched: their content must keep matching the
+		// entry they mirror.
+		entry, simAccount, found, err := randomEntry(r, ctx, k, accs, func(e types.Entry) bool {
+			return !e.IsRetracted() && !e.IsMirror()
 		})
 		if err != nil {
-			panic(err)
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read entries"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "entry creator not found"), nil, nil
 		}
 
-		for _, obj := range allEntry {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
+		agency, err := k.GetAgency(ctx, entry.Agency)
+		if err != nil || !agency.HasPublisher(entry.Creator) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "entry creator is no longer a publisher of its agency"), nil, nil
+		}
 
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				entry = obj
-				break
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get params"), nil, err
+		}
+
+		pinned, err := k.PinCount.Has(ctx, entry.Id)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get pin count"), nil, err
+		}
+
+		paths := nonCompliantFields(params, entry)
+		for _, field := range updatableEntryFields {
+			if r.Intn(3) == 0 && !slices.Contains(paths, field) {
+				paths = append(paths, field)
 			}
 		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "entry creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Id = entry.Id
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		if r.Intn(4) == 0 {
+			for _, field := range types.ContentEntryFields {
+				if !slices.Contains(paths, field) {
+					paths = append(paths, field)
+				}
+			}
+		}
+		if len(paths) == 0 {
+			paths = append(paths, types.FieldTitle)
+		}
+
+		update := RandomEntry(r, params, entry.Agency, entry.Creator, ctx.BlockTime())
+		msg := &types.MsgUpdateEntry{
+			Creator:         simAccount.Address.String(),
+			Id:              entry.Id,
+			Title:           update.Title,
+			Description:     update.Description,
+			IpfsCid:         update.IpfsCid,
+			MimeType:        update.MimeType,
+			FileName:        update.FileName,
+			FileUrl:         update.FileUrl,
+			FallbackUrl:     update.FallbackUrl,
+			FileSize:        update.FileSize,
+			ChecksumSha_256: update.ChecksumSha_256,
+			Submitter:       update.Submitter,
+			Category:        update.Category,
+			PublishedAt:     update.PublishedAt,
+			UpdateMask:      &gogotypes.FieldMask{Paths: paths},
+			NewRevision: pinned && slices.ContainsFunc(paths, func(field string) bool {
+				return slices.Contains(types.ContentEntryFields, field)
+			}),
+		}
+		if r.Intn(2) == 0 {
+			msg.ChangeReason = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 8, 64))
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg)
 	}
 }
 
+// SimulateMsgDeleteEntry retracts an active entry created by one of the
+// simulation accounts.
 func SimulateMsgDeleteEntry(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
@@ -111,53 +308,146 @@ func SimulateMsgDeleteEntry(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount = simtypes.Account{}
-			entry      = types.Entry{}
-			msg        = &types.MsgDeleteEntry{}
-			found      = false
-		)
-
-		var allEntry []types.Entry
-		err := k.Entry.Walk(ctx, nil, func(key uint64, value types.Entry) (stop bool, err error) {
-			allEntry = append(allEntry, value)
-			return false, nil
-		})
+		msgType := sdk.MsgTypeURL(&types.MsgDeleteEntry{})
+
+		entry, simAccount, found, err := randomEntry(r, ctx, k, accs, func(e types.Entry) bool { return !e.IsRetracted() })
 		if err != nil {
-			panic(err)
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read entries"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "entry creator not found"), nil, nil
 		}
 
-		for _, obj := range allEntry {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
+		msg := &types.MsgDeleteEntry{
+			Creator: simAccount.Address.String(),
+			Id:      entry.Id,
+			Reason:  simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 8, 64)),
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg)
+	}
+}
 
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				entry = obj
-				break
+// newMsgCreateEntry returns the message publishing entry.
+func newMsgCreateEntry(entry types.Entry) *types.MsgCreateEntry {
+	return &types.MsgCreateEntry{
+		Creator:         entry.Creator,
+		Title:           entry.Title,
+		Description:     entry.Description,
+		IpfsCid:         entry.IpfsCid,
+		MimeType:        entry.MimeType,
+		FileName:        entry.FileName,
+		FileUrl:         entry.FileUrl,
+		FallbackUrl:     entry.FallbackUrl,
+		FileSize:        entry.FileSize,
+		ChecksumSha_256: entry.ChecksumSha_256,
+		Agency:          entry.Agency,
+		Category:        entry.Category,
+		Submitter:       entry.Submitter,
+		PublishedAt:     entry.PublishedAt,
+		MirrorOf:        entry.MirrorOf,
+	}
+}
+
+// newBatchEntry returns the batch entry publishing entry.
+func newBatchEntry(entry types.Entry) types.BatchEntry {
+	return types.BatchEntry{
+		Title:           entry.Title,
+		Description:     entry.Description,
+		IpfsCid:         entry.IpfsCid,
+		MimeType:        entry.MimeType,
+		FileName:        entry.FileName,
+		FileUrl:         entry.FileUrl,
+		FallbackUrl:     entry.FallbackUrl,
+		FileSize:        entry.FileSize,
+		ChecksumSha_256: entry.ChecksumSha_256,
+		Agency:          entry.Agency,
+		Category:        entry.Category,
+		Submitter:       entry.Submitter,
+		PublishedAt:     entry.PublishedAt,
+		MirrorOf:        entry.MirrorOf,
+	}
+}
+
+// randomPublisher returns a random agency together with one of its
+// publishers among accs.
+func randomPublisher(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (types.Agency, simtypes.Account, bool, error) {
+	type candidate struct {
+		agency  types.Agency
+		account simtypes.Account
+	}
+	var candidates []candidate
+	err := k.Agency.Walk(ctx, nil, func(_ string, agency types.Agency) (bool, error) {
+		for _, acc := range accs {
+			if agency.HasPublisher(acc.Address.String()) {
+				candidates = append(candidates, candidate{agency, acc})
 			}
 		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "entry creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.Id = entry.Id
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return false, nil
+	})
+	if err != nil || len(candidates) == 0 {
+		return types.Agency{}, simtypes.Account{}, false, err
+	}
+	c := candidates[r.Intn(len(candidates))]
+	return c.agency, c.account, true, nil
+}
+
+// randomEntry returns a random entry matching filter. When accs is not nil,
+// only the entries created by one of the accounts are considered and the
+// creator account is returned with the entry.
+func randomEntry(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, filter func(types.Entry) bool) (types.Entry, simtypes.Account, bool, error) {
+	type candidate struct {
+		entry   types.Entry
+		account simtypes.Account
+	}
+	var candidates []candidate
+	err := k.Entry.Walk(ctx, nil, func(_ uint64, entry types.Entry) (bool, error) {
+		if !filter(entry) {
+			return false, nil
+		}
+		if accs == nil {
+			candidates = append(candidates, candidate{entry: entry})
+			return false, nil
+		}
+		creator, err := sdk.AccAddressFromBech32(entry.Creator)
+		if err != nil {
+			return true, err
+		}
+		if acc, found := simtypes.FindAccount(accs, creator); found {
+			candidates = append(candidates, candidate{entry, acc})
+		}
+		return false, nil
+	})
+	if err != nil || len(candidates) == 0 {
+		return types.Entry{}, simtypes.Account{}, false, err
+	}
+	c := candidates[r.Intn(len(candidates))]
+	return c.entry, c.account, true, nil
+}
+
+// deliver signs msg with simAccount and delivers it, failing the simulation
+// when the message is rejected.
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	txGen client.TxConfig,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
 	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
 }
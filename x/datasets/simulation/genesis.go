@@ -0,0 +1,109 @@
+package simulation
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"govchain/x/datasets/types"
+)
+
+// Simulation genesis keys, which allow the generated values to be overridden
+// through the simulation params file.
+const (
+	Params      = "params"
+	AgencyCount = "agency_count"
+	EntryCount  = "entry_count"
+	PinnerCount = "pinner_count"
+)
+
+// initialHeight is the height of the first simulated block.
+const initialHeight int64 = 1
+
+// RandomizedGenState generates a random GenesisState for the datasets module:
+// agencies published for by the simulation accounts, entries complying with
+// random params, each with its initial revision, and pinners among the
+// allowed simulation accounts attesting to some of the entries.
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		params      types.Params
+		agencyCount int
+		entryCount  int
+		pinnerCount int
+	)
+	simState.AppParams.GetOrGenerate(Params, &params, simState.Rand, func(r *rand.Rand) { params = RandomParams(r) })
+	simState.AppParams.GetOrGenerate(AgencyCount, &agencyCount, simState.Rand, func(r *rand.Rand) { agencyCount = simtypes.RandIntBetween(r, 1, 6) })
+	simState.AppParams.GetOrGenerate(EntryCount, &entryCount, simState.Rand, func(r *rand.Rand) { entryCount = r.Intn(50) })
+	simState.AppParams.GetOrGenerate(PinnerCount, &pinnerCount, simState.Rand, func(r *rand.Rand) { pinnerCount = r.Intn(4) })
+
+	r := simState.Rand
+	genesis := types.DefaultGenesis()
+	genesis.Params = params
+	genesis.AllowedPinners = RandomAllowedPinners(r, simState.Accounts, int(params.MaxAllowedPinners))
+
+	seen := make(map[string]bool)
+	for len(genesis.AgencyList) < agencyCount {
+		var publishers []string
+		for _, acc := range simState.Accounts {
+			if r.Intn(3) == 0 {
+				publishers = append(publishers, acc.Address.String())
+			}
+		}
+		agency := RandomAgency(r, publishers)
+		if seen[agency.Id] {
+			continue
+		}
+		seen[agency.Id] = true
+		genesis.AgencyList = append(genesis.AgencyList, agency)
+	}
+
+	for id := uint64(0); id < uint64(entryCount); id++ {
+		agency := genesis.AgencyList[r.Intn(len(genesis.AgencyList))]
+		if len(agency.Publishers) == 0 {
+			continue
+		}
+		entry := RandomEntry(r, params, agency.Id, randomChoice(r, agency.Publishers), simState.GenTimestamp)
+		entry.Id = id
+		entry.CreatedAt = simState.GenTimestamp
+		entry.UpdatedAt = simState.GenTimestamp
+		entry.Revision = 1
+
+		genesis.EntryList = append(genesis.EntryList, entry)
+		genesis.EntryRevisionList = append(genesis.EntryRevisionList, types.EntryRevision{
+			EntryId:  id,
+			Revision: 1,
+			Entry:    entry,
+			Editor:   entry.Creator,
+		})
+	}
+	genesis.EntryCount = uint64(entryCount)
+
+	// The attestations are made at the initial height of the simulation and
+	// expire during it unless renewed.
+	for _, i := range r.Perm(len(genesis.AllowedPinners))[:min(pinnerCount, len(genesis.AllowedPinners))] {
+		genesis.PinnerList = append(genesis.PinnerList, RandomPinner(r, genesis.AllowedPinners[i]))
+	}
+	slices.SortFunc(genesis.PinnerList, func(a, b types.Pinner) int { return strings.Compare(a.Address, b.Address) })
+	if params.PinAttestationWindowBlocks > 0 {
+		for _, entry := range genesis.EntryList {
+			for _, pinner := range genesis.PinnerList {
+				if r.Intn(3) != 0 {
+					continue
+				}
+				genesis.PinAttestationList = append(genesis.PinAttestationList, types.PinAttestation{
+					EntryId:        entry.Id,
+					Pinner:         pinner.Address,
+					Cid:            entry.IpfsCid,
+					ProofHeight:    initialHeight,
+					AttestedHeight: initialHeight,
+					ExpiresHeight:  initialHeight + int64(params.PinAttestationWindowBlocks),
+				})
+			}
+		}
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
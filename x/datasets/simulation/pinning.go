@@ -0,0 +1,173 @@
+package simulation
+
+import (
+	"errors"
+	"math/rand"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"govchain/x/datasets/keeper"
+	"govchain/x/datasets/types"
+)
+
+// SimulateMsgRegisterPinner registers a random allowed simulation account as
+// a pinner, or updates its registration.
+func SimulateMsgRegisterPinner(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRegisterPinner{})
+
+		var allowed []simtypes.Account
+		for _, acc := range accs {
+			found, err := k.AllowedPinners.Has(ctx, acc.Address)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read allowed pinners"), nil, err
+			}
+			if found {
+				allowed = append(allowed, acc)
+			}
+		}
+		if len(allowed) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no allowed pinner among the accounts"), nil, nil
+		}
+		simAccount := allowed[r.Intn(len(allowed))]
+		pinner := RandomPinner(r, simAccount.Address.String())
+		msg := &types.MsgRegisterPinner{
+			Pinner:   pinner.Address,
+			Moniker:  pinner.Moniker,
+			Endpoint: pinner.Endpoint,
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg)
+	}
+}
+
+// SimulateMsgAttestPin attests or renews the pinning of the content of an
+// active entry by a pinner among the simulation accounts, with a proof height
+// within the attestation window.
+func SimulateMsgAttestPin(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAttestPin{})
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to get params"), nil, err
+		}
+		if params.PinAttestationWindowBlocks == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "pin attestations are disabled"), nil, nil
+		}
+
+		var pinners []simtypes.Account
+		if err := k.Pinner.Walk(ctx, nil, func(address string, _ types.Pinner) (bool, error) {
+			addr, err := sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return true, err
+			}
+			allowed, err := k.AllowedPinners.Has(ctx, addr)
+			if err != nil {
+				return true, err
+			}
+			if acc, found := simtypes.FindAccount(accs, addr); found && allowed {
+				pinners = append(pinners, acc)
+			}
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read pinners"), nil, err
+		}
+		if len(pinners) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no pinner among the accounts"), nil, nil
+		}
+		simAccount := pinners[r.Intn(len(pinners))]
+
+		entry, _, found, err := randomEntry(r, ctx, k, nil, func(e types.Entry) bool {
+			return !e.IsRetracted() && e.IpfsCid != ""
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read entries"), nil, err
+		}
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no entry to pin"), nil, nil
+		}
+
+		// The proof lies within the window and does not precede the proof of
+		// the attestation it renews.
+		height := ctx.BlockHeight()
+		minProof := max(1, height-int64(params.PinAttestationWindowBlocks)+1)
+		att, err := k.PinAttestation.Get(ctx, collections.Join(entry.Id, simAccount.Address.String()))
+		switch {
+		case err == nil:
+			minProof = max(minProof, att.ProofHeight)
+		case !errors.Is(err, collections.ErrNotFound):
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read pin attestations"), nil, err
+		}
+		if minProof > height {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no proof height within the window"), nil, nil
+		}
+
+		msg := &types.MsgAttestPin{
+			Pinner:      simAccount.Address.String(),
+			EntryId:     entry.Id,
+			Cid:         entry.IpfsCid,
+			ProofHeight: minProof + r.Int63n(height-minProof+1),
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, simAccount, msg)
+	}
+}
+
+// SimulateMsgRespondChallenge answers an open challenge of a pinner among the
+// simulation accounts. The simulated content is not retrievable, so the
+// response is a random chunk that fails the challenge.
+func SimulateMsgRespondChallenge(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRespondChallenge{})
+
+		type candidate struct {
+			account   simtypes.Account
+			challenge uint64
+		}
+		var candidates []candidate
+		if err := k.Challenge.Walk(ctx, nil, func(id uint64, challenge types.Challenge) (bool, error) {
+			addr, err := sdk.AccAddressFromBech32(challenge.Pinner)
+			if err != nil {
+				return true, err
+			}
+			if acc, found := simtypes.FindAccount(accs, addr); found {
+				candidates = append(candidates, candidate{account: acc, challenge: id})
+			}
+			return false, nil
+		}); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "failed to read challenges"), nil, err
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open challenge"), nil, nil
+		}
+		c := candidates[r.Intn(len(candidates))]
+
+		msg := &types.MsgRespondChallenge{
+			Pinner:      c.account.Address.String(),
+			ChallengeId: c.challenge,
+			Chunk:       []byte(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 256))),
+		}
+		return deliver(r, app, ctx, ak, bk, txGen, c.account, msg)
+	}
+}
@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"govchain/x/datasets/types"
+)
+
+// Simulation proposal weights.
+const (
+	OpWeightMsgUpdateParams          = "op_weight_msg_update_params"
+	DefaultWeightMsgUpdateParams int = 100
+
+	OpWeightMsgUpdateAllowedPinners          = "op_weight_msg_update_allowed_pinners"
+	DefaultWeightMsgUpdateAllowedPinners int = 50
+)
+
+// ProposalMsgs returns the messages the simulated governance proposals of the
+// module execute.
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateAllowedPinners,
+			DefaultWeightMsgUpdateAllowedPinners,
+			SimulateMsgUpdateAllowedPinners,
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams setting random valid
+// params with the governance module account as authority.
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	return &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(types.GovModuleName).String(),
+		Params:    RandomParams(r),
+	}
+}
+
+// SimulateMsgUpdateAllowedPinners returns a MsgUpdateAllowedPinners allowing
+// a few random simulation accounts to pin and sometimes revoking another,
+// with the governance module account as authority.
+func SimulateMsgUpdateAllowedPinners(r *rand.Rand, _ sdk.Context, accs []simtypes.Account) sdk.Msg {
+	msg := &types.MsgUpdateAllowedPinners{
+		Authority: authtypes.NewModuleAddress(types.GovModuleName).String(),
+		Allow:     RandomAllowedPinners(r, accs, 3),
+	}
+	if len(accs) > 0 && r.Intn(3) == 0 {
+		revoked := accs[r.Intn(len(accs))].Address.String()
+		if !slices.Contains(msg.Allow, revoked) {
+			msg.Revoke = []string{revoked}
+		}
+	}
+	return msg
+}
@@ -0,0 +1,255 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"govchain/x/datasets/types"
+)
+
+// mimeTypeExtensions maps the media types published by the simulation to the
+// extension of their file names.
+var mimeTypeExtensions = map[string]string{
+	"text/csv":                 ".csv",
+	"text/plain":               ".txt",
+	"application/json":         ".json",
+	"application/xml":          ".xml",
+	"application/pdf":          ".pdf",
+	"application/zip":          ".zip",
+	"application/vnd.ms-excel": ".xls",
+	"image/png":                ".png",
+	"image/tiff":               ".tiff",
+}
+
+// mimeTypes lists the keys of mimeTypeExtensions in a stable order, so that
+// the simulation is reproducible from its seed.
+var mimeTypes = []string{
+	"text/csv", "text/plain", "application/json", "application/xml", "application/pdf",
+	"application/zip", "application/vnd.ms-excel", "image/png", "image/tiff",
+}
+
+// mimeTypeWildcards lists the wildcards that may be allowed by the params.
+var mimeTypeWildcards = []string{"text/*", "application/*", "image/*"}
+
+// categories lists the categories of the simulated entries.
+var categories = []string{
+	"health", "education", "transport", "finance", "environment", "public-safety", "census",
+}
+
+// maxSimulatedFileSize bounds the file size of the simulated entries when the
+// params do not limit it.
+const maxSimulatedFileSize = 1 << 34
+
+// RandomParams returns valid params. Each limit is either left unset or set
+// to a random value the simulated entries can comply with.
+func RandomParams(r *rand.Rand) types.Params {
+	params := types.NewParams(
+		uint32(simtypes.RandIntBetween(r, 64, types.MaxTitleLength+1)),
+		uint32(simtypes.RandIntBetween(r, 256, types.MaxDescriptionLength+1)),
+		0,
+		nil,
+		nil,
+		r.Intn(4) == 0,
+		uint64(simtypes.RandIntBetween(r, 1, 20)),
+		"",
+		uint64(simtypes.RandIntBetween(r, 1, 30)),
+		uint32(r.Intn(4)),
+		uint64(simtypes.RandIntBetween(r, 1, 20)),
+		uint64(r.Intn(types.MaxPinnerReputation/4)),
+		uint32(simtypes.RandIntBetween(r, 1, 10)),
+		uint32(simtypes.RandIntBetween(r, 3, 20)),
+	)
+	if r.Intn(2) == 0 {
+		params.MaxFileSizeBytes = uint64(simtypes.RandIntBetween(r, 1<<20, maxSimulatedFileSize))
+	}
+	if r.Intn(2) == 0 {
+		params.AllowedMimeTypes = randomSubset(r, append(append([]string{}, mimeTypes...), mimeTypeWildcards...))
+	}
+	if r.Intn(2) == 0 {
+		params.AllowedCategories = randomSubset(r, categories)
+	}
+	return params
+}
+
+// RandomAgency returns a valid agency with the given publishers.
+func RandomAgency(r *rand.Rand, publishers []string) types.Agency {
+	id := strings.ToLower(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 3, 12)))
+	return types.Agency{
+		Id:           id,
+		Name:         "Department of " + simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 4, 24)),
+		Jurisdiction: randomChoice(r, []string{"", "federal", "state", "municipal"}),
+		Contact:      "open-data@" + id + ".example.gov",
+		Publishers:   publishers,
+	}
+}
+
+// RandomPinner returns a valid pinner registration of address, with a random
+// reputation.
+func RandomPinner(r *rand.Rand, address string) types.Pinner {
+	moniker := strings.ToLower(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 3, 16)))
+	pinner := types.Pinner{Address: address, Moniker: moniker, Reputation: uint64(r.Intn(types.MaxPinnerReputation + 1))}
+	if r.Intn(2) == 0 {
+		pinner.Endpoint = "/dns4/" + moniker + ".example.org/tcp/4001"
+	}
+	return pinner
+}
+
+// RandomEntry returns an entry complying with params, published by creator on
+// behalf of agency no later than blockTime. The module maintained fields are
+// left unset.
+func RandomEntry(r *rand.Rand, params types.Params, agency, creator string, blockTime time.Time) types.Entry {
+	ipfsCid, checksum := RandomContent(r)
+	mimeType := randomMimeType(r, params)
+	fileName := strings.ToLower(simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 4, 32))) + mimeTypeExtensions[mimeType]
+
+	entry := types.Entry{
+		Title:           randomTitle(r, params),
+		Description:     randomDescription(r, params),
+		IpfsCid:         ipfsCid,
+		MimeType:        mimeType,
+		FileName:        fileName,
+		FileSize:        randomFileSize(r, params),
+		ChecksumSha_256: checksum,
+		Agency:          agency,
+		Category:        randomCategory(r, params),
+		PublishedAt:     blockTime.Add(-time.Duration(r.Int63n(int64(5 * 365 * 24 * time.Hour)))).UTC(),
+		Creator:         creator,
+	}
+	if r.Intn(2) == 0 {
+		entry.FileUrl = "https://data.example.gov/" + agency + "/" + fileName
+	}
+	if params.RequireFallbackUrl || r.Intn(2) == 0 {
+		entry.FallbackUrl = "https://mirror.example.org/" + agency + "/" + fileName
+	}
+	if r.Intn(2) == 0 {
+		entry.Submitter = simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 4, 32))
+	}
+	return entry
+}
+
+// RandomContent returns the IPFS CID and the hex encoded SHA-256 checksum of
+// random content. The CID is a CIDv1 of the raw content, so that both values
+// are unique to the content.
+func RandomContent(r *rand.Rand) (ipfsCid, checksum string) {
+	content := make([]byte, 64)
+	r.Read(content)
+
+	digest := sha256.Sum256(content)
+	hash, err := multihash.Encode(digest[:], multihash.SHA2_256)
+	if err != nil {
+		panic(err)
+	}
+	return cid.NewCidV1(cid.Raw, hash).String(), hex.EncodeToString(digest[:])
+}
+
+// nonCompliantFields returns the fields of entry that do not comply with
+// params, for instance after a parameter change.
+func nonCompliantFields(params types.Params, entry types.Entry) []string {
+	var fields []string
+	if params.MaxTitleLength > 0 && len(entry.Title) > int(params.MaxTitleLength) {
+		fields = append(fields, types.FieldTitle)
+	}
+	if params.MaxDescriptionLength > 0 && len(entry.Description) > int(params.MaxDescriptionLength) {
+		fields = append(fields, types.FieldDescription)
+	}
+	if params.MaxFileSizeBytes > 0 && entry.FileSize > params.MaxFileSizeBytes {
+		fields = append(fields, types.FieldFileSize)
+	}
+	if !params.IsMimeTypeAllowed(entry.MimeType) {
+		fields = append(fields, types.FieldMimeType)
+	}
+	if !params.IsCategoryAllowed(entry.Category) {
+		fields = append(fields, types.FieldCategory)
+	}
+	if params.RequireFallbackUrl && entry.FallbackUrl == "" {
+		fields = append(fields, types.FieldFallbackUrl)
+	}
+	return fields
+}
+
+func randomTitle(r *rand.Rand, params types.Params) string {
+	maxLen := min(limit(params.MaxTitleLength, types.MaxTitleLength), 96)
+	return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, maxLen+1))
+}
+
+func randomDescription(r *rand.Rand, params types.Params) string {
+	maxLen := min(limit(params.MaxDescriptionLength, types.MaxDescriptionLength), 512)
+	return simtypes.RandStringOfLength(r, r.Intn(maxLen+1))
+}
+
+func randomFileSize(r *rand.Rand, params types.Params) uint64 {
+	maxSize := uint64(maxSimulatedFileSize)
+	if params.MaxFileSizeBytes > 0 {
+		maxSize = params.MaxFileSizeBytes
+	}
+	return 1 + uint64(r.Int63n(int64(maxSize)))
+}
+
+// randomMimeType returns a media type allowed by params. A wildcard is
+// expanded to one of the known media types of its type.
+func randomMimeType(r *rand.Rand, params types.Params) string {
+	if len(params.AllowedMimeTypes) == 0 {
+		return randomChoice(r, mimeTypes)
+	}
+	allowed := randomChoice(r, params.AllowedMimeTypes)
+	typ, ok := strings.CutSuffix(allowed, "/*")
+	if !ok {
+		return allowed
+	}
+	var matching []string
+	for _, mimeType := range mimeTypes {
+		if strings.HasPrefix(mimeType, typ+"/") {
+			matching = append(matching, mimeType)
+		}
+	}
+	return randomChoice(r, matching)
+}
+
+func randomCategory(r *rand.Rand, params types.Params) string {
+	if len(params.AllowedCategories) == 0 {
+		return randomChoice(r, append([]string{""}, categories...))
+	}
+	return randomChoice(r, params.AllowedCategories)
+}
+
+// RandomAllowedPinners returns the addresses of up to max random accounts
+// of accs, or none when there is no account.
+func RandomAllowedPinners(r *rand.Rand, accs []simtypes.Account, max int) []string {
+	if len(accs) == 0 || max <= 0 {
+		return nil
+	}
+	var addresses []string
+	for _, i := range r.Perm(len(accs))[:simtypes.RandIntBetween(r, 1, min(max, len(accs))+1)] {
+		addresses = append(addresses, accs[i].Address.String())
+	}
+	return addresses
+}
+
+// randomSubset returns a random non-empty subset of values, in random order.
+func randomSubset(r *rand.Rand, values []string) []string {
+	var subset []string
+	for _, i := range r.Perm(len(values))[:simtypes.RandIntBetween(r, 1, len(values)+1)] {
+		subset = append(subset, values[i])
+	}
+	return subset
+}
+
+func randomChoice(r *rand.Rand, values []string) string {
+	return values[r.Intn(len(values))]
+}
+
+// limit returns the length limit set by a parameter, or max when unset.
+func limit(param uint32, max int) int {
+	if param == 0 {
+		return max
+	}
+	return int(param)
+}
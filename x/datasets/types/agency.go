@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Field length limits, in bytes, enforced on agency metadata.
+const (
+	MaxAgencyNameLength         = 256
+	MaxAgencyJurisdictionLength = 128
+	MaxAgencyContactLength      = 256
+)
+
+// Validate performs the stateless validation of an agency. Publisher addresses
+// are decoded by the keeper, which owns the address codec.
+func (a Agency) Validate() error {
+	if err := validateText(ErrInvalidAgency, "agency id", a.Id, MaxAgencyLength, true); err != nil {
+		return err
+	}
+	if strings.IndexFunc(a.Id, unicode.IsSpace) >= 0 {
+		return errorsmod.Wrapf(ErrInvalidAgency, "agency id %q must not contain whitespace", a.Id)
+	}
+	if err := validateText(ErrInvalidAgency, "agency name", a.Name, MaxAgencyNameLength, true); err != nil {
+		return err
+	}
+	if err := validateText(ErrInvalidAgency, "jurisdiction", a.Jurisdiction, MaxAgencyJurisdictionLength, false); err != nil {
+		return err
+	}
+	if err := validateText(ErrInvalidAgency, "contact", a.Contact, MaxAgencyContactLength, false); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(a.Publishers))
+	for _, publisher := range a.Publishers {
+		if publisher == "" {
+			return errorsmod.Wrapf(ErrInvalidAgency, "agency %s has an empty publisher", a.Id)
+		}
+		if seen[publisher] {
+			return errorsmod.Wrapf(ErrInvalidAgency, "agency %s has duplicated publisher %s", a.Id, publisher)
+		}
+		seen[publisher] = true
+	}
+	return nil
+}
+
+// HasPublisher reports whether address is an authorized publisher of the agency.
+func (a Agency) HasPublisher(address string) bool {
+	for _, publisher := range a.Publishers {
+		if publisher == address {
+			return true
+		}
+	}
+	return false
+}
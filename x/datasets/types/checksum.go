@@ -0,0 +1,87 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ipfs/go-cid"
+)
+
+// FileChecksum returns the hex encoded SHA-256 digest of the content read from
+// r, in the canonical form of checksum_sha_256.
+func FileChecksum(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyChecksum checks that the SHA-256 digest of the content read from r is
+// the checksum of the entry.
+func (e Entry) VerifyChecksum(r io.Reader) error {
+	if e.ChecksumSha_256 == "" {
+		return errorsmod.Wrapf(ErrChecksumMismatch, "entry %d has no checksum", e.Id)
+	}
+	checksum, err := FileChecksum(r)
+	if err != nil {
+		return err
+	}
+	if checksum != NormalizeChecksum(e.ChecksumSha_256) {
+		return errorsmod.Wrapf(ErrChecksumMismatch, "file checksum %s, entry %d checksum %s", checksum, e.Id, e.ChecksumSha_256)
+	}
+	return nil
+}
+
+// FileDigest describes content the way an entry records it.
+type FileDigest struct {
+	Size     uint64
+	Checksum string
+	Cid      cid.Cid
+}
+
+// DigestFile reads the content from r once and returns its size, its SHA-256
+// checksum and the CID the upload flow assigns to it.
+func DigestFile(r io.Reader) (FileDigest, error) {
+	return ImportDigest(r, nil)
+}
+
+// ImportDigest is DigestFile passing the blocks of the DAG of the content to
+// put, as ImportFile does, so that the content is stored as it is digested.
+func ImportDigest(r io.Reader, put func(c cid.Cid, block []byte) error) (FileDigest, error) {
+	h := sha256.New()
+	counter := &countingWriter{}
+	c, err := ImportFile(io.TeeReader(r, io.MultiWriter(h, counter)), put)
+	if err != nil {
+		return FileDigest{}, err
+	}
+	return FileDigest{Size: counter.n, Checksum: hex.EncodeToString(h.Sum(nil)), Cid: c}, nil
+}
+
+// MismatchedFields returns the content fields recorded by the entry that
+// differ from d, in proto declaration order. Fields the entry leaves unset
+// are not compared.
+func (e Entry) MismatchedFields(d FileDigest) []string {
+	var fields []string
+	if e.IpfsCid != "" && NormalizeCid(e.IpfsCid) != NormalizeCid(d.Cid.String()) {
+		fields = append(fields, FieldIpfsCid)
+	}
+	if e.FileSize != 0 && e.FileSize != d.Size {
+		fields = append(fields, FieldFileSize)
+	}
+	if e.ChecksumSha_256 != "" && NormalizeChecksum(e.ChecksumSha_256) != d.Checksum {
+		fields = append(fields, FieldChecksumSha256)
+	}
+	return fields
+}
+
+type countingWriter struct {
+	n uint64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += uint64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+func TestEntryVerifyChecksum(t *testing.T) {
+	// sha256("hello\n")
+	const checksum = "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03"
+
+	checksumOf, err := types.FileChecksum(strings.NewReader("hello\n"))
+	require.NoError(t, err)
+	require.Equal(t, checksum, checksumOf)
+
+	entry := types.Entry{Id: 1, ChecksumSha_256: strings.ToUpper(checksum)}
+	require.NoError(t, entry.VerifyChecksum(strings.NewReader("hello\n")))
+	require.ErrorIs(t, entry.VerifyChecksum(strings.NewReader("hello")), types.ErrChecksumMismatch)
+
+	entry.ChecksumSha_256 = ""
+	require.ErrorIs(t, entry.VerifyChecksum(strings.NewReader("hello\n")), types.ErrChecksumMismatch)
+}
+
+func TestEntryMismatchedFields(t *testing.T) {
+	digest, err := types.DigestFile(strings.NewReader("hello world\n"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), digest.Size)
+	require.Equal(t, "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447", digest.Checksum)
+	require.Equal(t, "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o", digest.Cid.String())
+
+	// importing the content digests it the same way
+	var blocks []cid.Cid
+	imported, err := types.ImportDigest(strings.NewReader("hello world\n"), func(c cid.Cid, _ []byte) error {
+		blocks = append(blocks, c)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, digest, imported)
+	require.Equal(t, []cid.Cid{digest.Cid}, blocks)
+
+	entry := types.Entry{
+		// the CIDv1 form of the same DAG
+		IpfsCid:         "bafybeicg2rebjoofv4kbyovkw7af3rpiitvnl6i7ckcywaq6xjcxnc2mby",
+		FileSize:        12,
+		ChecksumSha_256: strings.ToUpper(digest.Checksum),
+	}
+	require.Empty(t, entry.MismatchedFields(digest))
+	require.Empty(t, types.Entry{}.MismatchedFields(digest))
+
+	entry.IpfsCid = "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"
+	entry.FileSize = 13
+	require.Equal(t, []string{types.FieldIpfsCid, types.FieldFileSize}, entry.MismatchedFields(digest))
+}
@@ -11,10 +11,19 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreateEntry{},
 		&MsgUpdateEntry{},
 		&MsgDeleteEntry{},
+		&MsgRegisterPinner{},
+		&MsgAttestPin{},
+		&MsgRespondChallenge{},
+		&MsgCreateEntriesBatch{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterAgency{},
+		&MsgUpdateAgency{},
+		&MsgDeregisterAgency{},
+		&MsgPurgeEntry{},
+		&MsgUpdateAllowedPinners{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
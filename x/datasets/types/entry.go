@@ -0,0 +1,99 @@
+package types
+
+import "slices"
+
+// Names of the entry metadata fields, as used in field masks and events.
+const (
+	FieldTitle          = "title"
+	FieldDescription    = "description"
+	FieldIpfsCid        = "ipfs_cid"
+	FieldMimeType       = "mime_type"
+	FieldFileName       = "file_name"
+	FieldFileUrl        = "file_url"
+	FieldFallbackUrl    = "fallback_url"
+	FieldFileSize       = "file_size"
+	FieldChecksumSha256 = "checksum_sha_256"
+	FieldAgency         = "agency"
+	FieldCategory       = "category"
+	FieldSubmitter      = "submitter"
+	FieldPublishedAt    = "published_at"
+)
+
+// MutableEntryFields lists, in proto declaration order, the entry fields that
+// can be changed by MsgUpdateEntry.
+var MutableEntryFields = []string{
+	FieldTitle, FieldDescription, FieldIpfsCid, FieldMimeType, FieldFileName,
+	FieldFileUrl, FieldFallbackUrl, FieldFileSize, FieldChecksumSha256,
+	FieldAgency, FieldCategory, FieldSubmitter, FieldPublishedAt,
+}
+
+// ImmutableEntryFields lists the entry fields maintained by the module, which
+// can never be changed by MsgUpdateEntry.
+var ImmutableEntryFields = []string{
+	"id", "creator", "created_tx_hash", "created_at", "revision", "status",
+	"retraction", "created_height", "updated_tx_hash", "updated_height", "updated_at",
+	"mirror_of",
+}
+
+// ContentEntryFields lists the entry fields describing the published content.
+// Once the entry is pinned they can only be changed by a new revision.
+var ContentEntryFields = []string{FieldIpfsCid, FieldFileSize, FieldChecksumSha256}
+
+// IsRetracted reports whether the entry has been retracted.
+func (e Entry) IsRetracted() bool {
+	return e.Status == ENTRY_STATUS_RETRACTED
+}
+
+// IsMirror reports whether the entry mirrors the content of another entry.
+func (e Entry) IsMirror() bool {
+	return e.MirrorOf != nil
+}
+
+// ChangedFields returns the names of the metadata fields whose value differs
+// between e and other, in proto declaration order.
+func (e Entry) ChangedFields(other Entry) []string {
+	var changed []string
+	for _, field := range MutableEntryFields {
+		if !e.fieldEqual(field, other) {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+func (e Entry) fieldEqual(field string, other Entry) bool {
+	switch field {
+	case FieldTitle:
+		return e.Title == other.Title
+	case FieldDescription:
+		return e.Description == other.Description
+	case FieldIpfsCid:
+		return e.IpfsCid == other.IpfsCid
+	case FieldMimeType:
+		return e.MimeType == other.MimeType
+	case FieldFileName:
+		return e.FileName == other.FileName
+	case FieldFileUrl:
+		return e.FileUrl == other.FileUrl
+	case FieldFallbackUrl:
+		return e.FallbackUrl == other.FallbackUrl
+	case FieldFileSize:
+		return e.FileSize == other.FileSize
+	case FieldChecksumSha256:
+		return e.ChecksumSha_256 == other.ChecksumSha_256
+	case FieldAgency:
+		return e.Agency == other.Agency
+	case FieldCategory:
+		return e.Category == other.Category
+	case FieldSubmitter:
+		return e.Submitter == other.Submitter
+	case FieldPublishedAt:
+		return e.PublishedAt.Equal(other.PublishedAt)
+	}
+	return true
+}
+
+// isMutableEntryField reports whether field can be set by MsgUpdateEntry.
+func isMutableEntryField(field string) bool {
+	return slices.Contains(MutableEntryFields, field)
+}
@@ -1,20 +1,25 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: govchain/datasets/v1/entry.proto
+// source: govchain/datasets/v2/entry.proto
 
 package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,6 +27,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EntryStatus is the publication status of an entry.
+type EntryStatus int32
+
+const (
+	// ENTRY_STATUS_ACTIVE is the status of a published entry.
+	ENTRY_STATUS_ACTIVE EntryStatus = 0
+	// ENTRY_STATUS_RETRACTED is the status of an entry withdrawn by its
+	// publisher. Retracted entries stay retrievable by id but are hidden from
+	// default listings.
+	ENTRY_STATUS_RETRACTED EntryStatus = 1
+)
+
+var EntryStatus_name = map[int32]string{
+	0: "ENTRY_STATUS_ACTIVE",
+	1: "ENTRY_STATUS_RETRACTED",
+}
+
+var EntryStatus_value = map[string]int32{
+	"ENTRY_STATUS_ACTIVE":    0,
+	"ENTRY_STATUS_RETRACTED": 1,
+}
+
+func (x EntryStatus) String() string {
+	return proto.EnumName(EntryStatus_name, int32(x))
+}
+
+func (EntryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_026bb19b333771b6, []int{0}
+}
+
 // Entry defines the Entry message.
 type Entry struct {
 	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,22 +67,44 @@ type Entry struct {
 	FileName        string `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	FileUrl         string `protobuf:"bytes,7,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
 	FallbackUrl     string `protobuf:"bytes,8,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
-	FileSize        string `protobuf:"bytes,9,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	ChecksumSha_256 string `protobuf:"bytes,10,opt,name=checksum_sha_256,json=checksumSha256,proto3" json:"checksum_sha_256,omitempty"`
 	Agency          string `protobuf:"bytes,11,opt,name=agency,proto3" json:"agency,omitempty"`
 	Category        string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
 	Submitter       string `protobuf:"bytes,13,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	Timestamp       string `protobuf:"bytes,14,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	PinCount        string `protobuf:"bytes,15,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
 	Creator         string `protobuf:"bytes,16,opt,name=creator,proto3" json:"creator,omitempty"`
-	TxHash          string `protobuf:"bytes,17,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// created_tx_hash is the hash of the transaction that created the entry,
+	// empty when it was created outside of a transaction.
+	CreatedTxHash string `protobuf:"bytes,17,opt,name=created_tx_hash,json=createdTxHash,proto3" json:"created_tx_hash,omitempty"`
+	// created_at is the block time at which the entry was created.
+	CreatedAt time.Time `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// revision is the number of the current revision of the entry, starting at 1.
+	Revision uint64      `protobuf:"varint,19,opt,name=revision,proto3" json:"revision,omitempty"`
+	Status   EntryStatus `protobuf:"varint,20,opt,name=status,proto3,enum=govchain.datasets.v2.EntryStatus" json:"status,omitempty"`
+	// retraction is set once the entry has been retracted.
+	Retraction *Retraction `protobuf:"bytes,21,opt,name=retraction,proto3" json:"retraction,omitempty"`
+	// created_height is the block height at which the entry was created.
+	CreatedHeight int64 `protobuf:"varint,22,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// updated_tx_hash is the hash of the transaction that last modified the
+	// entry, including its retraction.
+	UpdatedTxHash string `protobuf:"bytes,23,opt,name=updated_tx_hash,json=updatedTxHash,proto3" json:"updated_tx_hash,omitempty"`
+	// updated_height is the block height at which the entry was last modified.
+	UpdatedHeight int64 `protobuf:"varint,24,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// updated_at is the block time at which the entry was last modified.
+	UpdatedAt time.Time `protobuf:"bytes,25,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// mirror_of is set when the entry republishes the content of another entry.
+	// Mirrors are exempt from the CID and checksum uniqueness checks.
+	MirrorOf *MirrorLink `protobuf:"bytes,26,opt,name=mirror_of,json=mirrorOf,proto3" json:"mirror_of,omitempty"`
+	// file_size is the size of the dataset file in bytes.
+	FileSize uint64 `protobuf:"varint,28,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// published_at is the publication time of the dataset as declared by the submitter.
+	PublishedAt time.Time `protobuf:"bytes,29,opt,name=published_at,json=publishedAt,proto3,stdtime" json:"published_at"`
 }
 
 func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33b8d88a5975f3d9, []int{0}
+	return fileDescriptor_026bb19b333771b6, []int{0}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -132,13 +189,6 @@ func (m *Entry) GetFallbackUrl() string {
 	return ""
 }
 
-func (m *Entry) GetFileSize() string {
-	if m != nil {
-		return m.FileSize
-	}
-	return ""
-}
-
 func (m *Entry) GetChecksumSha_256() string {
 	if m != nil {
 		return m.ChecksumSha_256
@@ -167,68 +217,378 @@ func (m *Entry) GetSubmitter() string {
 	return ""
 }
 
-func (m *Entry) GetTimestamp() string {
+func (m *Entry) GetCreator() string {
 	if m != nil {
-		return m.Timestamp
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *Entry) GetPinCount() string {
+func (m *Entry) GetCreatedTxHash() string {
 	if m != nil {
-		return m.PinCount
+		return m.CreatedTxHash
 	}
 	return ""
 }
 
-func (m *Entry) GetCreator() string {
+func (m *Entry) GetCreatedAt() time.Time {
 	if m != nil {
-		return m.Creator
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Entry) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Entry) GetStatus() EntryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ENTRY_STATUS_ACTIVE
+}
+
+func (m *Entry) GetRetraction() *Retraction {
+	if m != nil {
+		return m.Retraction
+	}
+	return nil
+}
+
+func (m *Entry) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Entry) GetUpdatedTxHash() string {
+	if m != nil {
+		return m.UpdatedTxHash
+	}
+	return ""
+}
+
+func (m *Entry) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *Entry) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Entry) GetMirrorOf() *MirrorLink {
+	if m != nil {
+		return m.MirrorOf
+	}
+	return nil
+}
+
+func (m *Entry) GetFileSize() uint64 {
+	if m != nil {
+		return m.FileSize
+	}
+	return 0
+}
+
+func (m *Entry) GetPublishedAt() time.Time {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return time.Time{}
+}
+
+// MirrorLink links an entry to the entry whose content it mirrors.
+type MirrorLink struct {
+	EntryId uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+}
+
+func (m *MirrorLink) Reset()         { *m = MirrorLink{} }
+func (m *MirrorLink) String() string { return proto.CompactTextString(m) }
+func (*MirrorLink) ProtoMessage()    {}
+func (*MirrorLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_026bb19b333771b6, []int{1}
+}
+func (m *MirrorLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MirrorLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MirrorLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MirrorLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorLink.Merge(m, src)
+}
+func (m *MirrorLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *MirrorLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorLink proto.InternalMessageInfo
+
+func (m *MirrorLink) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+// Retraction records why, when and by whom an entry was retracted.
+type Retraction struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *Retraction) Reset()         { *m = Retraction{} }
+func (m *Retraction) String() string { return proto.CompactTextString(m) }
+func (*Retraction) ProtoMessage()    {}
+func (*Retraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_026bb19b333771b6, []int{2}
+}
+func (m *Retraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Retraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Retraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Retraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retraction.Merge(m, src)
+}
+func (m *Retraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Retraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retraction proto.InternalMessageInfo
+
+func (m *Retraction) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Retraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Retraction) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// EntryRevision is an immutable record of one revision of an entry. A revision
+// is appended when the entry is created and on every subsequent update.
+type EntryRevision struct {
+	EntryId  uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// previous_ipfs_cid is the IPFS CID of the preceding revision, empty for the
+	// first revision.
+	PreviousIpfsCid string `protobuf:"bytes,3,opt,name=previous_ipfs_cid,json=previousIpfsCid,proto3" json:"previous_ipfs_cid,omitempty"`
+	// editor is the address that signed the revision.
+	Editor       string `protobuf:"bytes,4,opt,name=editor,proto3" json:"editor,omitempty"`
+	BlockHeight  int64  `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TxHash       string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	ChangeReason string `protobuf:"bytes,7,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+	// entry is the full entry metadata as of this revision.
+	Entry Entry `protobuf:"bytes,8,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EntryRevision) Reset()         { *m = EntryRevision{} }
+func (m *EntryRevision) String() string { return proto.CompactTextString(m) }
+func (*EntryRevision) ProtoMessage()    {}
+func (*EntryRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_026bb19b333771b6, []int{3}
+}
+func (m *EntryRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntryRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryRevision.Merge(m, src)
+}
+func (m *EntryRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryRevision proto.InternalMessageInfo
+
+func (m *EntryRevision) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *EntryRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *EntryRevision) GetPreviousIpfsCid() string {
+	if m != nil {
+		return m.PreviousIpfsCid
+	}
+	return ""
+}
+
+func (m *EntryRevision) GetEditor() string {
+	if m != nil {
+		return m.Editor
 	}
 	return ""
 }
 
-func (m *Entry) GetTxHash() string {
+func (m *EntryRevision) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EntryRevision) GetTxHash() string {
 	if m != nil {
 		return m.TxHash
 	}
 	return ""
 }
 
+func (m *EntryRevision) GetChangeReason() string {
+	if m != nil {
+		return m.ChangeReason
+	}
+	return ""
+}
+
+func (m *EntryRevision) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return Entry{}
+}
+
 func init() {
-	proto.RegisterType((*Entry)(nil), "govchain.datasets.v1.Entry")
-}
-
-func init() { proto.RegisterFile("govchain/datasets/v1/entry.proto", fileDescriptor_33b8d88a5975f3d9) }
-
-var fileDescriptor_33b8d88a5975f3d9 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xbd, 0x6e, 0x13, 0x41,
-	0x14, 0x85, 0xbd, 0x4e, 0xfc, 0x37, 0x09, 0x26, 0x8c, 0x22, 0xb8, 0x01, 0xb4, 0x32, 0x54, 0xae,
-	0x6c, 0x25, 0x51, 0xf2, 0x00, 0x44, 0x48, 0x54, 0x14, 0x09, 0x34, 0x34, 0xab, 0xf1, 0xec, 0xb5,
-	0xf7, 0x2a, 0xfb, 0xa7, 0x99, 0x6b, 0xcb, 0xce, 0x53, 0xf0, 0x58, 0x94, 0x29, 0x29, 0x91, 0x5d,
-	0xf1, 0x16, 0x68, 0x66, 0xb2, 0x31, 0xe5, 0x39, 0xdf, 0xd1, 0xd1, 0xd5, 0xcc, 0x11, 0xa3, 0x45,
-	0xb5, 0xd2, 0x99, 0xa2, 0x72, 0x9a, 0x2a, 0x56, 0x16, 0xd9, 0x4e, 0x57, 0xe7, 0x53, 0x2c, 0xd9,
-	0x6c, 0x26, 0xb5, 0xa9, 0xb8, 0x92, 0xa7, 0x4d, 0x62, 0xd2, 0x24, 0x26, 0xab, 0xf3, 0x8f, 0x7f,
-	0x0f, 0x44, 0xe7, 0xb3, 0x4b, 0xc9, 0xa1, 0x68, 0x53, 0x0a, 0xd1, 0x28, 0x1a, 0x1f, 0xde, 0xb6,
-	0x29, 0x95, 0xa7, 0xa2, 0xc3, 0xc4, 0x39, 0x42, 0x7b, 0x14, 0x8d, 0x07, 0xb7, 0x41, 0xc8, 0x91,
-	0x38, 0x4a, 0xd1, 0x6a, 0x43, 0x35, 0x53, 0x55, 0xc2, 0x81, 0x67, 0xff, 0x5b, 0xf2, 0x4c, 0xf4,
-	0xa9, 0x9e, 0xdb, 0x44, 0x53, 0x0a, 0x87, 0x1e, 0xf7, 0x9c, 0xbe, 0xa1, 0x54, 0xbe, 0x13, 0x83,
-	0x82, 0x0a, 0x4c, 0x78, 0x53, 0x23, 0x74, 0x3c, 0xeb, 0x3b, 0xe3, 0xdb, 0xa6, 0x46, 0x07, 0xe7,
-	0x94, 0x63, 0x52, 0xaa, 0x02, 0xa1, 0x1b, 0xa0, 0x33, 0xbe, 0xaa, 0x02, 0x5d, 0xa9, 0x87, 0x4b,
-	0x93, 0x43, 0x2f, 0x94, 0x3a, 0xfd, 0xdd, 0xe4, 0xf2, 0x83, 0x38, 0x9e, 0xab, 0x3c, 0x9f, 0x29,
-	0x7d, 0xef, 0x71, 0x3f, 0x9c, 0xd4, 0x78, 0x2e, 0xd2, 0x54, 0x5b, 0x7a, 0x40, 0x18, 0xec, 0xab,
-	0xef, 0xe8, 0x01, 0xe5, 0x58, 0x9c, 0xe8, 0x0c, 0xf5, 0xbd, 0x5d, 0x16, 0x89, 0xcd, 0x54, 0x72,
-	0x71, 0x75, 0x0d, 0xc2, 0x67, 0x86, 0x8d, 0x7f, 0x97, 0xa9, 0x8b, 0xab, 0x6b, 0xf9, 0x5a, 0x74,
-	0xd5, 0x02, 0x4b, 0xbd, 0x81, 0x23, 0xcf, 0x9f, 0x94, 0x7c, 0x2b, 0xfa, 0x5a, 0x31, 0x2e, 0x2a,
-	0xb3, 0x81, 0xe3, 0xd0, 0xde, 0x68, 0xf9, 0x5e, 0x0c, 0xec, 0x72, 0x56, 0x10, 0x33, 0x1a, 0x78,
-	0xe1, 0xe1, 0xde, 0x70, 0x94, 0xa9, 0x40, 0xcb, 0xaa, 0xa8, 0x61, 0x18, 0xe8, 0xb3, 0xe1, 0xce,
-	0xae, 0xa9, 0x4c, 0x74, 0xb5, 0x2c, 0x19, 0x5e, 0x86, 0xe2, 0x9a, 0xca, 0x1b, 0xa7, 0x25, 0x88,
-	0x9e, 0x36, 0xa8, 0xb8, 0x32, 0x70, 0x12, 0x1e, 0xe4, 0x49, 0xca, 0x37, 0xa2, 0xc7, 0xeb, 0x24,
-	0x53, 0x36, 0x83, 0x57, 0xe1, 0x4e, 0x5e, 0x7f, 0x51, 0x36, 0xfb, 0x74, 0xf9, 0x6b, 0x1b, 0x47,
-	0x8f, 0xdb, 0x38, 0xfa, 0xb3, 0x8d, 0xa3, 0x9f, 0xbb, 0xb8, 0xf5, 0xb8, 0x8b, 0x5b, 0xbf, 0x77,
-	0x71, 0xeb, 0xc7, 0xd9, 0xf3, 0x7a, 0xd6, 0xfb, 0xfd, 0xb8, 0x5f, 0xb2, 0xb3, 0xae, 0x5f, 0xcf,
-	0xe5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xc8, 0x0e, 0x0f, 0x61, 0x02, 0x00, 0x00,
+	proto.RegisterEnum("govchain.datasets.v2.EntryStatus", EntryStatus_name, EntryStatus_value)
+	proto.RegisterType((*Entry)(nil), "govchain.datasets.v2.Entry")
+	proto.RegisterType((*MirrorLink)(nil), "govchain.datasets.v2.MirrorLink")
+	proto.RegisterType((*Retraction)(nil), "govchain.datasets.v2.Retraction")
+	proto.RegisterType((*EntryRevision)(nil), "govchain.datasets.v2.EntryRevision")
+}
+
+func init() { proto.RegisterFile("govchain/datasets/v2/entry.proto", fileDescriptor_026bb19b333771b6) }
+
+var fileDescriptor_026bb19b333771b6 = []byte{
+	// 903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x15, 0x59, 0xa2, 0x46, 0xb6, 0xcc, 0x6c, 0x5c, 0x7b, 0x2d, 0xbb, 0xb2, 0xe2, 0x22,
+	0xad, 0x90, 0x83, 0x04, 0x28, 0x48, 0x8a, 0x1e, 0x0a, 0x54, 0x76, 0x85, 0xda, 0x42, 0x9b, 0x02,
+	0x14, 0x53, 0xa0, 0xbd, 0x10, 0x2b, 0x72, 0x45, 0x2e, 0x2c, 0x91, 0x04, 0x77, 0x65, 0x58, 0xf9,
+	0x05, 0x3d, 0xe6, 0xde, 0x63, 0xfb, 0x63, 0x72, 0xcc, 0xb1, 0xa7, 0xb6, 0xb0, 0xff, 0x48, 0xb1,
+	0x1f, 0x94, 0xed, 0x22, 0x0d, 0x90, 0x1b, 0xdf, 0x9b, 0xb7, 0xa3, 0xd9, 0x99, 0xb7, 0x23, 0xe8,
+	0x44, 0xe9, 0x65, 0x10, 0x13, 0x96, 0xf4, 0x43, 0x22, 0x08, 0xa7, 0x82, 0xf7, 0x2f, 0x07, 0x7d,
+	0x9a, 0x88, 0x7c, 0xd5, 0xcb, 0xf2, 0x54, 0xa4, 0x68, 0xa7, 0x50, 0xf4, 0x0a, 0x45, 0xef, 0x72,
+	0xd0, 0xda, 0x89, 0xd2, 0x28, 0x55, 0x82, 0xbe, 0xfc, 0xd2, 0xda, 0xd6, 0x51, 0x94, 0xa6, 0xd1,
+	0x9c, 0xf6, 0x15, 0x9a, 0x2e, 0x67, 0x7d, 0xc1, 0x16, 0x94, 0x0b, 0xb2, 0xc8, 0xb4, 0xe0, 0xf8,
+	0x37, 0x1b, 0x36, 0x46, 0x32, 0x39, 0x6a, 0x42, 0x99, 0x85, 0xd8, 0xea, 0x58, 0xdd, 0x8a, 0x5b,
+	0x66, 0x21, 0xda, 0x81, 0x0d, 0xc1, 0xc4, 0x9c, 0xe2, 0x72, 0xc7, 0xea, 0xd6, 0x5d, 0x0d, 0x50,
+	0x07, 0x1a, 0x21, 0xe5, 0x41, 0xce, 0x32, 0xc1, 0xd2, 0x04, 0x3f, 0x50, 0xb1, 0xbb, 0x14, 0xda,
+	0x07, 0x9b, 0x65, 0x33, 0xee, 0x07, 0x2c, 0xc4, 0x15, 0x15, 0xae, 0x49, 0x7c, 0xca, 0x42, 0x74,
+	0x00, 0xf5, 0x05, 0x5b, 0x50, 0x5f, 0xac, 0x32, 0x8a, 0x37, 0x54, 0xcc, 0x96, 0x84, 0xb7, 0xca,
+	0xa8, 0x0c, 0xce, 0xd8, 0x9c, 0xfa, 0x09, 0x59, 0x50, 0x5c, 0xd5, 0x41, 0x49, 0xbc, 0x24, 0x0b,
+	0x2a, 0x93, 0xaa, 0xe0, 0x32, 0x9f, 0xe3, 0x9a, 0x4e, 0x2a, 0xf1, 0xab, 0x7c, 0x8e, 0x1e, 0xc3,
+	0xe6, 0x8c, 0xcc, 0xe7, 0x53, 0x12, 0x5c, 0xa8, 0xb0, 0xad, 0x4b, 0x2a, 0x38, 0x29, 0xe9, 0x82,
+	0x13, 0xc4, 0x34, 0xb8, 0xe0, 0xcb, 0x85, 0xcf, 0x63, 0xe2, 0x0f, 0x9e, 0xbf, 0xc0, 0xa0, 0x64,
+	0xcd, 0x82, 0x9f, 0xc4, 0x64, 0xf0, 0xfc, 0x05, 0xda, 0x85, 0x2a, 0x89, 0x68, 0x12, 0xac, 0x70,
+	0x43, 0xc5, 0x0d, 0x42, 0x2d, 0xb0, 0x03, 0x22, 0x68, 0x94, 0xe6, 0x2b, 0xbc, 0xa9, 0x6b, 0x2b,
+	0x30, 0x3a, 0x84, 0x3a, 0x5f, 0x4e, 0x17, 0x4c, 0x08, 0x9a, 0xe3, 0x2d, 0x15, 0xbc, 0x25, 0x10,
+	0x86, 0x5a, 0x90, 0x53, 0x22, 0xd2, 0x1c, 0x3b, 0xba, 0x70, 0x03, 0xd1, 0xe7, 0xb0, 0xad, 0x3e,
+	0x69, 0xe8, 0x8b, 0x2b, 0x3f, 0x26, 0x3c, 0xc6, 0x0f, 0x95, 0x62, 0xcb, 0xd0, 0xde, 0xd5, 0x19,
+	0xe1, 0x31, 0x3a, 0x05, 0x28, 0x74, 0x44, 0x60, 0xd4, 0xb1, 0xba, 0x8d, 0x41, 0xab, 0xa7, 0x07,
+	0xdb, 0x2b, 0x06, 0xdb, 0xf3, 0x8a, 0xc1, 0x9e, 0xd8, 0x6f, 0xff, 0x3a, 0x2a, 0xbd, 0xf9, 0xfb,
+	0xc8, 0x72, 0xeb, 0xe6, 0xdc, 0x50, 0xc8, 0x0b, 0xe4, 0xf4, 0x92, 0x71, 0x39, 0xb4, 0x47, 0x6a,
+	0xc6, 0x6b, 0x8c, 0xbe, 0x82, 0x2a, 0x17, 0x44, 0x2c, 0x39, 0xde, 0xe9, 0x58, 0xdd, 0xe6, 0xe0,
+	0x71, 0xef, 0x7d, 0x0e, 0xeb, 0x29, 0x9b, 0x4c, 0x94, 0xd0, 0x35, 0x07, 0xd0, 0x37, 0x00, 0x39,
+	0x15, 0x39, 0x09, 0x94, 0x1b, 0x3e, 0x51, 0xb5, 0x75, 0xde, 0x7f, 0xdc, 0x5d, 0xeb, 0xdc, 0x3b,
+	0x67, 0xd0, 0x13, 0x68, 0x16, 0xb7, 0x8b, 0x29, 0x8b, 0x62, 0x81, 0x77, 0x3b, 0x56, 0xf7, 0xc1,
+	0xba, 0x09, 0x67, 0x8a, 0x94, 0xcd, 0x5a, 0x66, 0xe1, 0xbd, 0x66, 0xed, 0xe9, 0x66, 0x19, 0xda,
+	0x34, 0xeb, 0x09, 0x34, 0x0b, 0x9d, 0x49, 0x87, 0x75, 0x3a, 0xc3, 0x9a, 0x74, 0xa7, 0x00, 0x85,
+	0x8c, 0x08, 0xbc, 0xff, 0x31, 0x3d, 0x35, 0xe7, 0x86, 0x02, 0x7d, 0x2d, 0xed, 0x9c, 0xe7, 0x69,
+	0xee, 0xa7, 0x33, 0xdc, 0xfa, 0xd0, 0xdd, 0x7f, 0x50, 0xb2, 0xef, 0x59, 0x72, 0x21, 0x0d, 0x2f,
+	0xbf, 0x7f, 0x9c, 0xad, 0x0d, 0xcf, 0xd9, 0x6b, 0x8a, 0x0f, 0xf5, 0x4c, 0x24, 0x31, 0x61, 0xaf,
+	0x29, 0xfa, 0x0e, 0x36, 0xb3, 0xe5, 0x74, 0xce, 0x78, 0xac, 0x4b, 0xfc, 0xf4, 0x23, 0x4a, 0x6c,
+	0xac, 0x4f, 0x0e, 0xc5, 0xb8, 0x62, 0xd7, 0x1d, 0x18, 0x57, 0xec, 0xa6, 0xb3, 0x3d, 0xae, 0xd8,
+	0xdb, 0x8e, 0x33, 0xae, 0xd8, 0x07, 0xce, 0xe1, 0xb8, 0x62, 0xb7, 0x9d, 0x23, 0xb7, 0xbe, 0xde,
+	0x07, 0x6e, 0x2d, 0x63, 0x49, 0x42, 0x73, 0xee, 0xd6, 0x33, 0x96, 0xf8, 0x41, 0xba, 0x4c, 0xc4,
+	0xf1, 0x17, 0x00, 0xb7, 0xa5, 0xcb, 0x47, 0xa8, 0xf6, 0x90, 0xbf, 0xde, 0x13, 0x35, 0x85, 0xcf,
+	0xc3, 0x63, 0x0f, 0xe0, 0x76, 0xbe, 0xf2, 0x15, 0xe5, 0x94, 0xf0, 0x34, 0x51, 0xb2, 0xba, 0x6b,
+	0x90, 0xe4, 0xcd, 0x50, 0xca, 0x6a, 0x28, 0x06, 0x49, 0x9e, 0xb3, 0x28, 0xa1, 0xb9, 0xd9, 0x27,
+	0x06, 0x1d, 0xff, 0x51, 0x86, 0x2d, 0xe5, 0x3a, 0xb7, 0xb0, 0xea, 0xff, 0x97, 0x70, 0xcf, 0xe1,
+	0xe5, 0xff, 0x38, 0xfc, 0x29, 0x3c, 0xcc, 0x24, 0x48, 0x97, 0xdc, 0x5f, 0x2f, 0x27, 0xfd, 0x5b,
+	0xdb, 0x45, 0xe0, 0xdc, 0x2c, 0xa9, 0x5d, 0xa8, 0xd2, 0x90, 0xc9, 0xf7, 0xaa, 0xb7, 0x97, 0x41,
+	0x72, 0xcf, 0x4c, 0xe7, 0x69, 0x70, 0x51, 0xf8, 0x6a, 0x43, 0x5d, 0xa1, 0xa1, 0x38, 0xe3, 0xaa,
+	0x3d, 0xa8, 0x15, 0xe6, 0xd4, 0x0b, 0xac, 0x2a, 0xb4, 0x2b, 0x3f, 0x83, 0xad, 0x20, 0x26, 0x49,
+	0x44, 0x7d, 0xd3, 0x17, 0xbd, 0xc3, 0x36, 0x35, 0xe9, 0xea, 0xee, 0x7c, 0x09, 0x1b, 0xea, 0x2e,
+	0x6a, 0x83, 0x35, 0x06, 0x07, 0x1f, 0x78, 0x85, 0x27, 0x15, 0x39, 0x6c, 0x57, 0xeb, 0x9f, 0x9e,
+	0x41, 0xe3, 0xce, 0xdb, 0x44, 0x7b, 0xf0, 0x68, 0xf4, 0xd2, 0x73, 0x7f, 0xf6, 0x27, 0xde, 0xd0,
+	0x7b, 0x35, 0xf1, 0x87, 0xa7, 0xde, 0xf9, 0x4f, 0x23, 0xa7, 0x84, 0x5a, 0xb0, 0x7b, 0x2f, 0xe0,
+	0x8e, 0x3c, 0x77, 0x78, 0xea, 0x8d, 0xbe, 0x75, 0xac, 0x56, 0xe5, 0xd7, 0xdf, 0xdb, 0xa5, 0x93,
+	0x67, 0x6f, 0xaf, 0xdb, 0xd6, 0xbb, 0xeb, 0xb6, 0xf5, 0xcf, 0x75, 0xdb, 0x7a, 0x73, 0xd3, 0x2e,
+	0xbd, 0xbb, 0x69, 0x97, 0xfe, 0xbc, 0x69, 0x97, 0x7e, 0xd9, 0x5f, 0xff, 0x2d, 0x5d, 0xdd, 0xfe,
+	0x31, 0xc9, 0x3d, 0xce, 0xa7, 0x55, 0x65, 0xc6, 0x67, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa0,
+	0xa1, 0x63, 0xb7, 0xba, 0x06, 0x00, 0x00,
 }
 
 func (m *Entry) Marshal() (dAtA []byte, err error) {
@@ -251,10 +611,112 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintEntry(dAtA, i, uint64(len(m.TxHash)))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEntry(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.FileSize != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.MirrorOf != nil {
+		{
+			size, err := m.MirrorOf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEntry(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.UpdatedTxHash) > 0 {
+		i -= len(m.UpdatedTxHash)
+		copy(dAtA[i:], m.UpdatedTxHash)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.UpdatedTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.Retraction != nil {
+		{
+			size, err := m.Retraction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.Status != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Revision != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintEntry(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.CreatedTxHash) > 0 {
+		i -= len(m.CreatedTxHash)
+		copy(dAtA[i:], m.CreatedTxHash)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.CreatedTxHash)))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -269,20 +731,6 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x82
 	}
-	if len(m.PinCount) > 0 {
-		i -= len(m.PinCount)
-		copy(dAtA[i:], m.PinCount)
-		i = encodeVarintEntry(dAtA, i, uint64(len(m.PinCount)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.Timestamp) > 0 {
-		i -= len(m.Timestamp)
-		copy(dAtA[i:], m.Timestamp)
-		i = encodeVarintEntry(dAtA, i, uint64(len(m.Timestamp)))
-		i--
-		dAtA[i] = 0x72
-	}
 	if len(m.Submitter) > 0 {
 		i -= len(m.Submitter)
 		copy(dAtA[i:], m.Submitter)
@@ -311,13 +759,6 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
-	if len(m.FileSize) > 0 {
-		i -= len(m.FileSize)
-		copy(dAtA[i:], m.FileSize)
-		i = encodeVarintEntry(dAtA, i, uint64(len(m.FileSize)))
-		i--
-		dAtA[i] = 0x4a
-	}
 	if len(m.FallbackUrl) > 0 {
 		i -= len(m.FallbackUrl)
 		copy(dAtA[i:], m.FallbackUrl)
@@ -375,19 +816,165 @@ func (m *Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEntry(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEntry(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MirrorLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Entry) Size() (n int) {
-	if m == nil {
+
+func (m *MirrorLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MirrorLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Retraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Retraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Retraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntryRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEntry(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ChangeReason) > 0 {
+		i -= len(m.ChangeReason)
+		copy(dAtA[i:], m.ChangeReason)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.ChangeReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousIpfsCid) > 0 {
+		i -= len(m.PreviousIpfsCid)
+		copy(dAtA[i:], m.PreviousIpfsCid)
+		i = encodeVarintEntry(dAtA, i, uint64(len(m.PreviousIpfsCid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintEntry(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEntry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEntry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Entry) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -423,10 +1010,6 @@ func (m *Entry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntry(uint64(l))
 	}
-	l = len(m.FileSize)
-	if l > 0 {
-		n += 1 + l + sovEntry(uint64(l))
-	}
 	l = len(m.ChecksumSha_256)
 	if l > 0 {
 		n += 1 + l + sovEntry(uint64(l))
@@ -443,22 +1026,115 @@ func (m *Entry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntry(uint64(l))
 	}
-	l = len(m.Timestamp)
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovEntry(uint64(l))
+	}
+	l = len(m.CreatedTxHash)
+	if l > 0 {
+		n += 2 + l + sovEntry(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 2 + l + sovEntry(uint64(l))
+	if m.Revision != 0 {
+		n += 2 + sovEntry(uint64(m.Revision))
+	}
+	if m.Status != 0 {
+		n += 2 + sovEntry(uint64(m.Status))
+	}
+	if m.Retraction != nil {
+		l = m.Retraction.Size()
+		n += 2 + l + sovEntry(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 2 + sovEntry(uint64(m.CreatedHeight))
+	}
+	l = len(m.UpdatedTxHash)
+	if l > 0 {
+		n += 2 + l + sovEntry(uint64(l))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 2 + sovEntry(uint64(m.UpdatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 2 + l + sovEntry(uint64(l))
+	if m.MirrorOf != nil {
+		l = m.MirrorOf.Size()
+		n += 2 + l + sovEntry(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 2 + sovEntry(uint64(m.FileSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt)
+	n += 2 + l + sovEntry(uint64(l))
+	return n
+}
+
+func (m *MirrorLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovEntry(uint64(m.EntryId))
+	}
+	return n
+}
+
+func (m *Retraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEntry(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEntry(uint64(m.Height))
+	}
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovEntry(uint64(l))
 	}
-	l = len(m.PinCount)
+	return n
+}
+
+func (m *EntryRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovEntry(uint64(m.EntryId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovEntry(uint64(m.Revision))
+	}
+	l = len(m.PreviousIpfsCid)
 	if l > 0 {
 		n += 1 + l + sovEntry(uint64(l))
 	}
-	l = len(m.Creator)
+	l = len(m.Editor)
 	if l > 0 {
-		n += 2 + l + sovEntry(uint64(l))
+		n += 1 + l + sovEntry(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEntry(uint64(m.BlockHeight))
 	}
 	l = len(m.TxHash)
 	if l > 0 {
-		n += 2 + l + sovEntry(uint64(l))
+		n += 1 + l + sovEntry(uint64(l))
 	}
+	l = len(m.ChangeReason)
+	if l > 0 {
+		n += 1 + l + sovEntry(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovEntry(uint64(l))
 	return n
 }
 
@@ -607,16 +1283,684 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthEntry
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= EntryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retraction == nil {
+				m.Retraction = &Retraction{}
+			}
+			if err := m.Retraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MirrorOf == nil {
+				m.MirrorOf = &MirrorLink{}
+			}
+			if err := m.MirrorOf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MirrorLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntry
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsCid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MirrorLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MirrorLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntry
@@ -626,27 +1970,64 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EntryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntry
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEntry
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Retraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Retraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Retraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -674,13 +2055,13 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileUrl", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntry
@@ -690,27 +2071,14 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileUrl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FallbackUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -738,45 +2106,63 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FallbackUrl = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEntry
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntry(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEntry
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntry
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntryRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntry
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileSize = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntryRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntryRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntry
@@ -786,29 +2172,16 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EntryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var stringLen uint64
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntry
@@ -818,27 +2191,14 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Revision |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Agency = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousIpfsCid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,11 +2226,11 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
+			m.PreviousIpfsCid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -898,13 +2258,13 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.Editor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntry
@@ -914,27 +2274,14 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEntry
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEntry
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Timestamp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -962,11 +2309,11 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PinCount = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -994,13 +2341,13 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ChangeReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEntry
@@ -1010,23 +2357,24 @@ func (m *Entry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEntry
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEntry
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
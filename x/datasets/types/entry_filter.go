@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"math"
+)
+
+// Validate returns an error if the creation or update height range of the
+// filter is invalid.
+func (f EntryFilter) Validate() error {
+	if f.MinCreatedHeight < 0 || f.MaxCreatedHeight < 0 {
+		return fmt.Errorf("created heights must not be negative")
+	}
+	if f.MaxCreatedHeight != 0 && f.MinCreatedHeight > f.MaxCreatedHeight {
+		return fmt.Errorf("min_created_height %d is greater than max_created_height %d", f.MinCreatedHeight, f.MaxCreatedHeight)
+	}
+	if f.MinUpdatedHeight < 0 || f.MaxUpdatedHeight < 0 {
+		return fmt.Errorf("updated heights must not be negative")
+	}
+	if f.MaxUpdatedHeight != 0 && f.MinUpdatedHeight > f.MaxUpdatedHeight {
+		return fmt.Errorf("min_updated_height %d is greater than max_updated_height %d", f.MinUpdatedHeight, f.MaxUpdatedHeight)
+	}
+	return nil
+}
+
+// CreatedHeightRange returns the inclusive bounds of the creation heights
+// matched by the filter.
+func (f EntryFilter) CreatedHeightRange() (minHeight, maxHeight int64) {
+	maxHeight = f.MaxCreatedHeight
+	if maxHeight == 0 {
+		maxHeight = math.MaxInt64
+	}
+	return f.MinCreatedHeight, maxHeight
+}
+
+// UpdatedHeightRange returns the inclusive bounds of the heights of the last
+// modification matched by the filter.
+func (f EntryFilter) UpdatedHeightRange() (minHeight, maxHeight int64) {
+	maxHeight = f.MaxUpdatedHeight
+	if maxHeight == 0 {
+		maxHeight = math.MaxInt64
+	}
+	return f.MinUpdatedHeight, maxHeight
+}
+
+// Matches reports whether entry meets every criterion of the filter.
+func (f EntryFilter) Matches(entry Entry) bool {
+	minHeight, maxHeight := f.CreatedHeightRange()
+	minUpdated, maxUpdated := f.UpdatedHeightRange()
+	return (f.Agency == "" || entry.Agency == f.Agency) &&
+		(f.Category == "" || entry.Category == f.Category) &&
+		(f.MimeType == "" || entry.MimeType == f.MimeType) &&
+		entry.CreatedHeight >= minHeight && entry.CreatedHeight <= maxHeight &&
+		entry.UpdatedHeight >= minUpdated && entry.UpdatedHeight <= maxUpdated
+}
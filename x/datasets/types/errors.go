@@ -8,5 +8,40 @@ import (
 
 // x/datasets module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner         = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidTitle          = errors.Register(ModuleName, 1101, "invalid title")
+	ErrInvalidDescription    = errors.Register(ModuleName, 1102, "invalid description")
+	ErrInvalidCid            = errors.Register(ModuleName, 1103, "invalid IPFS CID")
+	ErrInvalidMimeType       = errors.Register(ModuleName, 1104, "invalid MIME type")
+	ErrInvalidFileName       = errors.Register(ModuleName, 1105, "invalid file name")
+	ErrInvalidURL            = errors.Register(ModuleName, 1106, "invalid URL")
+	ErrInvalidChecksum       = errors.Register(ModuleName, 1107, "invalid SHA-256 checksum")
+	ErrInvalidAgency         = errors.Register(ModuleName, 1108, "invalid agency")
+	ErrInvalidCategory       = errors.Register(ModuleName, 1109, "invalid category")
+	ErrInvalidSubmitter      = errors.Register(ModuleName, 1110, "invalid submitter")
+	ErrInvalidPublishedAt    = errors.Register(ModuleName, 1111, "invalid publication time")
+	ErrFileTooLarge          = errors.Register(ModuleName, 1112, "file size exceeds the maximum allowed")
+	ErrMimeTypeNotAllowed    = errors.Register(ModuleName, 1113, "mime type not allowed")
+	ErrCategoryNotAllowed    = errors.Register(ModuleName, 1114, "category not allowed")
+	ErrMissingFallbackURL    = errors.Register(ModuleName, 1115, "fallback url is required")
+	ErrAgencyNotFound        = errors.Register(ModuleName, 1116, "agency not registered")
+	ErrAgencyExists          = errors.Register(ModuleName, 1117, "agency already registered")
+	ErrNotAgencyPublisher    = errors.Register(ModuleName, 1118, "signer is not an authorized publisher of the agency")
+	ErrInvalidChangeReason   = errors.Register(ModuleName, 1119, "invalid change reason")
+	ErrInvalidReason         = errors.Register(ModuleName, 1120, "invalid reason")
+	ErrEntryRetracted        = errors.Register(ModuleName, 1121, "entry is retracted")
+	ErrInvalidFieldMask      = errors.Register(ModuleName, 1122, "invalid field mask")
+	ErrImmutableField        = errors.Register(ModuleName, 1123, "immutable fields cannot be updated")
+	ErrDuplicateEntry        = errors.Register(ModuleName, 1124, "content already registered by another entry")
+	ErrInvalidMirror         = errors.Register(ModuleName, 1125, "invalid mirror link")
+	ErrInvalidInclusionProof = errors.Register(ModuleName, 1126, "invalid entry inclusion proof")
+	ErrInvalidStateProof     = errors.Register(ModuleName, 1127, "invalid entry state proof")
+	ErrChecksumMismatch      = errors.Register(ModuleName, 1128, "file checksum does not match the entry")
+	ErrInvalidPinner         = errors.Register(ModuleName, 1129, "invalid pinner")
+	ErrPinnerNotFound        = errors.Register(ModuleName, 1130, "pinner not registered")
+	ErrInvalidPinAttestation = errors.Register(ModuleName, 1131, "invalid pin attestation")
+	ErrInvalidChallenge      = errors.Register(ModuleName, 1132, "invalid retrievability challenge")
+	ErrChallengeNotFound     = errors.Register(ModuleName, 1133, "challenge not found")
+	ErrInvalidBatch          = errors.Register(ModuleName, 1134, "invalid entry batch")
+	ErrEntryMirrored         = errors.Register(ModuleName, 1135, "entry is mirrored by other entries")
 )
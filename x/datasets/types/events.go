@@ -0,0 +1,13 @@
+package types
+
+// datasets module event types
+const (
+	EventTypeMigrationInvalidValue = "entry_migration_invalid_value"
+	EventTypeMigrationMirror       = "entry_migration_mirror"
+
+	AttributeKeyEntryId  = "entry_id"
+	AttributeKeyField    = "field"
+	AttributeKeyValue    = "value"
+	AttributeKeyError    = "error"
+	AttributeKeyMirrorOf = "mirror_of"
+)
@@ -1,12 +1,26 @@
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:    DefaultParams(),
-		EntryList: []Entry{}}
+		Params:             DefaultParams(),
+		EntryList:          []Entry{},
+		AgencyList:         []Agency{},
+		EntryRevisionList:  []EntryRevision{},
+		PeriodRootList:     []PeriodRoot{},
+		PeriodLeafList:     []PeriodLeaf{},
+		PinnerList:         []Pinner{},
+		PinAttestationList: []PinAttestation{},
+		ChallengeList:      []Challenge{},
+		CurrentPeriod:      CurrentPeriod{PeriodId: 1, StartHeight: 1},
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -24,5 +38,192 @@ func (gs GenesisState) Validate() error {
 		entryIdMap[elem.Id] = true
 	}
 
+	cidMap := make(map[string]uint64)
+	checksumMap := make(map[string]uint64)
+	for _, elem := range gs.EntryList {
+		if elem.IsMirror() {
+			if !entryIdMap[elem.MirrorOf.EntryId] {
+				return fmt.Errorf("entry %d is a mirror of unknown entry %d", elem.Id, elem.MirrorOf.EntryId)
+			}
+			continue
+		}
+		if cid := NormalizeCid(elem.IpfsCid); cid != "" {
+			if id, ok := cidMap[cid]; ok {
+				return fmt.Errorf("entries %d and %d share ipfs_cid %s", id, elem.Id, cid)
+			}
+			cidMap[cid] = elem.Id
+		}
+		if checksum := NormalizeChecksum(elem.ChecksumSha_256); checksum != "" {
+			if id, ok := checksumMap[checksum]; ok {
+				return fmt.Errorf("entries %d and %d share checksum_sha_256 %s", id, elem.Id, checksum)
+			}
+			checksumMap[checksum] = elem.Id
+		}
+	}
+
+	agencyIdMap := make(map[string]bool)
+	for _, elem := range gs.AgencyList {
+		if _, ok := agencyIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for agency")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		agencyIdMap[elem.Id] = true
+	}
+
+	revisionMap := make(map[[2]uint64]bool)
+	for _, elem := range gs.EntryRevisionList {
+		key := [2]uint64{elem.EntryId, elem.Revision}
+		if _, ok := revisionMap[key]; ok {
+			return fmt.Errorf("duplicated revision %d for entry %d", elem.Revision, elem.EntryId)
+		}
+		if elem.Revision == 0 {
+			return fmt.Errorf("revision of entry %d should be greater than zero", elem.EntryId)
+		}
+		if elem.Entry.Id != elem.EntryId || elem.Entry.Revision != elem.Revision {
+			return fmt.Errorf("revision %d of entry %d does not match its entry snapshot", elem.Revision, elem.EntryId)
+		}
+		revisionMap[key] = true
+	}
+
+	if err := gs.validatePeriods(); err != nil {
+		return err
+	}
+
+	if err := gs.validatePinning(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
+
+// validatePeriods checks that the roots of the closed periods match their
+// leaves and precede the current period.
+func (gs GenesisState) validatePeriods() error {
+	if gs.CurrentPeriod.PeriodId == 0 {
+		return fmt.Errorf("current period id should be greater than zero")
+	}
+
+	roots := make(map[uint64]PeriodRoot)
+	for _, elem := range gs.PeriodRootList {
+		if _, ok := roots[elem.PeriodId]; ok {
+			return fmt.Errorf("duplicated root for period %d", elem.PeriodId)
+		}
+		if elem.PeriodId == 0 || elem.PeriodId >= gs.CurrentPeriod.PeriodId {
+			return fmt.Errorf("period %d should be between 1 and the current period %d", elem.PeriodId, gs.CurrentPeriod.PeriodId)
+		}
+		roots[elem.PeriodId] = elem
+	}
+
+	leaves := make(map[uint64][]PeriodLeaf)
+	for _, elem := range gs.PeriodLeafList {
+		if _, ok := roots[elem.PeriodId]; !ok {
+			return fmt.Errorf("leaf of entry %d belongs to unknown period %d", elem.EntryId, elem.PeriodId)
+		}
+		leaves[elem.PeriodId] = append(leaves[elem.PeriodId], elem)
+	}
+	for id, root := range roots {
+		periodLeaves := leaves[id]
+		slices.SortFunc(periodLeaves, func(a, b PeriodLeaf) int { return cmp.Compare(a.EntryId, b.EntryId) })
+		for i := 1; i < len(periodLeaves); i++ {
+			if periodLeaves[i].EntryId == periodLeaves[i-1].EntryId {
+				return fmt.Errorf("duplicated leaf of entry %d in period %d", periodLeaves[i].EntryId, id)
+			}
+		}
+		if uint64(len(periodLeaves)) != root.EntryCount || !bytes.Equal(PeriodMerkleRoot(periodLeaves), root.Root) {
+			return fmt.Errorf("root of period %d does not match its leaves", id)
+		}
+	}
+
+	changes := make(map[uint64]bool)
+	for _, id := range gs.PeriodChanges {
+		if changes[id] {
+			return fmt.Errorf("duplicated period change of entry %d", id)
+		}
+		changes[id] = true
+	}
+	return nil
+}
+
+// validatePinning checks that the allowed pinners are set, not duplicated and
+// within the max_allowed_pinners param, that every pin attestation is made by
+// a registered pinner for the content of an existing active entry, and that
+// every challenge is open for a registered pinner.
+func (gs GenesisState) validatePinning() error {
+	allowed := make(map[string]bool, len(gs.AllowedPinners))
+	for _, address := range gs.AllowedPinners {
+		if address == "" {
+			return fmt.Errorf("empty allowed pinner address")
+		}
+		if allowed[address] {
+			return fmt.Errorf("duplicated allowed pinner %s", address)
+		}
+		allowed[address] = true
+	}
+	if len(gs.AllowedPinners) > int(gs.Params.MaxAllowedPinners) {
+		return fmt.Errorf("%d allowed pinners exceed the maximum of %d", len(gs.AllowedPinners), gs.Params.MaxAllowedPinners)
+	}
+
+	pinners := make(map[string]bool)
+	for _, elem := range gs.PinnerList {
+		if pinners[elem.Address] {
+			return fmt.Errorf("duplicated pinner %s", elem.Address)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		pinners[elem.Address] = true
+	}
+
+	entries := make(map[uint64]Entry, len(gs.EntryList))
+	for _, elem := range gs.EntryList {
+		entries[elem.Id] = elem
+	}
+	attested := make(map[uint64][]string)
+	for _, elem := range gs.PinAttestationList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if slices.Contains(attested[elem.EntryId], elem.Pinner) {
+			return fmt.Errorf("duplicated attestation of entry %d by %s", elem.EntryId, elem.Pinner)
+		}
+		if !pinners[elem.Pinner] {
+			return fmt.Errorf("attestation of entry %d by unregistered pinner %s", elem.EntryId, elem.Pinner)
+		}
+		entry, ok := entries[elem.EntryId]
+		if !ok {
+			return fmt.Errorf("attestation by %s of unknown entry %d", elem.Pinner, elem.EntryId)
+		}
+		if NormalizeCid(elem.Cid) != NormalizeCid(entry.IpfsCid) {
+			return fmt.Errorf("attestation of entry %d by %s is for cid %s, not the entry cid", elem.EntryId, elem.Pinner, elem.Cid)
+		}
+		if entry.IsRetracted() {
+			return fmt.Errorf("attestation by %s of retracted entry %d", elem.Pinner, elem.EntryId)
+		}
+		attested[elem.EntryId] = append(attested[elem.EntryId], elem.Pinner)
+	}
+
+	challengeIds := make(map[uint64]bool)
+	challenged := make(map[uint64][]string)
+	for _, elem := range gs.ChallengeList {
+		if challengeIds[elem.Id] {
+			return fmt.Errorf("duplicated id for challenge %d", elem.Id)
+		}
+		if elem.Id >= gs.ChallengeCount {
+			return fmt.Errorf("challenge id should be lower or equal than the last id")
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if !pinners[elem.Pinner] {
+			return fmt.Errorf("challenge %d of unregistered pinner %s", elem.Id, elem.Pinner)
+		}
+		if slices.Contains(challenged[elem.EntryId], elem.Pinner) {
+			return fmt.Errorf("duplicated challenge of %s for entry %d", elem.Pinner, elem.EntryId)
+		}
+		challengeIds[elem.Id] = true
+		challenged[elem.EntryId] = append(challenged[elem.EntryId], elem.Pinner)
+	}
+	return nil
+}
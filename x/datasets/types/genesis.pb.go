@@ -27,9 +27,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the datasets module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params     Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	EntryList  []Entry `protobuf:"bytes,2,rep,name=entry_list,json=entryList,proto3" json:"entry_list"`
-	EntryCount uint64  `protobuf:"varint,3,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	EntryList         []Entry         `protobuf:"bytes,2,rep,name=entry_list,json=entryList,proto3" json:"entry_list"`
+	EntryCount        uint64          `protobuf:"varint,3,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	AgencyList        []Agency        `protobuf:"bytes,4,rep,name=agency_list,json=agencyList,proto3" json:"agency_list"`
+	EntryRevisionList []EntryRevision `protobuf:"bytes,5,rep,name=entry_revision_list,json=entryRevisionList,proto3" json:"entry_revision_list"`
+	PeriodRootList    []PeriodRoot    `protobuf:"bytes,6,rep,name=period_root_list,json=periodRootList,proto3" json:"period_root_list"`
+	PeriodLeafList    []PeriodLeaf    `protobuf:"bytes,7,rep,name=period_leaf_list,json=periodLeafList,proto3" json:"period_leaf_list"`
+	CurrentPeriod     CurrentPeriod   `protobuf:"bytes,8,opt,name=current_period,json=currentPeriod,proto3" json:"current_period"`
+	// period_changes lists the ids of the entries changed during the current
+	// period.
+	PeriodChanges      []uint64         `protobuf:"varint,9,rep,packed,name=period_changes,json=periodChanges,proto3" json:"period_changes,omitempty"`
+	PinnerList         []Pinner         `protobuf:"bytes,10,rep,name=pinner_list,json=pinnerList,proto3" json:"pinner_list"`
+	PinAttestationList []PinAttestation `protobuf:"bytes,11,rep,name=pin_attestation_list,json=pinAttestationList,proto3" json:"pin_attestation_list"`
+	// challenge_list lists the open challenges.
+	ChallengeList  []Challenge `protobuf:"bytes,12,rep,name=challenge_list,json=challengeList,proto3" json:"challenge_list"`
+	ChallengeCount uint64      `protobuf:"varint,13,opt,name=challenge_count,json=challengeCount,proto3" json:"challenge_count,omitempty"`
+	// allowed_pinners lists the addresses allowed to register as pinners and
+	// to attest to pins.
+	AllowedPinners []string `protobuf:"bytes,14,rep,name=allowed_pinners,json=allowedPinners,proto3" json:"allowed_pinners,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +102,83 @@ func (m *GenesisState) GetEntryCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetAgencyList() []Agency {
+	if m != nil {
+		return m.AgencyList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntryRevisionList() []EntryRevision {
+	if m != nil {
+		return m.EntryRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodRootList() []PeriodRoot {
+	if m != nil {
+		return m.PeriodRootList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodLeafList() []PeriodLeaf {
+	if m != nil {
+		return m.PeriodLeafList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCurrentPeriod() CurrentPeriod {
+	if m != nil {
+		return m.CurrentPeriod
+	}
+	return CurrentPeriod{}
+}
+
+func (m *GenesisState) GetPeriodChanges() []uint64 {
+	if m != nil {
+		return m.PeriodChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPinnerList() []Pinner {
+	if m != nil {
+		return m.PinnerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPinAttestationList() []PinAttestation {
+	if m != nil {
+		return m.PinAttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeList() []Challenge {
+	if m != nil {
+		return m.ChallengeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeCount() uint64 {
+	if m != nil {
+		return m.ChallengeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAllowedPinners() []string {
+	if m != nil {
+		return m.AllowedPinners
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "govchain.datasets.v1.GenesisState")
 }
@@ -95,24 +188,42 @@ func init() {
 }
 
 var fileDescriptor_e539b56eefb36149 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xcf, 0x2f, 0x4b,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0x2c, 0x49, 0x2c, 0x4e, 0x2d, 0x29, 0xd6, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xa9, 0xd1, 0x83, 0xa9, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7,
-	0x07, 0x93, 0x10, 0x85, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15,
-	0x55, 0xc0, 0x6a, 0x45, 0x6a, 0x5e, 0x49, 0x51, 0x25, 0x54, 0x85, 0x22, 0x56, 0x15, 0x05, 0x89,
-	0x45, 0x89, 0xb9, 0x50, 0x37, 0x28, 0x6d, 0x62, 0xe4, 0xe2, 0x71, 0x87, 0xb8, 0x2a, 0xb8, 0x24,
-	0xb1, 0x24, 0x55, 0xc8, 0x9e, 0x8b, 0x0d, 0xa2, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
-	0x46, 0x0f, 0x9b, 0x2b, 0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1,
-	0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0x9b, 0x90, 0x03, 0x17, 0x17, 0xd8, 0x0d, 0xf1, 0x39, 0x99,
-	0xc5, 0x25, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xd2, 0xd8, 0x0d, 0x71, 0x05, 0xa9, 0x73,
-	0x62, 0x01, 0x99, 0x11, 0xc4, 0x09, 0xd6, 0xe4, 0x93, 0x59, 0x5c, 0x22, 0x24, 0xcf, 0xc5, 0x0d,
-	0x31, 0x21, 0x39, 0xbf, 0x34, 0xaf, 0x44, 0x82, 0x59, 0x81, 0x51, 0x83, 0x25, 0x08, 0x62, 0xa8,
-	0x33, 0x48, 0xc4, 0xc9, 0xf8, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92,
-	0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x24,
-	0xe1, 0x3e, 0xae, 0x40, 0xf8, 0xb9, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x61, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7d, 0xb2, 0xfb, 0x43, 0x9a, 0x01, 0x00, 0x00,
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x12, 0x52, 0xb2, 0x69, 0x52, 0x6a, 0x72, 0x30, 0x05, 0x39, 0xa6, 0x05, 0x35,
+	0xe2, 0x60, 0xab, 0xe9, 0x03, 0x40, 0x13, 0x21, 0x2e, 0x39, 0x44, 0xe6, 0x04, 0x42, 0xb2, 0x16,
+	0x67, 0xeb, 0xac, 0xe4, 0xee, 0x5a, 0xde, 0x6d, 0xa0, 0x6f, 0xc1, 0x63, 0x70, 0x44, 0x3c, 0x45,
+	0x8f, 0x3d, 0x72, 0x42, 0x28, 0x39, 0xf0, 0x1a, 0xc8, 0x33, 0x9b, 0xa4, 0x45, 0x4e, 0xb8, 0x44,
+	0xd6, 0x9f, 0x7f, 0xbe, 0x7f, 0x76, 0x66, 0x97, 0x1c, 0x26, 0x72, 0x16, 0x4f, 0x29, 0x17, 0xc1,
+	0x84, 0x6a, 0xaa, 0x98, 0x56, 0xc1, 0xec, 0x24, 0x48, 0x98, 0x60, 0x8a, 0x2b, 0x3f, 0xcb, 0xa5,
+	0x96, 0x76, 0x67, 0xe9, 0xf1, 0x97, 0x1e, 0x7f, 0x76, 0x72, 0xb0, 0x4f, 0x2f, 0xb8, 0x90, 0x01,
+	0xfc, 0xa2, 0xf1, 0xa0, 0x93, 0xc8, 0x44, 0xc2, 0x67, 0x50, 0x7c, 0x19, 0xf5, 0x59, 0x69, 0x04,
+	0x4d, 0x98, 0x88, 0xaf, 0xb6, 0x5a, 0x32, 0x9a, 0xd3, 0x0b, 0xb5, 0xdd, 0xc2, 0x72, 0x2e, 0x27,
+	0xc6, 0x52, 0x7e, 0x96, 0x8c, 0x0b, 0xc1, 0x45, 0x62, 0x3c, 0x5e, 0x89, 0xa7, 0x1f, 0x30, 0xa1,
+	0x73, 0xd3, 0xcb, 0xe1, 0x8f, 0x1d, 0xb2, 0xfb, 0x16, 0xcf, 0xff, 0x4e, 0x53, 0xcd, 0xec, 0x57,
+	0xa4, 0x8e, 0x9d, 0x38, 0x96, 0x67, 0xf5, 0x9a, 0xfd, 0xa7, 0x7e, 0xd9, 0x3c, 0xfc, 0x31, 0x78,
+	0x06, 0x8d, 0xeb, 0x5f, 0xdd, 0xca, 0xb7, 0x3f, 0xdf, 0x5f, 0x5a, 0xa1, 0x29, 0xb3, 0x5f, 0x13,
+	0x02, 0x01, 0x51, 0xca, 0x95, 0x76, 0xee, 0x79, 0xd5, 0x5e, 0xb3, 0xff, 0xa4, 0x0c, 0xd2, 0xf7,
+	0xdf, 0x14, 0xbe, 0x41, 0xad, 0x60, 0x84, 0x0d, 0x28, 0x1a, 0x71, 0xa5, 0xed, 0x2e, 0x69, 0x22,
+	0x21, 0x96, 0x97, 0x42, 0x3b, 0x55, 0xcf, 0xea, 0xd5, 0x42, 0x84, 0x0e, 0x0b, 0xc5, 0x1e, 0x92,
+	0x26, 0x0e, 0x14, 0x33, 0x6a, 0x90, 0xb1, 0xa1, 0xd1, 0x33, 0x30, 0x9a, 0x10, 0x82, 0x65, 0x90,
+	0xf2, 0x9e, 0x3c, 0xc2, 0x94, 0x9c, 0xcd, 0xb8, 0xe2, 0x52, 0x20, 0xec, 0x3e, 0xc0, 0x8e, 0xb6,
+	0x34, 0x1c, 0x1a, 0xbf, 0x61, 0xee, 0xb3, 0xdb, 0x22, 0xa0, 0xc7, 0xe4, 0x21, 0xae, 0x2a, 0xca,
+	0xa5, 0xd4, 0xc8, 0xad, 0x03, 0xd7, 0xdb, 0x30, 0x4d, 0x70, 0x87, 0x52, 0x6a, 0x03, 0x6d, 0x67,
+	0x2b, 0xe5, 0x1f, 0x62, 0xca, 0xe8, 0x39, 0x12, 0x77, 0xfe, 0x4f, 0x1c, 0x31, 0x7a, 0x7e, 0x97,
+	0x58, 0x28, 0x86, 0xd8, 0x8e, 0x2f, 0xf3, 0x9c, 0x09, 0x1d, 0xe1, 0x3f, 0xce, 0x03, 0xd8, 0xf7,
+	0x51, 0x39, 0x6f, 0x88, 0x5e, 0xc4, 0x1a, 0x64, 0x2b, 0xbe, 0x2d, 0xda, 0x2f, 0x88, 0xc9, 0x88,
+	0xe2, 0x29, 0x15, 0x09, 0x53, 0x4e, 0xc3, 0xab, 0xf6, 0x6a, 0x61, 0x0b, 0xd5, 0x21, 0x8a, 0xc5,
+	0xf2, 0x8a, 0x4b, 0xca, 0x72, 0x3c, 0x05, 0xd9, 0xb6, 0xbc, 0x31, 0x18, 0x97, 0xcb, 0xc3, 0x32,
+	0xe8, 0xfe, 0x23, 0xe9, 0x64, 0x5c, 0x44, 0x54, 0x6b, 0xa6, 0x34, 0xd5, 0xab, 0xed, 0x35, 0x81,
+	0xf6, 0x7c, 0x23, 0xed, 0x6c, 0x5d, 0x60, 0xa8, 0x76, 0x76, 0x47, 0x05, 0xfa, 0x88, 0xb4, 0xe3,
+	0x29, 0x4d, 0x53, 0x26, 0x12, 0x86, 0xdc, 0x5d, 0xe0, 0x76, 0x37, 0xcc, 0x66, 0xe9, 0x5d, 0xcd,
+	0x65, 0x29, 0x00, 0xed, 0x98, 0xec, 0xad, 0x69, 0x78, 0xa5, 0x5b, 0x70, 0xa5, 0xd7, 0x21, 0x78,
+	0xad, 0x8f, 0xc9, 0x1e, 0x4d, 0x53, 0xf9, 0x99, 0x4d, 0x22, 0x3c, 0xaa, 0x72, 0xda, 0x5e, 0xb5,
+	0xd7, 0x08, 0xdb, 0x46, 0xc6, 0x71, 0xa8, 0xc1, 0xe9, 0xf5, 0xdc, 0xb5, 0x6e, 0xe6, 0xae, 0xf5,
+	0x7b, 0xee, 0x5a, 0x5f, 0x17, 0x6e, 0xe5, 0x66, 0xe1, 0x56, 0x7e, 0x2e, 0xdc, 0xca, 0x87, 0xc7,
+	0xab, 0x07, 0xff, 0x65, 0xfd, 0xe4, 0xf5, 0x55, 0xc6, 0xd4, 0xa7, 0x3a, 0x3c, 0xf8, 0xd3, 0xbf,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xc8, 0xb1, 0x19, 0x04, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +246,146 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedPinners) > 0 {
+		for iNdEx := len(m.AllowedPinners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPinners[iNdEx])
+			copy(dAtA[i:], m.AllowedPinners[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedPinners[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ChallengeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChallengeCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ChallengeList) > 0 {
+		for iNdEx := len(m.ChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PinAttestationList) > 0 {
+		for iNdEx := len(m.PinAttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinAttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PinnerList) > 0 {
+		for iNdEx := len(m.PinnerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PinnerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PeriodChanges) > 0 {
+		dAtA2 := make([]byte, len(m.PeriodChanges)*10)
+		var j1 int
+		for _, num := range m.PeriodChanges {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.CurrentPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.PeriodLeafList) > 0 {
+		for iNdEx := len(m.PeriodLeafList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodLeafList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PeriodRootList) > 0 {
+		for iNdEx := len(m.PeriodRootList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodRootList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EntryRevisionList) > 0 {
+		for iNdEx := len(m.EntryRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AgencyList) > 0 {
+		for iNdEx := len(m.AgencyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AgencyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.EntryCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.EntryCount))
 		i--
@@ -195,6 +446,66 @@ func (m *GenesisState) Size() (n int) {
 	if m.EntryCount != 0 {
 		n += 1 + sovGenesis(uint64(m.EntryCount))
 	}
+	if len(m.AgencyList) > 0 {
+		for _, e := range m.AgencyList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EntryRevisionList) > 0 {
+		for _, e := range m.EntryRevisionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeriodRootList) > 0 {
+		for _, e := range m.PeriodRootList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeriodLeafList) > 0 {
+		for _, e := range m.PeriodLeafList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CurrentPeriod.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PeriodChanges) > 0 {
+		l = 0
+		for _, e := range m.PeriodChanges {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.PinnerList) > 0 {
+		for _, e := range m.PinnerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PinAttestationList) > 0 {
+		for _, e := range m.PinAttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChallengeList) > 0 {
+		for _, e := range m.ChallengeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ChallengeCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ChallengeCount))
+	}
+	if len(m.AllowedPinners) > 0 {
+		for _, s := range m.AllowedPinners {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -319,6 +630,404 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgencyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgencyList = append(m.AgencyList, Agency{})
+			if err := m.AgencyList[len(m.AgencyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryRevisionList = append(m.EntryRevisionList, EntryRevision{})
+			if err := m.EntryRevisionList[len(m.EntryRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodRootList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodRootList = append(m.PeriodRootList, PeriodRoot{})
+			if err := m.PeriodRootList[len(m.PeriodRootList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodLeafList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodLeafList = append(m.PeriodLeafList, PeriodLeaf{})
+			if err := m.PeriodLeafList[len(m.PeriodLeafList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PeriodChanges = append(m.PeriodChanges, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PeriodChanges) == 0 {
+					m.PeriodChanges = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PeriodChanges = append(m.PeriodChanges, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodChanges", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinnerList = append(m.PinnerList, Pinner{})
+			if err := m.PinnerList[len(m.PinnerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinAttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinAttestationList = append(m.PinAttestationList, PinAttestation{})
+			if err := m.PinAttestationList[len(m.PinAttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeList = append(m.ChallengeList, Challenge{})
+			if err := m.ChallengeList[len(m.ChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeCount", wireType)
+			}
+			m.ChallengeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPinners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPinners = append(m.AllowedPinners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,34 @@ import (
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	leaves := []types.PeriodLeaf{
+		{PeriodId: 1, EntryId: 0, ContentHash: []byte{1}},
+		{PeriodId: 1, EntryId: 2, ContentHash: []byte{2}},
+	}
+	pinned := func(pinners []types.Pinner, atts ...types.PinAttestation) *types.GenesisState {
+		entry := types.Entry{Id: 0, IpfsCid: "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"}
+		return &types.GenesisState{
+			EntryList:          []types.Entry{entry},
+			EntryCount:         1,
+			CurrentPeriod:      types.CurrentPeriod{PeriodId: 1},
+			PinnerList:         pinners,
+			PinAttestationList: atts,
+		}
+	}
+	pinners := []types.Pinner{{Address: "pinner-a", Moniker: "alpha"}, {Address: "pinner-b", Moniker: "beta"}}
+	attestation := func(pinner, cid string) types.PinAttestation {
+		return types.PinAttestation{EntryId: 0, Pinner: pinner, Cid: cid, ProofHeight: 5, AttestedHeight: 6, ExpiresHeight: 10}
+	}
+	const cidV1 = "bafybeicg2rebjoofv4kbyovkw7af3rpiitvnl6i7ckcywaq6xjcxnc2mby"
+	challenged := func(challenges ...types.Challenge) *types.GenesisState {
+		genState := pinned(pinners)
+		genState.ChallengeList = challenges
+		genState.ChallengeCount = 2
+		return genState
+	}
+	challenge := func(id uint64, pinner string) types.Challenge {
+		return types.Challenge{Id: id, EntryId: 0, Pinner: pinner, Cid: cidV1, Offset: 3, IssuedHeight: 5, DeadlineHeight: 15}
+	}
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -21,7 +49,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{EntryList: []types.Entry{{Id: 0}, {Id: 1}}, EntryCount: 2}, valid: true,
+			genState: &types.GenesisState{EntryList: []types.Entry{{Id: 0}, {Id: 1}}, EntryCount: 2, CurrentPeriod: types.CurrentPeriod{PeriodId: 1}}, valid: true,
 		}, {
 			desc: "duplicated entry",
 			genState: &types.GenesisState{
@@ -46,6 +74,181 @@ func TestGenesisState_Validate(t *testing.T) {
 				EntryCount: 0,
 			},
 			valid: false,
+		}, {
+			desc: "duplicated agency",
+			genState: &types.GenesisState{
+				AgencyList: []types.Agency{{Id: "NOAA", Name: "NOAA"}, {Id: "NOAA", Name: "NOAA"}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated entry revision",
+			genState: &types.GenesisState{
+				EntryRevisionList: []types.EntryRevision{
+					{EntryId: 1, Revision: 1, Entry: types.Entry{Id: 1, Revision: 1}},
+					{EntryId: 1, Revision: 1, Entry: types.Entry{Id: 1, Revision: 1}},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "entry revision mismatching its snapshot",
+			genState: &types.GenesisState{
+				EntryRevisionList: []types.EntryRevision{{EntryId: 1, Revision: 2, Entry: types.Entry{Id: 1, Revision: 1}}},
+			},
+			valid: false,
+		}, {
+			desc: "entries sharing a cid",
+			genState: &types.GenesisState{
+				EntryList: []types.Entry{
+					{Id: 0, IpfsCid: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"},
+					{Id: 1, IpfsCid: "bafybeie5nqv6kd3qnfjupgvz34woh3oksc3iau6abmyajn7qvtf6d2ho34"},
+				},
+				EntryCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "entries sharing a checksum",
+			genState: &types.GenesisState{
+				EntryList:  []types.Entry{{Id: 0, ChecksumSha_256: "ABC"}, {Id: 1, ChecksumSha_256: "abc"}},
+				EntryCount: 2,
+			},
+			valid: false,
+		}, {
+			desc: "mirror sharing a checksum",
+			genState: &types.GenesisState{
+				EntryList: []types.Entry{
+					{Id: 0, ChecksumSha_256: "abc"},
+					{Id: 1, ChecksumSha_256: "abc", MirrorOf: &types.MirrorLink{EntryId: 0}},
+				},
+				EntryCount:    2,
+				CurrentPeriod: types.CurrentPeriod{PeriodId: 1},
+			},
+			valid: true,
+		}, {
+			desc: "mirror of unknown entry",
+			genState: &types.GenesisState{
+				EntryList:  []types.Entry{{Id: 0, MirrorOf: &types.MirrorLink{EntryId: 5}}},
+				EntryCount: 1,
+			},
+			valid: false,
+		}, {
+			desc: "invalid agency",
+			genState: &types.GenesisState{
+				AgencyList: []types.Agency{{Id: "NOAA"}},
+			},
+			valid: false,
+		}, {
+			desc: "closed period",
+			genState: &types.GenesisState{
+				PeriodRootList: []types.PeriodRoot{
+					{PeriodId: 1, Root: types.PeriodMerkleRoot(leaves[:2]), EntryCount: 2},
+					{PeriodId: 2, Root: types.PeriodMerkleRoot(nil)},
+				},
+				PeriodLeafList: []types.PeriodLeaf{leaves[1], leaves[0]},
+				CurrentPeriod:  types.CurrentPeriod{PeriodId: 3},
+				PeriodChanges:  []uint64{1, 3},
+			},
+			valid: true,
+		}, {
+			desc:     "no current period",
+			genState: &types.GenesisState{},
+			valid:    false,
+		}, {
+			desc: "period root not matching its leaves",
+			genState: &types.GenesisState{
+				PeriodRootList: []types.PeriodRoot{{PeriodId: 1, Root: types.PeriodMerkleRoot(leaves[:1]), EntryCount: 2}},
+				PeriodLeafList: leaves[:2],
+				CurrentPeriod:  types.CurrentPeriod{PeriodId: 2},
+			},
+			valid: false,
+		}, {
+			desc: "period root of the current period",
+			genState: &types.GenesisState{
+				PeriodRootList: []types.PeriodRoot{{PeriodId: 1, Root: types.PeriodMerkleRoot(nil)}},
+				CurrentPeriod:  types.CurrentPeriod{PeriodId: 1},
+			},
+			valid: false,
+		}, {
+			desc: "leaf of unknown period",
+			genState: &types.GenesisState{
+				PeriodLeafList: leaves[:1],
+				CurrentPeriod:  types.CurrentPeriod{PeriodId: 2},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated period change",
+			genState: &types.GenesisState{
+				CurrentPeriod: types.CurrentPeriod{PeriodId: 1},
+				PeriodChanges: []uint64{4, 4},
+			},
+			valid: false,
+		}, {
+			desc:     "allowed pinners",
+			genState: &types.GenesisState{Params: types.Params{MaxAllowedPinners: 2}, AllowedPinners: []string{"pinner-a", "pinner-b"}, CurrentPeriod: types.CurrentPeriod{PeriodId: 1}},
+			valid:    true,
+		}, {
+			desc:     "duplicated allowed pinner",
+			genState: &types.GenesisState{Params: types.Params{MaxAllowedPinners: 2}, AllowedPinners: []string{"pinner-a", "pinner-a"}, CurrentPeriod: types.CurrentPeriod{PeriodId: 1}},
+			valid:    false,
+		}, {
+			desc:     "too many allowed pinners",
+			genState: &types.GenesisState{Params: types.Params{MaxAllowedPinners: 1}, AllowedPinners: []string{"pinner-a", "pinner-b"}, CurrentPeriod: types.CurrentPeriod{PeriodId: 1}},
+			valid:    false,
+		}, {
+			desc:     "pin attestations",
+			genState: pinned(pinners, attestation("pinner-a", cidV1), attestation("pinner-b", cidV1)),
+			valid:    true,
+		}, {
+			desc:     "duplicated pinner",
+			genState: pinned(append(pinners, pinners[0])),
+			valid:    false,
+		}, {
+			desc:     "pinner without moniker",
+			genState: pinned([]types.Pinner{{Address: "pinner-a"}}),
+			valid:    false,
+		}, {
+			desc:     "attestation by unregistered pinner",
+			genState: pinned(pinners[:1], attestation("pinner-b", cidV1)),
+			valid:    false,
+		}, {
+			desc:     "attestation of other content",
+			genState: pinned(pinners, attestation("pinner-a", "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH")),
+			valid:    false,
+		}, {
+			desc:     "duplicated attestation",
+			genState: pinned(pinners, attestation("pinner-a", cidV1), attestation("pinner-a", cidV1)),
+			valid:    false,
+		}, {
+			desc: "attestation of a retracted entry",
+			genState: func() *types.GenesisState {
+				genState := pinned(pinners, attestation("pinner-a", cidV1))
+				genState.EntryList[0].Status = types.ENTRY_STATUS_RETRACTED
+				return genState
+			}(),
+			valid: false,
+		}, {
+			desc:     "challenges",
+			genState: challenged(challenge(0, "pinner-a"), challenge(1, "pinner-b")),
+			valid:    true,
+		}, {
+			desc:     "duplicated challenge id",
+			genState: challenged(challenge(0, "pinner-a"), challenge(0, "pinner-b")),
+			valid:    false,
+		}, {
+			desc:     "challenge id above the count",
+			genState: challenged(challenge(2, "pinner-a")),
+			valid:    false,
+		}, {
+			desc:     "challenge of unregistered pinner",
+			genState: challenged(challenge(0, "pinner-c")),
+			valid:    false,
+		}, {
+			desc:     "duplicated challenge of a pinner for an entry",
+			genState: challenged(challenge(0, "pinner-a"), challenge(1, "pinner-a")),
+			valid:    false,
+		}, {
+			desc:     "challenge with inconsistent heights",
+			genState: challenged(types.Challenge{Id: 0, Pinner: "pinner-a", Cid: cidV1, IssuedHeight: 5, DeadlineHeight: 4}),
+			valid:    false,
 		},
 	}
 	for _, tc := range tests {
@@ -21,4 +21,42 @@ var ParamsKey = collections.NewPrefix("p_datasets")
 var (
 	EntryKey      = collections.NewPrefix("entry/value/")
 	EntryCountKey = collections.NewPrefix("entry/count/")
+
+	EntryAgencyIndexKey   = collections.NewPrefix("entry/index/agency/")
+	EntryCategoryIndexKey = collections.NewPrefix("entry/index/category/")
+	EntryMimeTypeIndexKey = collections.NewPrefix("entry/index/mime_type/")
+
+	EntryCreatedHeightIndexKey = collections.NewPrefix("entry/index/created_height/")
+	EntryFileSizeIndexKey      = collections.NewPrefix("entry/index/file_size/")
+	EntryUpdatedHeightIndexKey = collections.NewPrefix("entry/index/updated_height/")
+
+	EntryRevisionKey = collections.NewPrefix("entry/revision/")
+
+	EntrySearchIndexKey = collections.NewPrefix("entry/index/search/")
+
+	EntryCidIndexKey      = collections.NewPrefix("entry/index/cid/")
+	EntryChecksumIndexKey = collections.NewPrefix("entry/index/checksum/")
+	EntryMirrorIndexKey   = collections.NewPrefix("entry/index/mirror/")
+
+	EntryTotalsKey = collections.NewPrefix("entry/stats/totals/")
+	EntryStatsKey  = collections.NewPrefix("entry/stats/bucket/")
+
+	AgencyKey = collections.NewPrefix("agency/value/")
+
+	PeriodRootKey    = collections.NewPrefix("period/root/")
+	PeriodLeafKey    = collections.NewPrefix("period/leaf/")
+	CurrentPeriodKey = collections.NewPrefix("period/current/")
+	PeriodChangesKey = collections.NewPrefix("period/changes/")
+
+	PinnerKey         = collections.NewPrefix("pinner/value/")
+	AllowedPinnersKey = collections.NewPrefix("pinner/allowed/")
+	PinAttestationKey = collections.NewPrefix("pin/attestation/")
+	PinExpiryQueueKey = collections.NewPrefix("pin/expiry/")
+	PinCountKey       = collections.NewPrefix("pin/count/")
+
+	ChallengeKey              = collections.NewPrefix("challenge/value/")
+	ChallengeCountKey         = collections.NewPrefix("challenge/count/")
+	ChallengeDeadlineQueueKey = collections.NewPrefix("challenge/deadline/")
+	PinnerChallengesKey       = collections.NewPrefix("challenge/pinner/")
+	ChallengeEntryIndexKey    = collections.NewPrefix("challenge/index/entry/")
 )
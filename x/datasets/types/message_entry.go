@@ -0,0 +1,216 @@
+package types
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.HasValidateBasic = (*MsgCreateEntry)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateEntry)(nil)
+	_ sdk.HasValidateBasic = (*MsgDeleteEntry)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateEntriesBatch)(nil)
+)
+
+// ValidateBasic performs the stateless validation of MsgCreateEntry.
+func (msg *MsgCreateEntry) ValidateBasic() error {
+	return validateEntryFields(
+		func(string) bool { return true },
+		msg.Title, msg.Description, msg.IpfsCid, msg.MimeType, msg.FileName, msg.FileUrl,
+		msg.FallbackUrl, msg.ChecksumSha_256, msg.Agency, msg.Category, msg.Submitter,
+	)
+}
+
+// ValidateBasic performs the stateless validation of MsgUpdateEntry. Only the
+// fields selected by the update mask are checked: the others keep the value
+// they were validated with.
+func (msg *MsgUpdateEntry) ValidateBasic() error {
+	paths, err := msg.UpdatePaths()
+	if err != nil {
+		return err
+	}
+	if err := validateEntryFields(
+		func(field string) bool { return slices.Contains(paths, field) },
+		msg.Title, msg.Description, msg.IpfsCid, msg.MimeType, msg.FileName, msg.FileUrl,
+		msg.FallbackUrl, msg.ChecksumSha_256, msg.Agency, msg.Category, msg.Submitter,
+	); err != nil {
+		return err
+	}
+	return validateText(ErrInvalidChangeReason, "change reason", msg.ChangeReason, MaxChangeReasonLength, false)
+}
+
+// UpdatePaths returns the entry fields replaced by the update. They are the
+// paths of the update mask or, when the mask is empty, the fields set to a
+// non-default value in the message. An error names the paths that are
+// unknown or immutable.
+func (msg *MsgUpdateEntry) UpdatePaths() ([]string, error) {
+	if len(msg.GetUpdateMask().GetPaths()) == 0 {
+		return msg.populatedFields(), nil
+	}
+
+	var (
+		paths              []string
+		unknown, immutable []string
+	)
+	for _, path := range msg.UpdateMask.Paths {
+		switch {
+		case isMutableEntryField(path):
+			if !slices.Contains(paths, path) {
+				paths = append(paths, path)
+			}
+		case slices.Contains(ImmutableEntryFields, path):
+			immutable = append(immutable, path)
+		default:
+			unknown = append(unknown, path)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, errorsmod.Wrapf(ErrInvalidFieldMask, "unknown fields: %s", strings.Join(unknown, ", "))
+	}
+	if len(immutable) > 0 {
+		return nil, errorsmod.Wrapf(ErrImmutableField, "%s", strings.Join(immutable, ", "))
+	}
+	return paths, nil
+}
+
+// populatedFields returns the mutable entry fields set to a non-default value
+// in the message.
+func (msg *MsgUpdateEntry) populatedFields() []string {
+	var fields []string
+	for _, field := range MutableEntryFields {
+		if !msg.entry().fieldEqual(field, Entry{}) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// ApplyTo returns entry with the given fields replaced by the values of the
+// message.
+func (msg *MsgUpdateEntry) ApplyTo(entry Entry, paths []string) Entry {
+	update := msg.entry()
+	for _, field := range paths {
+		switch field {
+		case FieldTitle:
+			entry.Title = update.Title
+		case FieldDescription:
+			entry.Description = update.Description
+		case FieldIpfsCid:
+			entry.IpfsCid = update.IpfsCid
+		case FieldMimeType:
+			entry.MimeType = update.MimeType
+		case FieldFileName:
+			entry.FileName = update.FileName
+		case FieldFileUrl:
+			entry.FileUrl = update.FileUrl
+		case FieldFallbackUrl:
+			entry.FallbackUrl = update.FallbackUrl
+		case FieldFileSize:
+			entry.FileSize = update.FileSize
+		case FieldChecksumSha256:
+			entry.ChecksumSha_256 = update.ChecksumSha_256
+		case FieldAgency:
+			entry.Agency = update.Agency
+		case FieldCategory:
+			entry.Category = update.Category
+		case FieldSubmitter:
+			entry.Submitter = update.Submitter
+		case FieldPublishedAt:
+			entry.PublishedAt = update.PublishedAt
+		}
+	}
+	return entry
+}
+
+// entry returns the metadata carried by the message as an entry.
+func (msg *MsgUpdateEntry) entry() Entry {
+	return Entry{
+		Title:           msg.Title,
+		Description:     msg.Description,
+		IpfsCid:         msg.IpfsCid,
+		MimeType:        msg.MimeType,
+		FileName:        msg.FileName,
+		FileUrl:         msg.FileUrl,
+		FallbackUrl:     msg.FallbackUrl,
+		FileSize:        msg.FileSize,
+		ChecksumSha_256: msg.ChecksumSha_256,
+		Agency:          msg.Agency,
+		Category:        msg.Category,
+		Submitter:       msg.Submitter,
+		PublishedAt:     msg.PublishedAt,
+	}
+}
+
+// ValidateBasic performs the stateless validation of MsgDeleteEntry.
+func (msg *MsgDeleteEntry) ValidateBasic() error {
+	return ValidateReason(msg.Reason)
+}
+
+// ValidateBasic performs the stateless validation of MsgCreateEntriesBatch:
+// the batch is not empty, within the hard limit of MaxBatchEntries, and each
+// of its entries passes the validation of MsgCreateEntry.
+func (msg *MsgCreateEntriesBatch) ValidateBasic() error {
+	if len(msg.Entries) == 0 {
+		return errorsmod.Wrap(ErrInvalidBatch, "no entries")
+	}
+	if len(msg.Entries) > MaxBatchEntries {
+		return errorsmod.Wrapf(ErrInvalidBatch, "%d entries exceed the maximum of %d", len(msg.Entries), MaxBatchEntries)
+	}
+	for i, entry := range msg.Entries {
+		if err := entry.MsgCreateEntry(msg.Creator).ValidateBasic(); err != nil {
+			return WrapBatchEntryError(err, i)
+		}
+	}
+	return nil
+}
+
+// batchEntryError matches the wrap of WrapBatchEntryError.
+var batchEntryError = regexp.MustCompile(`entry (\d+): `)
+
+// WrapBatchEntryError wraps the error of the entry at index i of a
+// MsgCreateEntriesBatch, so that clients can tell the failing entry with
+// BatchEntryIndex.
+func WrapBatchEntryError(err error, i int) error {
+	return errorsmod.Wrapf(err, "entry %d", i)
+}
+
+// BatchEntryIndex returns the index of the failing entry named by the error of
+// a MsgCreateEntriesBatch, wrapped by WrapBatchEntryError, even once the error
+// is printed in a transaction log.
+func BatchEntryIndex(err error) (int, bool) {
+	m := batchEntryError.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0, false
+	}
+	i, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// MsgCreateEntry returns the message creating the entry on behalf of creator.
+func (e BatchEntry) MsgCreateEntry(creator string) *MsgCreateEntry {
+	return &MsgCreateEntry{
+		Creator:         creator,
+		Title:           e.Title,
+		Description:     e.Description,
+		IpfsCid:         e.IpfsCid,
+		MimeType:        e.MimeType,
+		FileName:        e.FileName,
+		FileUrl:         e.FileUrl,
+		FallbackUrl:     e.FallbackUrl,
+		FileSize:        e.FileSize,
+		ChecksumSha_256: e.ChecksumSha_256,
+		Agency:          e.Agency,
+		Category:        e.Category,
+		Submitter:       e.Submitter,
+		PublishedAt:     e.PublishedAt,
+		MirrorOf:        e.MirrorOf,
+	}
+}
@@ -0,0 +1,296 @@
+package types_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"govchain/x/datasets/types"
+)
+
+func validMsgCreateEntry() *types.MsgCreateEntry {
+	return &types.MsgCreateEntry{
+		Title:           "Climate Data 2024",
+		Description:     "Annual climate measurements",
+		IpfsCid:         "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		MimeType:        "text/csv",
+		FileName:        "climate.csv",
+		FileUrl:         "https://ipfs.io/ipfs/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		ChecksumSha_256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Agency:          "NOAA",
+		Category:        "climate",
+	}
+}
+
+func TestMsgCreateEntry_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		desc   string
+		modify func(msg *types.MsgCreateEntry)
+		err    error
+	}{
+		{
+			desc:   "valid",
+			modify: func(msg *types.MsgCreateEntry) {},
+		},
+		{
+			desc: "valid cidv1 and mime type with parameters",
+			modify: func(msg *types.MsgCreateEntry) {
+				msg.IpfsCid = "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+				msg.MimeType = "text/csv; charset=utf-8"
+				msg.FallbackUrl = "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+			},
+		},
+		{
+			desc:   "empty title",
+			modify: func(msg *types.MsgCreateEntry) { msg.Title = "  " },
+			err:    types.ErrInvalidTitle,
+		},
+		{
+			desc:   "title too long",
+			modify: func(msg *types.MsgCreateEntry) { msg.Title = strings.Repeat("a", types.MaxTitleLength+1) },
+			err:    types.ErrInvalidTitle,
+		},
+		{
+			desc:   "description too long",
+			modify: func(msg *types.MsgCreateEntry) { msg.Description = strings.Repeat("a", types.MaxDescriptionLength+1) },
+			err:    types.ErrInvalidDescription,
+		},
+		{
+			desc:   "malformed cid",
+			modify: func(msg *types.MsgCreateEntry) { msg.IpfsCid = "QmNotACid" },
+			err:    types.ErrInvalidCid,
+		},
+		{
+			desc:   "empty cid",
+			modify: func(msg *types.MsgCreateEntry) { msg.IpfsCid = "" },
+			err:    types.ErrInvalidCid,
+		},
+		{
+			desc:   "mime type without subtype",
+			modify: func(msg *types.MsgCreateEntry) { msg.MimeType = "csv" },
+			err:    types.ErrInvalidMimeType,
+		},
+		{
+			desc:   "mime type with invalid characters",
+			modify: func(msg *types.MsgCreateEntry) { msg.MimeType = "text/c*v" },
+			err:    types.ErrInvalidMimeType,
+		},
+		{
+			desc:   "file name with path",
+			modify: func(msg *types.MsgCreateEntry) { msg.FileName = "../etc/passwd" },
+			err:    types.ErrInvalidFileName,
+		},
+		{
+			desc:   "relative file url",
+			modify: func(msg *types.MsgCreateEntry) { msg.FileUrl = "/ipfs/Qm" },
+			err:    types.ErrInvalidURL,
+		},
+		{
+			desc:   "fallback url with unsupported scheme",
+			modify: func(msg *types.MsgCreateEntry) { msg.FallbackUrl = "javascript://alert(1)" },
+			err:    types.ErrInvalidURL,
+		},
+		{
+			desc:   "short checksum",
+			modify: func(msg *types.MsgCreateEntry) { msg.ChecksumSha_256 = "e3b0c442" },
+			err:    types.ErrInvalidChecksum,
+		},
+		{
+			desc:   "non hex checksum",
+			modify: func(msg *types.MsgCreateEntry) { msg.ChecksumSha_256 = strings.Repeat("z", 64) },
+			err:    types.ErrInvalidChecksum,
+		},
+		{
+			desc:   "empty agency",
+			modify: func(msg *types.MsgCreateEntry) { msg.Agency = "" },
+			err:    types.ErrInvalidAgency,
+		},
+		{
+			desc:   "category too long",
+			modify: func(msg *types.MsgCreateEntry) { msg.Category = strings.Repeat("a", types.MaxCategoryLength+1) },
+			err:    types.ErrInvalidCategory,
+		},
+		{
+			desc:   "submitter not utf8",
+			modify: func(msg *types.MsgCreateEntry) { msg.Submitter = "\xff" },
+			err:    types.ErrInvalidSubmitter,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := validMsgCreateEntry()
+			tc.modify(msg)
+			err := msg.ValidateBasic()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateEntry_ValidateBasic(t *testing.T) {
+	create := validMsgCreateEntry()
+	msg := &types.MsgUpdateEntry{
+		Id:              1,
+		Title:           create.Title,
+		IpfsCid:         create.IpfsCid,
+		MimeType:        create.MimeType,
+		ChecksumSha_256: create.ChecksumSha_256,
+		Agency:          create.Agency,
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ChangeReason = strings.Repeat("a", types.MaxChangeReasonLength+1)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidChangeReason)
+
+	msg.ChangeReason = "corrected checksum"
+	msg.ChecksumSha_256 = ""
+	require.NoError(t, msg.ValidateBasic(), "unset fields are not updated")
+
+	msg.UpdateMask = &gogotypes.FieldMask{Paths: []string{"checksum_sha_256"}}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidChecksum)
+}
+
+func TestMsgUpdateEntry_UpdatePaths(t *testing.T) {
+	tests := []struct {
+		desc  string
+		msg   *types.MsgUpdateEntry
+		paths []string
+		err   error
+		inErr string
+	}{
+		{
+			desc:  "implied by populated fields",
+			msg:   &types.MsgUpdateEntry{Title: "title", FileSize: 1, PublishedAt: time.Unix(1, 0)},
+			paths: []string{"title", "file_size", "published_at"},
+		},
+		{
+			desc:  "explicit mask clears fields",
+			msg:   &types.MsgUpdateEntry{Title: "title", UpdateMask: &gogotypes.FieldMask{Paths: []string{"fallback_url", "title", "title"}}},
+			paths: []string{"fallback_url", "title"},
+		},
+		{
+			desc:  "unknown field",
+			msg:   &types.MsgUpdateEntry{UpdateMask: &gogotypes.FieldMask{Paths: []string{"title", "color"}}},
+			err:   types.ErrInvalidFieldMask,
+			inErr: "color",
+		},
+		{
+			desc:  "immutable fields",
+			msg:   &types.MsgUpdateEntry{UpdateMask: &gogotypes.FieldMask{Paths: []string{"creator", "title", "created_at"}}},
+			err:   types.ErrImmutableField,
+			inErr: "creator, created_at",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			paths, err := tc.msg.UpdatePaths()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.ErrorContains(t, err, tc.inErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.paths, paths)
+			}
+		})
+	}
+}
+
+func TestMsgCreateEntry_LegacyFields(t *testing.T) {
+	// a MsgCreateEntry of a legacy client, with file_size and timestamp as
+	// strings under their former numbers
+	var bz []byte
+	bz = protowire.AppendTag(bz, 2, protowire.BytesType)
+	bz = protowire.AppendString(bz, "Climate Data 2024")
+	bz = protowire.AppendTag(bz, 9, protowire.BytesType)
+	bz = protowire.AppendString(bz, "1024")
+	bz = protowire.AppendTag(bz, 14, protowire.BytesType)
+	bz = protowire.AppendString(bz, "2024-01-01T00:00:00Z")
+
+	var msg types.MsgCreateEntry
+	require.NoError(t, msg.Unmarshal(bz))
+	require.Equal(t, "Climate Data 2024", msg.Title)
+	require.Zero(t, msg.FileSize)
+	require.True(t, msg.PublishedAt.IsZero())
+
+	// transactions carrying them are rejected by the decoder
+	err := unknownproto.RejectUnknownFieldsStrict(bz, &types.MsgCreateEntry{}, codectypes.NewInterfaceRegistry())
+	require.ErrorContains(t, err, "TagNum: 9")
+}
+
+func TestMsgDeleteEntry_ValidateBasic(t *testing.T) {
+	msg := &types.MsgDeleteEntry{Id: 1, Reason: "published the wrong file"}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Reason = " "
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidReason)
+
+	msg.Reason = strings.Repeat("a", types.MaxChangeReasonLength+1)
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidReason)
+}
+
+func TestMsgCreateEntriesBatch_ValidateBasic(t *testing.T) {
+	entry := func(modify func(msg *types.MsgCreateEntry)) types.BatchEntry {
+		msg := validMsgCreateEntry()
+		modify(msg)
+		return types.BatchEntry{
+			Title:           msg.Title,
+			Description:     msg.Description,
+			IpfsCid:         msg.IpfsCid,
+			MimeType:        msg.MimeType,
+			FileName:        msg.FileName,
+			FileUrl:         msg.FileUrl,
+			ChecksumSha_256: msg.ChecksumSha_256,
+			Agency:          msg.Agency,
+			Category:        msg.Category,
+		}
+	}
+	valid := entry(func(*types.MsgCreateEntry) {})
+	require.Equal(t, validMsgCreateEntry(), valid.MsgCreateEntry(""))
+
+	msg := &types.MsgCreateEntriesBatch{Entries: []types.BatchEntry{valid, valid}}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Entries = append(msg.Entries, entry(func(msg *types.MsgCreateEntry) { msg.IpfsCid = "QmNotACid" }))
+	err := msg.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidCid)
+	i, ok := types.BatchEntryIndex(err)
+	require.True(t, ok)
+	require.Equal(t, 2, i)
+
+	msg.Entries = nil
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBatch)
+
+	msg.Entries = make([]types.BatchEntry, types.MaxBatchEntries+1)
+	for i := range msg.Entries {
+		msg.Entries[i] = valid
+	}
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidBatch)
+}
+
+func TestBatchEntryIndex(t *testing.T) {
+	err := types.WrapBatchEntryError(types.ErrDuplicateEntry, 12)
+	require.ErrorIs(t, err, types.ErrDuplicateEntry)
+	i, ok := types.BatchEntryIndex(err)
+	require.True(t, ok)
+	require.Equal(t, 12, i)
+
+	// the index is still found in the log of a failed transaction
+	_, _, log := errorsmod.ABCIInfo(errorsmod.Wrapf(err, "failed to execute message; message index: %d", 0), false)
+	i, ok = types.BatchEntryIndex(errors.New(log))
+	require.True(t, ok)
+	require.Equal(t, 12, i)
+
+	_, ok = types.BatchEntryIndex(types.ErrInvalidBatch)
+	require.False(t, ok)
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// MimeSniffLength is the number of leading bytes SniffMimeType considers.
+const MimeSniffLength = 512
+
+// mimeTypeRefinements maps the generic media types sniffed from content to
+// the media types of the data formats they cannot tell apart, by file
+// extension.
+var mimeTypeRefinements = map[string]map[string]string{
+	"text/plain": {
+		".csv":     "text/csv",
+		".tsv":     "text/tab-separated-values",
+		".json":    "application/json",
+		".geojson": "application/geo+json",
+		".ndjson":  "application/x-ndjson",
+		".jsonl":   "application/x-ndjson",
+		".md":      "text/markdown",
+		".yaml":    "application/yaml",
+		".yml":     "application/yaml",
+	},
+	"application/zip": {
+		".xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		".docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		".ods":  "application/vnd.oasis.opendocument.spreadsheet",
+		".kmz":  "application/vnd.google-earth.kmz",
+	},
+	"application/octet-stream": {
+		".parquet": "application/vnd.apache.parquet",
+		".xls":     "application/vnd.ms-excel",
+		".nc":      "application/x-netcdf",
+		".shp":     "application/vnd.shp",
+	},
+}
+
+// SniffMimeType returns the media type of the content starting with head, as
+// sniffed by http.DetectContentType from its first MimeSniffLength bytes,
+// without parameters. Content sniffed as plain text, a ZIP archive or binary
+// data is refined by the extension of name to the known data formats of that
+// kind, such as CSV or XLSX.
+func SniffMimeType(head []byte, name string) string {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	if refined, ok := mimeTypeRefinements[mediaType][strings.ToLower(filepath.Ext(name))]; ok {
+		return refined
+	}
+	return mediaType
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+func TestSniffMimeType(t *testing.T) {
+	zip := []byte("PK\x03\x04\x14\x00\x06\x00")
+	for _, tc := range []struct {
+		name     string
+		head     []byte
+		fileName string
+		expected string
+	}{
+		{"text", []byte("hello world\n"), "notes.txt", "text/plain"},
+		{"csv", []byte("year,rainfall\n2024,1200\n"), "rainfall.CSV", "text/csv"},
+		{"json", []byte(`{"year": 2024}`), "rainfall.json", "application/json"},
+		{"json without extension", []byte(`{"year": 2024}`), "rainfall", "text/plain"},
+		{"html", []byte("<!DOCTYPE html><html></html>"), "index.csv", "text/html"},
+		{"pdf", []byte("%PDF-1.7\n"), "report.csv", "application/pdf"},
+		{"png", []byte("\x89PNG\r\n\x1a\n\x00\x00"), "map.png", "image/png"},
+		{"xlsx", zip, "budget.xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+		{"zip", zip, "archive.zip", "application/zip"},
+		{"parquet", []byte("PAR1\x15\x00\x15\x00"), "trips.parquet", "application/vnd.apache.parquet"},
+		{"binary", []byte("\x00\x01\x02\x03"), "data.csv", "application/octet-stream"},
+		{"empty", nil, "empty.csv", "text/csv"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mimeType := types.SniffMimeType(tc.head, tc.fileName)
+			require.Equal(t, tc.expected, mimeType)
+			require.NoError(t, types.ValidateMimeType(mimeType))
+		})
+	}
+}
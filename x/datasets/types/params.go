@@ -1,17 +1,271 @@
 package types
 
+import (
+	"fmt"
+	"math"
+	"mime"
+	"slices"
+	"strings"
+)
+
+var (
+	// DefaultMaxTitleLength is the default maximum title length in bytes.
+	DefaultMaxTitleLength uint32 = MaxTitleLength
+	// DefaultMaxDescriptionLength is the default maximum description length in bytes.
+	DefaultMaxDescriptionLength uint32 = MaxDescriptionLength
+	// DefaultMaxFileSizeBytes is the default maximum file size. Zero means no limit.
+	DefaultMaxFileSizeBytes uint64 = 0
+	// DefaultSnapshotIntervalBlocks is the default length of a reporting
+	// period, about a day of 6 second blocks.
+	DefaultSnapshotIntervalBlocks uint64 = 14400
+	// DefaultSnapshotEpochIdentifier is the default epoch closing the reporting
+	// periods. Empty means the periods are closed every
+	// DefaultSnapshotIntervalBlocks blocks.
+	DefaultSnapshotEpochIdentifier = ""
+	// DefaultPinAttestationWindowBlocks is the default number of blocks a pin
+	// attestation counts for, about a week of 6 second blocks. Zero disables
+	// the attestations.
+	DefaultPinAttestationWindowBlocks uint64 = 100800
+	// DefaultChallengesPerBlock is the default number of entries challenged
+	// at each block. Zero disables the challenges.
+	DefaultChallengesPerBlock uint32 = 1
+	// DefaultChallengeResponseBlocks is the default number of blocks to answer
+	// a challenge in, about an hour of 6 second blocks.
+	DefaultChallengeResponseBlocks uint64 = 600
+	// DefaultChallengeFailurePenalty is the default reputation lost for a
+	// failed or missed challenge.
+	DefaultChallengeFailurePenalty uint64 = 100
+	// DefaultMaxBatchEntries is the default maximum number of entries of a
+	// batch.
+	DefaultMaxBatchEntries uint32 = 100
+	// DefaultMaxAllowedPinners is the default maximum number of allowed
+	// pinners.
+	DefaultMaxAllowedPinners uint32 = 100
+)
+
+// MaxChallengesPerBlock bounds the number of entries challenged at each
+// block, and so the work of BeginBlock.
+const MaxChallengesPerBlock = 100
+
+// MaxBatchEntries bounds the number of entries of a batch, and so the work of
+// a single message.
+const MaxBatchEntries = 1000
+
+// MaxAllowedPinners bounds the number of allowed pinners, and so the size of
+// the allowlist.
+const MaxAllowedPinners = 1000
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxTitleLength uint32,
+	maxDescriptionLength uint32,
+	maxFileSizeBytes uint64,
+	allowedMimeTypes []string,
+	allowedCategories []string,
+	requireFallbackUrl bool,
+	snapshotIntervalBlocks uint64,
+	snapshotEpochIdentifier string,
+	pinAttestationWindowBlocks uint64,
+	challengesPerBlock uint32,
+	challengeResponseBlocks uint64,
+	challengeFailurePenalty uint64,
+	maxBatchEntries uint32,
+	maxAllowedPinners uint32,
+) Params {
+	return Params{
+		MaxTitleLength:          maxTitleLength,
+		MaxDescriptionLength:    maxDescriptionLength,
+		MaxFileSizeBytes:        maxFileSizeBytes,
+		AllowedMimeTypes:        allowedMimeTypes,
+		AllowedCategories:       allowedCategories,
+		RequireFallbackUrl:      requireFallbackUrl,
+		SnapshotIntervalBlocks:  snapshotIntervalBlocks,
+		SnapshotEpochIdentifier: snapshotEpochIdentifier,
+
+		PinAttestationWindowBlocks: pinAttestationWindowBlocks,
+		ChallengesPerBlock:         challengesPerBlock,
+		ChallengeResponseBlocks:    challengeResponseBlocks,
+		ChallengeFailurePenalty:    challengeFailurePenalty,
+		MaxBatchEntries:            maxBatchEntries,
+		MaxAllowedPinners:          maxAllowedPinners,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxTitleLength,
+		DefaultMaxDescriptionLength,
+		DefaultMaxFileSizeBytes,
+		nil,
+		nil,
+		false,
+		DefaultSnapshotIntervalBlocks,
+		DefaultSnapshotEpochIdentifier,
+		DefaultPinAttestationWindowBlocks,
+		DefaultChallengesPerBlock,
+		DefaultChallengeResponseBlocks,
+		DefaultChallengeFailurePenalty,
+		DefaultMaxBatchEntries,
+		DefaultMaxAllowedPinners,
+	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.MaxTitleLength > MaxTitleLength {
+		return fmt.Errorf("max title length cannot exceed %d: %d", MaxTitleLength, p.MaxTitleLength)
+	}
+	if p.MaxDescriptionLength > MaxDescriptionLength {
+		return fmt.Errorf("max description length cannot exceed %d: %d", MaxDescriptionLength, p.MaxDescriptionLength)
+	}
+	if err := validateAllowedMimeTypes(p.AllowedMimeTypes); err != nil {
+		return err
+	}
+	if err := validateAllowedCategories(p.AllowedCategories); err != nil {
+		return err
+	}
+	if err := validateSnapshotEpochIdentifier(p.SnapshotEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validatePinAttestationWindowBlocks(p.PinAttestationWindowBlocks); err != nil {
+		return err
+	}
+	if err := validateChallenges(p.ChallengesPerBlock, p.ChallengeResponseBlocks, p.ChallengeFailurePenalty); err != nil {
+		return err
+	}
+	if p.MaxBatchEntries > MaxBatchEntries {
+		return fmt.Errorf("max batch entries cannot exceed %d: %d", MaxBatchEntries, p.MaxBatchEntries)
+	}
+	if p.MaxAllowedPinners > MaxAllowedPinners {
+		return fmt.Errorf("max allowed pinners cannot exceed %d: %d", MaxAllowedPinners, p.MaxAllowedPinners)
+	}
+	return nil
+}
+
+func validateAllowedMimeTypes(mimeTypes []string) error {
+	seen := make(map[string]bool, len(mimeTypes))
+	for _, mimeType := range mimeTypes {
+		normalized := strings.ToLower(mimeType)
+		if seen[normalized] {
+			return fmt.Errorf("duplicated allowed mime type: %s", mimeType)
+		}
+		seen[normalized] = true
+
+		if typ, ok := strings.CutSuffix(mimeType, "/*"); ok {
+			// validate the wildcard through a placeholder subtype
+			mimeType = typ + "/x"
+		}
+		if strings.Contains(mimeType, ";") {
+			return fmt.Errorf("allowed mime type must not have parameters: %s", mimeType)
+		}
+		if err := ValidateMimeType(mimeType); err != nil {
+			return fmt.Errorf("invalid allowed mime type: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateAllowedCategories(categories []string) error {
+	seen := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		if seen[category] {
+			return fmt.Errorf("duplicated allowed category: %s", category)
+		}
+		seen[category] = true
+
+		if err := validateText(ErrInvalidCategory, "allowed category", category, MaxCategoryLength, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSnapshotEpochIdentifier(identifier string) error {
+	if strings.TrimSpace(identifier) != identifier {
+		return fmt.Errorf("snapshot epoch identifier must not have leading or trailing spaces: %q", identifier)
+	}
+	return nil
+}
+
+func validatePinAttestationWindowBlocks(window uint64) error {
+	if window > math.MaxInt64/2 {
+		return fmt.Errorf("pin attestation window cannot exceed %d blocks: %d", uint64(math.MaxInt64/2), window)
+	}
+	return nil
+}
 
+func validateChallenges(perBlock uint32, responseBlocks, penalty uint64) error {
+	if perBlock > MaxChallengesPerBlock {
+		return fmt.Errorf("challenges per block cannot exceed %d: %d", MaxChallengesPerBlock, perBlock)
+	}
+	if perBlock > 0 && responseBlocks == 0 {
+		return fmt.Errorf("challenge response blocks must be positive when challenges are enabled")
+	}
+	if responseBlocks > math.MaxInt64/2 {
+		return fmt.Errorf("challenge response blocks cannot exceed %d: %d", uint64(math.MaxInt64/2), responseBlocks)
+	}
+	if penalty > MaxPinnerReputation {
+		return fmt.Errorf("challenge failure penalty cannot exceed the maximum reputation %d: %d", MaxPinnerReputation, penalty)
+	}
 	return nil
 }
+
+// IsMimeTypeAllowed reports whether the media type of mimeType, ignoring any
+// parameters, is allowed by the params.
+func (p Params) IsMimeTypeAllowed(mimeType string) bool {
+	if len(p.AllowedMimeTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+	typ, _, _ := strings.Cut(mediaType, "/")
+	for _, allowed := range p.AllowedMimeTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == mediaType || allowed == typ+"/*" {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCategoryAllowed reports whether category is allowed by the params.
+func (p Params) IsCategoryAllowed(category string) bool {
+	if len(p.AllowedCategories) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedCategories {
+		if allowed == category {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangedFields returns the names of the parameters whose value differs
+// between p and other, in proto declaration order.
+func (p Params) ChangedFields(other Params) []string {
+	var changed []string
+	add := func(name string, equal bool) {
+		if !equal {
+			changed = append(changed, name)
+		}
+	}
+	add("max_title_length", p.MaxTitleLength == other.MaxTitleLength)
+	add("max_description_length", p.MaxDescriptionLength == other.MaxDescriptionLength)
+	add("max_file_size_bytes", p.MaxFileSizeBytes == other.MaxFileSizeBytes)
+	add("allowed_mime_types", slices.Equal(p.AllowedMimeTypes, other.AllowedMimeTypes))
+	add("allowed_categories", slices.Equal(p.AllowedCategories, other.AllowedCategories))
+	add("require_fallback_url", p.RequireFallbackUrl == other.RequireFallbackUrl)
+	add("snapshot_interval_blocks", p.SnapshotIntervalBlocks == other.SnapshotIntervalBlocks)
+	add("snapshot_epoch_identifier", p.SnapshotEpochIdentifier == other.SnapshotEpochIdentifier)
+	add("pin_attestation_window_blocks", p.PinAttestationWindowBlocks == other.PinAttestationWindowBlocks)
+	add("challenges_per_block", p.ChallengesPerBlock == other.ChallengesPerBlock)
+	add("challenge_response_blocks", p.ChallengeResponseBlocks == other.ChallengeResponseBlocks)
+	add("challenge_failure_penalty", p.ChallengeFailurePenalty == other.ChallengeFailurePenalty)
+	add("max_batch_entries", p.MaxBatchEntries == other.MaxBatchEntries)
+	add("max_allowed_pinners", p.MaxAllowedPinners == other.MaxAllowedPinners)
+	return changed
+}
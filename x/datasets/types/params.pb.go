@@ -26,6 +26,52 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_title_length is the maximum length of an entry title in bytes. Zero
+	// only applies the module's hard limit.
+	MaxTitleLength uint32 `protobuf:"varint,1,opt,name=max_title_length,json=maxTitleLength,proto3" json:"max_title_length,omitempty"`
+	// max_description_length is the maximum length of an entry description in
+	// bytes. Zero only applies the module's hard limit.
+	MaxDescriptionLength uint32 `protobuf:"varint,2,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty"`
+	// max_file_size_bytes is the maximum declared size of a dataset file. Zero
+	// means no limit.
+	MaxFileSizeBytes uint64 `protobuf:"varint,3,opt,name=max_file_size_bytes,json=maxFileSizeBytes,proto3" json:"max_file_size_bytes,omitempty"`
+	// allowed_mime_types restricts the media types entries may declare. A
+	// "type/*" item allows every subtype. An empty list allows any media type.
+	AllowedMimeTypes []string `protobuf:"bytes,4,rep,name=allowed_mime_types,json=allowedMimeTypes,proto3" json:"allowed_mime_types,omitempty"`
+	// allowed_categories restricts the categories entries may declare. An empty
+	// list allows any category.
+	AllowedCategories []string `protobuf:"bytes,5,rep,name=allowed_categories,json=allowedCategories,proto3" json:"allowed_categories,omitempty"`
+	// require_fallback_url requires every entry to declare a fallback URL.
+	RequireFallbackUrl bool `protobuf:"varint,6,opt,name=require_fallback_url,json=requireFallbackUrl,proto3" json:"require_fallback_url,omitempty"`
+	// snapshot_interval_blocks closes the reporting period, and snapshots the
+	// Merkle root of the entries changed during it, at every block height that
+	// is a multiple of the interval. Zero disables the snapshots, unless
+	// snapshot_epoch_identifier is set.
+	SnapshotIntervalBlocks uint64 `protobuf:"varint,7,opt,name=snapshot_interval_blocks,json=snapshotIntervalBlocks,proto3" json:"snapshot_interval_blocks,omitempty"`
+	// snapshot_epoch_identifier closes the reporting period at the end of each
+	// epoch of the x/epochs module with this identifier, instead of every
+	// snapshot_interval_blocks blocks.
+	SnapshotEpochIdentifier string `protobuf:"bytes,8,opt,name=snapshot_epoch_identifier,json=snapshotEpochIdentifier,proto3" json:"snapshot_epoch_identifier,omitempty"`
+	// pin_attestation_window_blocks is the number of blocks a pin attestation
+	// counts for after its proof height, unless renewed. Zero disables new
+	// attestations.
+	PinAttestationWindowBlocks uint64 `protobuf:"varint,9,opt,name=pin_attestation_window_blocks,json=pinAttestationWindowBlocks,proto3" json:"pin_attestation_window_blocks,omitempty"`
+	// challenges_per_block is the number of entries whose pinners are
+	// challenged to prove the retrievability of their content at each block.
+	// Zero disables the challenges.
+	ChallengesPerBlock uint32 `protobuf:"varint,10,opt,name=challenges_per_block,json=challengesPerBlock,proto3" json:"challenges_per_block,omitempty"`
+	// challenge_response_blocks is the number of blocks after its issuance
+	// during which a challenge can be answered.
+	ChallengeResponseBlocks uint64 `protobuf:"varint,11,opt,name=challenge_response_blocks,json=challengeResponseBlocks,proto3" json:"challenge_response_blocks,omitempty"`
+	// challenge_failure_penalty is the reputation a pinner loses for each
+	// failed or missed challenge.
+	ChallengeFailurePenalty uint64 `protobuf:"varint,12,opt,name=challenge_failure_penalty,json=challengeFailurePenalty,proto3" json:"challenge_failure_penalty,omitempty"`
+	// max_batch_entries is the maximum number of entries created by a
+	// MsgCreateEntriesBatch. Zero disables the batches.
+	MaxBatchEntries uint32 `protobuf:"varint,13,opt,name=max_batch_entries,json=maxBatchEntries,proto3" json:"max_batch_entries,omitempty"`
+	// max_allowed_pinners is the maximum number of addresses allowed to
+	// register as pinners and to attest to pins.
+	MaxAllowedPinners uint32 `protobuf:"varint,15,opt,name=max_allowed_pinners,json=maxAllowedPinners,proto3" json:"max_allowed_pinners,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +107,104 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxTitleLength() uint32 {
+	if m != nil {
+		return m.MaxTitleLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDescriptionLength() uint32 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFileSizeBytes() uint64 {
+	if m != nil {
+		return m.MaxFileSizeBytes
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedMimeTypes() []string {
+	if m != nil {
+		return m.AllowedMimeTypes
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedCategories() []string {
+	if m != nil {
+		return m.AllowedCategories
+	}
+	return nil
+}
+
+func (m *Params) GetRequireFallbackUrl() bool {
+	if m != nil {
+		return m.RequireFallbackUrl
+	}
+	return false
+}
+
+func (m *Params) GetSnapshotIntervalBlocks() uint64 {
+	if m != nil {
+		return m.SnapshotIntervalBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotEpochIdentifier() string {
+	if m != nil {
+		return m.SnapshotEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetPinAttestationWindowBlocks() uint64 {
+	if m != nil {
+		return m.PinAttestationWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetChallengesPerBlock() uint32 {
+	if m != nil {
+		return m.ChallengesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeResponseBlocks() uint64 {
+	if m != nil {
+		return m.ChallengeResponseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeFailurePenalty() uint64 {
+	if m != nil {
+		return m.ChallengeFailurePenalty
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBatchEntries() uint32 {
+	if m != nil {
+		return m.MaxBatchEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAllowedPinners() uint32 {
+	if m != nil {
+		return m.MaxAllowedPinners
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "govchain.datasets.v1.Params")
 }
@@ -68,18 +212,44 @@ func init() {
 func init() { proto.RegisterFile("govchain/datasets/v1/params.proto", fileDescriptor_4b58ec5d5c6ffe78) }
 
 var fileDescriptor_4b58ec5d5c6ffe78 = []byte{
-	// 161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xcf, 0x2f, 0x4b,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x49, 0x2c, 0x49, 0x2c, 0x4e, 0x2d, 0x29, 0xd6, 0x2f, 0x33,
-	0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81,
-	0x29, 0xd1, 0x83, 0x29, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07,
-	0x93, 0x10, 0x85, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55,
-	0xd2, 0xe5, 0x62, 0x0b, 0x00, 0x1b, 0x67, 0xa5, 0xfc, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d,
-	0x5a, 0x52, 0x70, 0x4b, 0x2b, 0x10, 0xd6, 0x42, 0x14, 0x39, 0x19, 0x9f, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x24, 0x36, 0x5d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0x60, 0xab, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x97, 0x3e, 0x08, 0xce, 0x00, 0x00,
-	0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x4e, 0x53, 0x41,
+	0x14, 0xc6, 0xb9, 0x82, 0x15, 0x46, 0xa1, 0x30, 0x36, 0x70, 0x69, 0x62, 0xad, 0xba, 0x69, 0x88,
+	0xb4, 0x10, 0x5c, 0x18, 0x76, 0x54, 0x21, 0xc1, 0x68, 0xd2, 0x5c, 0x31, 0x26, 0x6e, 0x26, 0xd3,
+	0xdb, 0xd3, 0xde, 0x09, 0x73, 0x67, 0xc6, 0x99, 0xa1, 0x14, 0x1e, 0xc1, 0x95, 0x8f, 0xa0, 0x6f,
+	0xe0, 0x63, 0xb8, 0x64, 0xe9, 0xd2, 0xc0, 0x42, 0x1f, 0xc3, 0xcc, 0xdc, 0x7f, 0x09, 0x71, 0xd3,
+	0xdc, 0x7c, 0xbf, 0xef, 0x3b, 0x67, 0xa6, 0x67, 0x0e, 0x7a, 0x32, 0x91, 0xd3, 0x38, 0xa1, 0x4c,
+	0xf4, 0x46, 0xd4, 0x52, 0x03, 0xd6, 0xf4, 0xa6, 0xbb, 0x3d, 0x45, 0x35, 0x4d, 0x4d, 0x57, 0x69,
+	0x69, 0x25, 0x6e, 0x14, 0x96, 0x6e, 0x61, 0xe9, 0x4e, 0x77, 0x9b, 0x6b, 0x34, 0x65, 0x42, 0xf6,
+	0xfc, 0x6f, 0x66, 0x6c, 0x36, 0x26, 0x72, 0x22, 0xfd, 0x67, 0xcf, 0x7d, 0x65, 0xea, 0xd3, 0xef,
+	0x35, 0x54, 0x1b, 0xf8, 0x7a, 0xb8, 0x83, 0x56, 0x53, 0x3a, 0x23, 0x96, 0x59, 0x0e, 0x84, 0x83,
+	0x98, 0xd8, 0x24, 0x0c, 0xda, 0x41, 0x67, 0x39, 0x5a, 0x49, 0xe9, 0xec, 0xc4, 0xc9, 0x6f, 0xbd,
+	0x8a, 0x5f, 0xa0, 0x75, 0xe7, 0x1c, 0x81, 0x89, 0x35, 0x53, 0x96, 0x49, 0x51, 0xf8, 0xef, 0x78,
+	0x7f, 0x23, 0xa5, 0xb3, 0xd7, 0x15, 0xcc, 0x53, 0xdb, 0xe8, 0xa1, 0x4b, 0x8d, 0x19, 0x07, 0x62,
+	0xd8, 0x25, 0x90, 0xe1, 0x85, 0x05, 0x13, 0xce, 0xb7, 0x83, 0xce, 0x42, 0xe4, 0x5a, 0x1f, 0x31,
+	0x0e, 0xef, 0xd9, 0x25, 0xf4, 0x9d, 0x8e, 0x9f, 0x23, 0x4c, 0x39, 0x97, 0xe7, 0x30, 0x22, 0x29,
+	0x4b, 0x81, 0xd8, 0x0b, 0x05, 0x26, 0x5c, 0x68, 0xcf, 0x77, 0x96, 0xa2, 0xd5, 0x9c, 0xbc, 0x63,
+	0x29, 0x9c, 0x38, 0x1d, 0x6f, 0x57, 0xee, 0x98, 0x5a, 0x98, 0x48, 0xcd, 0xc0, 0x84, 0x77, 0xbd,
+	0x7b, 0x2d, 0x27, 0xaf, 0x4a, 0x80, 0x77, 0x50, 0x43, 0xc3, 0xe7, 0x33, 0xa6, 0x81, 0x8c, 0x29,
+	0xe7, 0x43, 0x1a, 0x9f, 0x92, 0x33, 0xcd, 0xc3, 0x5a, 0x3b, 0xe8, 0x2c, 0x46, 0x38, 0x67, 0x47,
+	0x39, 0xfa, 0xa0, 0x39, 0x7e, 0x89, 0x42, 0x23, 0xa8, 0x32, 0x89, 0xb4, 0x84, 0x09, 0x0b, 0x7a,
+	0x4a, 0x39, 0x19, 0x72, 0x19, 0x9f, 0x9a, 0xf0, 0x9e, 0xbf, 0xc2, 0x7a, 0xc1, 0x8f, 0x73, 0xdc,
+	0xf7, 0x14, 0xef, 0xa3, 0xcd, 0x32, 0x09, 0x4a, 0xc6, 0x09, 0x61, 0x23, 0x10, 0x96, 0x8d, 0x19,
+	0xe8, 0x70, 0xb1, 0x1d, 0x74, 0x96, 0xa2, 0x8d, 0xc2, 0x70, 0xe8, 0xf8, 0x71, 0x89, 0xf1, 0x01,
+	0x7a, 0xa4, 0x98, 0x20, 0xd4, 0x5a, 0x30, 0x96, 0xfa, 0x7f, 0xfa, 0x9c, 0x89, 0x91, 0x3c, 0x2f,
+	0x5a, 0x2f, 0xf9, 0xd6, 0x4d, 0xc5, 0xc4, 0x41, 0xe5, 0xf9, 0xe8, 0x2d, 0x79, 0xfb, 0x1d, 0xd4,
+	0x88, 0x13, 0xca, 0xdd, 0x80, 0xc0, 0x10, 0x05, 0x3a, 0x8b, 0x86, 0xc8, 0x8f, 0x0a, 0x57, 0x6c,
+	0x00, 0xda, 0x47, 0xdc, 0x81, 0x4b, 0x95, 0x68, 0x30, 0x4a, 0x0a, 0x03, 0x45, 0xc3, 0xfb, 0xbe,
+	0xe1, 0x46, 0x69, 0x88, 0x72, 0x5e, 0x5d, 0xb6, 0xca, 0x8e, 0x29, 0xe3, 0x67, 0x1a, 0x88, 0x02,
+	0x41, 0xb9, 0xbd, 0x08, 0x1f, 0xdc, 0xca, 0x1e, 0x65, 0x7c, 0x90, 0x61, 0xbc, 0x85, 0xd6, 0xdc,
+	0x03, 0x19, 0x52, 0x1b, 0x27, 0x04, 0x84, 0xf5, 0x23, 0x5c, 0xf6, 0xc7, 0xac, 0xa7, 0x74, 0xd6,
+	0x77, 0xfa, 0x61, 0x26, 0xe3, 0x6e, 0xf6, 0x98, 0x8a, 0x99, 0x2b, 0x26, 0x04, 0x68, 0x13, 0xd6,
+	0xbd, 0xdb, 0x95, 0x39, 0xc8, 0xc8, 0x20, 0x03, 0xfb, 0xcf, 0xfe, 0x7e, 0x7b, 0x1c, 0x7c, 0xf9,
+	0xf3, 0x63, 0xab, 0x59, 0xae, 0xd4, 0xac, 0x5a, 0xaa, 0x6c, 0x03, 0xde, 0x2c, 0x2c, 0xae, 0xac,
+	0xd6, 0xa3, 0xfa, 0xad, 0xa2, 0xfd, 0xbd, 0x9f, 0xd7, 0xad, 0xe0, 0xea, 0xba, 0x15, 0xfc, 0xbe,
+	0x6e, 0x05, 0x5f, 0x6f, 0x5a, 0x73, 0x57, 0x37, 0xad, 0xb9, 0x5f, 0x37, 0xad, 0xb9, 0x4f, 0x9b,
+	0xff, 0x2b, 0xe6, 0x1f, 0xea, 0xb0, 0xe6, 0xf7, 0x6b, 0xef, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x1e, 0x5a, 0x44, 0x2a, 0xc3, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,6 +271,58 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxTitleLength != that1.MaxTitleLength {
+		return false
+	}
+	if this.MaxDescriptionLength != that1.MaxDescriptionLength {
+		return false
+	}
+	if this.MaxFileSizeBytes != that1.MaxFileSizeBytes {
+		return false
+	}
+	if len(this.AllowedMimeTypes) != len(that1.AllowedMimeTypes) {
+		return false
+	}
+	for i := range this.AllowedMimeTypes {
+		if this.AllowedMimeTypes[i] != that1.AllowedMimeTypes[i] {
+			return false
+		}
+	}
+	if len(this.AllowedCategories) != len(that1.AllowedCategories) {
+		return false
+	}
+	for i := range this.AllowedCategories {
+		if this.AllowedCategories[i] != that1.AllowedCategories[i] {
+			return false
+		}
+	}
+	if this.RequireFallbackUrl != that1.RequireFallbackUrl {
+		return false
+	}
+	if this.SnapshotIntervalBlocks != that1.SnapshotIntervalBlocks {
+		return false
+	}
+	if this.SnapshotEpochIdentifier != that1.SnapshotEpochIdentifier {
+		return false
+	}
+	if this.PinAttestationWindowBlocks != that1.PinAttestationWindowBlocks {
+		return false
+	}
+	if this.ChallengesPerBlock != that1.ChallengesPerBlock {
+		return false
+	}
+	if this.ChallengeResponseBlocks != that1.ChallengeResponseBlocks {
+		return false
+	}
+	if this.ChallengeFailurePenalty != that1.ChallengeFailurePenalty {
+		return false
+	}
+	if this.MaxBatchEntries != that1.MaxBatchEntries {
+		return false
+	}
+	if this.MaxAllowedPinners != that1.MaxAllowedPinners {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +345,91 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAllowedPinners != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAllowedPinners))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxBatchEntries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchEntries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ChallengeFailurePenalty != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeFailurePenalty))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ChallengeResponseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeResponseBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ChallengesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengesPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PinAttestationWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PinAttestationWindowBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SnapshotEpochIdentifier) > 0 {
+		i -= len(m.SnapshotEpochIdentifier)
+		copy(dAtA[i:], m.SnapshotEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SnapshotEpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SnapshotIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotIntervalBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequireFallbackUrl {
+		i--
+		if m.RequireFallbackUrl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedCategories) > 0 {
+		for iNdEx := len(m.AllowedCategories) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCategories[iNdEx])
+			copy(dAtA[i:], m.AllowedCategories[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedCategories[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedMimeTypes) > 0 {
+		for iNdEx := len(m.AllowedMimeTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMimeTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMimeTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMimeTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxFileSizeBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFileSizeBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxDescriptionLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDescriptionLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTitleLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTitleLength))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -143,6 +450,55 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxTitleLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTitleLength))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxDescriptionLength))
+	}
+	if m.MaxFileSizeBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxFileSizeBytes))
+	}
+	if len(m.AllowedMimeTypes) > 0 {
+		for _, s := range m.AllowedMimeTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedCategories) > 0 {
+		for _, s := range m.AllowedCategories {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.RequireFallbackUrl {
+		n += 2
+	}
+	if m.SnapshotIntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotIntervalBlocks))
+	}
+	l = len(m.SnapshotEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.PinAttestationWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PinAttestationWindowBlocks))
+	}
+	if m.ChallengesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.ChallengesPerBlock))
+	}
+	if m.ChallengeResponseBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeResponseBlocks))
+	}
+	if m.ChallengeFailurePenalty != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeFailurePenalty))
+	}
+	if m.MaxBatchEntries != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchEntries))
+	}
+	if m.MaxAllowedPinners != 0 {
+		n += 1 + sovParams(uint64(m.MaxAllowedPinners))
+	}
 	return n
 }
 
@@ -181,6 +537,312 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTitleLength", wireType)
+			}
+			m.MaxTitleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTitleLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
+			}
+			m.MaxDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDescriptionLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFileSizeBytes", wireType)
+			}
+			m.MaxFileSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFileSizeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMimeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMimeTypes = append(m.AllowedMimeTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCategories", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCategories = append(m.AllowedCategories, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireFallbackUrl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireFallbackUrl = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotIntervalBlocks", wireType)
+			}
+			m.SnapshotIntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotIntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinAttestationWindowBlocks", wireType)
+			}
+			m.PinAttestationWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinAttestationWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengesPerBlock", wireType)
+			}
+			m.ChallengesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeResponseBlocks", wireType)
+			}
+			m.ChallengeResponseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeResponseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeFailurePenalty", wireType)
+			}
+			m.ChallengeFailurePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeFailurePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchEntries", wireType)
+			}
+			m.MaxBatchEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowedPinners", wireType)
+			}
+			m.MaxAllowedPinners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAllowedPinners |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		desc   string
+		params types.Params
+		valid  bool
+	}{
+		{desc: "default", params: types.DefaultParams(), valid: true},
+		{desc: "empty", params: types.Params{}, valid: true},
+		{
+			desc:   "allowlists",
+			params: types.Params{AllowedMimeTypes: []string{"text/csv", "image/*"}, AllowedCategories: []string{"budget", "climate"}},
+			valid:  true,
+		},
+		{desc: "title length above hard limit", params: types.Params{MaxTitleLength: types.MaxTitleLength + 1}},
+		{desc: "description length above hard limit", params: types.Params{MaxDescriptionLength: types.MaxDescriptionLength + 1}},
+		{desc: "invalid mime type", params: types.Params{AllowedMimeTypes: []string{"csv"}}},
+		{desc: "mime type with parameters", params: types.Params{AllowedMimeTypes: []string{"text/csv; charset=utf-8"}}},
+		{desc: "duplicated mime type", params: types.Params{AllowedMimeTypes: []string{"text/csv", "TEXT/CSV"}}},
+		{desc: "empty category", params: types.Params{AllowedCategories: []string{""}}},
+		{desc: "duplicated category", params: types.Params{AllowedCategories: []string{"budget", "budget"}}},
+		{desc: "snapshot epoch", params: types.Params{SnapshotEpochIdentifier: "week"}, valid: true},
+		{desc: "snapshot epoch with spaces", params: types.Params{SnapshotEpochIdentifier: " week"}},
+		{desc: "pin attestations disabled", params: types.Params{PinAttestationWindowBlocks: 0}, valid: true},
+		{desc: "pin attestation window overflows heights", params: types.Params{PinAttestationWindowBlocks: math.MaxUint64}},
+		{desc: "challenges without response blocks", params: types.Params{ChallengesPerBlock: 1}},
+		{desc: "too many challenges per block", params: types.Params{ChallengesPerBlock: types.MaxChallengesPerBlock + 1, ChallengeResponseBlocks: 10}},
+		{desc: "challenge penalty above the maximum reputation", params: types.Params{ChallengeFailurePenalty: types.MaxPinnerReputation + 1}},
+		{desc: "batches disabled", params: types.Params{MaxBatchEntries: 0}, valid: true},
+		{desc: "batch entries above hard limit", params: types.Params{MaxBatchEntries: types.MaxBatchEntries + 1}},
+		{desc: "no allowed pinners", params: types.Params{MaxAllowedPinners: 0}, valid: true},
+		{desc: "allowed pinners above hard limit", params: types.Params{MaxAllowedPinners: types.MaxAllowedPinners + 1}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_IsMimeTypeAllowed(t *testing.T) {
+	require.True(t, types.Params{}.IsMimeTypeAllowed("application/pdf"))
+
+	params := types.Params{AllowedMimeTypes: []string{"text/csv", "image/*"}}
+	require.True(t, params.IsMimeTypeAllowed("text/csv"))
+	require.True(t, params.IsMimeTypeAllowed("Text/CSV; charset=utf-8"))
+	require.True(t, params.IsMimeTypeAllowed("image/png"))
+	require.False(t, params.IsMimeTypeAllowed("text/plain"))
+	require.False(t, params.IsMimeTypeAllowed("application/pdf"))
+}
+
+func TestParams_IsCategoryAllowed(t *testing.T) {
+	require.True(t, types.Params{}.IsCategoryAllowed("anything"))
+
+	params := types.Params{AllowedCategories: []string{"budget"}}
+	require.True(t, params.IsCategoryAllowed("budget"))
+	require.False(t, params.IsCategoryAllowed("climate"))
+}
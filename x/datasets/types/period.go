@@ -0,0 +1,75 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// ContentHash returns the SHA-256 digest of the protobuf encoding of the
+// entry, which identifies the entry in the Merkle tree of a period.
+func (e Entry) ContentHash() ([]byte, error) {
+	bz, err := e.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// Bytes returns the leaf data hashed into the Merkle tree of the period: the
+// big endian entry id followed by the content hash.
+func (l PeriodLeaf) Bytes() []byte {
+	return periodLeafBytes(l.EntryId, l.ContentHash)
+}
+
+// PeriodMerkleRoot returns the Merkle root of leaves, which must be ordered by
+// entry id.
+func PeriodMerkleRoot(leaves []PeriodLeaf) []byte {
+	items := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		items[i] = leaf.Bytes()
+	}
+	return merkle.HashFromByteSlices(items)
+}
+
+// NewEntryInclusionProof returns the proof that the leaf at index is included
+// in the Merkle root of leaves.
+func NewEntryInclusionProof(leaves []PeriodLeaf, index int) EntryInclusionProof {
+	items := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		items[i] = leaf.Bytes()
+	}
+	_, proofs := merkle.ProofsFromByteSlices(items)
+	leaf := leaves[index]
+	return EntryInclusionProof{
+		PeriodId:    leaf.PeriodId,
+		EntryId:     leaf.EntryId,
+		ContentHash: leaf.ContentHash,
+		Index:       proofs[index].Index,
+		Total:       proofs[index].Total,
+		Aunts:       proofs[index].Aunts,
+	}
+}
+
+// Verify checks that the proof leads from its entry and content hash to root.
+func (p EntryInclusionProof) Verify(root []byte) error {
+	leaf := periodLeafBytes(p.EntryId, p.ContentHash)
+	proof := merkle.Proof{
+		Total: p.Total,
+		Index: p.Index,
+		// The root of a single item tree is the hash of the leaf.
+		LeafHash: merkle.HashFromByteSlices([][]byte{leaf}),
+		Aunts:    p.Aunts,
+	}
+	if err := proof.Verify(root, leaf); err != nil {
+		return errorsmod.Wrapf(ErrInvalidInclusionProof, "entry %d in period %d: %s", p.EntryId, p.PeriodId, err)
+	}
+	return nil
+}
+
+func periodLeafBytes(entryId uint64, contentHash []byte) []byte {
+	return append(binary.BigEndian.AppendUint64(nil, entryId), contentHash...)
+}
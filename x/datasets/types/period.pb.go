@@ -0,0 +1,1385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: govchain/datasets/v1/period.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PeriodRoot is the Merkle root over the entries changed during a closed
+// reporting period.
+type PeriodRoot struct {
+	PeriodId uint64 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	// root is the RFC 6962 Merkle root of the period leaves, ordered by entry id.
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// start_height and end_height are the first and last block of the period.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// closed_at is the time of the block closing the period.
+	ClosedAt time.Time `protobuf:"bytes,5,opt,name=closed_at,json=closedAt,proto3,stdtime" json:"closed_at"`
+	// entry_count is the number of leaves of the period.
+	EntryCount uint64 `protobuf:"varint,6,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+}
+
+func (m *PeriodRoot) Reset()         { *m = PeriodRoot{} }
+func (m *PeriodRoot) String() string { return proto.CompactTextString(m) }
+func (*PeriodRoot) ProtoMessage()    {}
+func (*PeriodRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_efabbda47a09b45f, []int{0}
+}
+func (m *PeriodRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodRoot.Merge(m, src)
+}
+func (m *PeriodRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodRoot proto.InternalMessageInfo
+
+func (m *PeriodRoot) GetPeriodId() uint64 {
+	if m != nil {
+		return m.PeriodId
+	}
+	return 0
+}
+
+func (m *PeriodRoot) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *PeriodRoot) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *PeriodRoot) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *PeriodRoot) GetClosedAt() time.Time {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return time.Time{}
+}
+
+func (m *PeriodRoot) GetEntryCount() uint64 {
+	if m != nil {
+		return m.EntryCount
+	}
+	return 0
+}
+
+// PeriodLeaf is a leaf of the Merkle tree of a period: the content hash of an
+// entry changed during the period, as of the end of the period.
+type PeriodLeaf struct {
+	PeriodId uint64 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	EntryId  uint64 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// content_hash is the SHA-256 digest of the protobuf encoded entry.
+	ContentHash []byte `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (m *PeriodLeaf) Reset()         { *m = PeriodLeaf{} }
+func (m *PeriodLeaf) String() string { return proto.CompactTextString(m) }
+func (*PeriodLeaf) ProtoMessage()    {}
+func (*PeriodLeaf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_efabbda47a09b45f, []int{1}
+}
+func (m *PeriodLeaf) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodLeaf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodLeaf.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodLeaf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodLeaf.Merge(m, src)
+}
+func (m *PeriodLeaf) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodLeaf) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodLeaf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodLeaf proto.InternalMessageInfo
+
+func (m *PeriodLeaf) GetPeriodId() uint64 {
+	if m != nil {
+		return m.PeriodId
+	}
+	return 0
+}
+
+func (m *PeriodLeaf) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *PeriodLeaf) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+// CurrentPeriod describes the open reporting period.
+type CurrentPeriod struct {
+	PeriodId    uint64 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	StartHeight int64  `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// epoch_ended is set when the epoch closing the period has ended, so that
+	// the period is closed by the next EndBlock.
+	EpochEnded bool `protobuf:"varint,3,opt,name=epoch_ended,json=epochEnded,proto3" json:"epoch_ended,omitempty"`
+}
+
+func (m *CurrentPeriod) Reset()         { *m = CurrentPeriod{} }
+func (m *CurrentPeriod) String() string { return proto.CompactTextString(m) }
+func (*CurrentPeriod) ProtoMessage()    {}
+func (*CurrentPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_efabbda47a09b45f, []int{2}
+}
+func (m *CurrentPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrentPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrentPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrentPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentPeriod.Merge(m, src)
+}
+func (m *CurrentPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrentPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrentPeriod proto.InternalMessageInfo
+
+func (m *CurrentPeriod) GetPeriodId() uint64 {
+	if m != nil {
+		return m.PeriodId
+	}
+	return 0
+}
+
+func (m *CurrentPeriod) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *CurrentPeriod) GetEpochEnded() bool {
+	if m != nil {
+		return m.EpochEnded
+	}
+	return false
+}
+
+// EntryInclusionProof is an RFC 6962 Merkle audit path proving that an entry
+// with the given content hash is a leaf of the root of a period.
+type EntryInclusionProof struct {
+	PeriodId    uint64 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	EntryId     uint64 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	ContentHash []byte `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// index is the position of the leaf among the total leaves of the period.
+	Index int64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// aunts are the sibling hashes from the leaf up to the root.
+	Aunts [][]byte `protobuf:"bytes,6,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *EntryInclusionProof) Reset()         { *m = EntryInclusionProof{} }
+func (m *EntryInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*EntryInclusionProof) ProtoMessage()    {}
+func (*EntryInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_efabbda47a09b45f, []int{3}
+}
+func (m *EntryInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntryInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryInclusionProof.Merge(m, src)
+}
+func (m *EntryInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryInclusionProof proto.InternalMessageInfo
+
+func (m *EntryInclusionProof) GetPeriodId() uint64 {
+	if m != nil {
+		return m.PeriodId
+	}
+	return 0
+}
+
+func (m *EntryInclusionProof) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *EntryInclusionProof) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+func (m *EntryInclusionProof) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EntryInclusionProof) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *EntryInclusionProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PeriodRoot)(nil), "govchain.datasets.v1.PeriodRoot")
+	proto.RegisterType((*PeriodLeaf)(nil), "govchain.datasets.v1.PeriodLeaf")
+	proto.RegisterType((*CurrentPeriod)(nil), "govchain.datasets.v1.CurrentPeriod")
+	proto.RegisterType((*EntryInclusionProof)(nil), "govchain.datasets.v1.EntryInclusionProof")
+}
+
+func init() { proto.RegisterFile("govchain/datasets/v1/period.proto", fileDescriptor_efabbda47a09b45f) }
+
+var fileDescriptor_efabbda47a09b45f = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x31, 0x6e, 0xdb, 0x30,
+	0x14, 0x86, 0xcd, 0xd8, 0x71, 0x65, 0xda, 0x5d, 0x54, 0x0f, 0x8a, 0x8b, 0xca, 0x8a, 0x27, 0x4d,
+	0x12, 0xd2, 0x9c, 0x20, 0x09, 0x02, 0x24, 0x40, 0x87, 0x80, 0xe8, 0xd4, 0x45, 0x60, 0x44, 0x46,
+	0x12, 0xe0, 0xf0, 0x09, 0xe2, 0x93, 0x91, 0xdc, 0x22, 0x67, 0xe9, 0x29, 0x32, 0x66, 0xec, 0xd4,
+	0x16, 0xf6, 0x45, 0x0a, 0x3d, 0x5a, 0x2d, 0xd0, 0x02, 0xd9, 0xba, 0xf1, 0xff, 0xfc, 0x9b, 0xef,
+	0xff, 0x49, 0x8a, 0x1f, 0x17, 0xb0, 0xc9, 0x4b, 0x59, 0x99, 0x54, 0x49, 0x94, 0x56, 0xa3, 0x4d,
+	0x37, 0x27, 0x69, 0xad, 0x9b, 0x0a, 0x54, 0x52, 0x37, 0x80, 0xe0, 0xcf, 0x7b, 0x4b, 0xd2, 0x5b,
+	0x92, 0xcd, 0xc9, 0x62, 0x5e, 0x40, 0x01, 0x64, 0x48, 0xbb, 0x95, 0xf3, 0x2e, 0x96, 0x05, 0x40,
+	0xb1, 0xd6, 0x29, 0xa9, 0xdb, 0xf6, 0x2e, 0xc5, 0xea, 0x5e, 0x5b, 0x94, 0xf7, 0xb5, 0x33, 0xac,
+	0x76, 0x8c, 0xf3, 0x1b, 0xda, 0x5d, 0x00, 0xa0, 0xff, 0x9e, 0x4f, 0xdc, 0xac, 0xac, 0x52, 0x01,
+	0x8b, 0x58, 0x3c, 0x12, 0x9e, 0x03, 0xd7, 0xca, 0xf7, 0xf9, 0xa8, 0x01, 0xc0, 0xe0, 0x20, 0x62,
+	0xf1, 0x4c, 0xd0, 0xda, 0x3f, 0xe6, 0x33, 0x8b, 0xb2, 0xc1, 0xac, 0xd4, 0x55, 0x51, 0x62, 0x30,
+	0x8c, 0x58, 0x3c, 0x14, 0x53, 0x62, 0x57, 0x84, 0xfc, 0x0f, 0x9c, 0x6b, 0xa3, 0x7a, 0xc3, 0x88,
+	0x0c, 0x13, 0x6d, 0xd4, 0xfe, 0xe7, 0x33, 0x3e, 0xc9, 0xd7, 0x60, 0xb5, 0xca, 0x24, 0x06, 0x87,
+	0x11, 0x8b, 0xa7, 0x1f, 0x17, 0x89, 0x8b, 0x9d, 0xf4, 0xb1, 0x93, 0xcf, 0x7d, 0xec, 0x73, 0xef,
+	0xf9, 0xfb, 0x72, 0xf0, 0xf4, 0x63, 0xc9, 0x84, 0xe7, 0xfe, 0x76, 0x86, 0xfe, 0x92, 0x4f, 0xb5,
+	0xc1, 0xe6, 0x31, 0xcb, 0xa1, 0x35, 0x18, 0x8c, 0x29, 0x37, 0x27, 0x74, 0xd1, 0x91, 0x55, 0xd1,
+	0x97, 0xfc, 0xa4, 0xe5, 0xdd, 0xeb, 0x25, 0x8f, 0xb8, 0xe7, 0xf6, 0xaa, 0x14, 0x15, 0x1d, 0x89,
+	0x37, 0xa4, 0xaf, 0x55, 0xd7, 0x35, 0x07, 0x83, 0xda, 0x60, 0x56, 0x4a, 0x5b, 0x52, 0xd7, 0x99,
+	0x98, 0xee, 0xd9, 0x95, 0xb4, 0xe5, 0xaa, 0xe6, 0x6f, 0x2f, 0xda, 0xa6, 0xd1, 0x06, 0xdd, 0xbc,
+	0xd7, 0x67, 0xfd, 0x7d, 0x78, 0x07, 0xff, 0x1e, 0x5e, 0x57, 0xad, 0x86, 0xbc, 0xcc, 0xb4, 0x51,
+	0x5a, 0xd1, 0x48, 0x4f, 0x70, 0x42, 0x97, 0x1d, 0x59, 0x7d, 0x65, 0xfc, 0xdd, 0x25, 0x05, 0x34,
+	0xf9, 0xba, 0xb5, 0x15, 0x98, 0x9b, 0x06, 0xe0, 0x7f, 0x96, 0xf4, 0xe7, 0xfc, 0xb0, 0x32, 0x4a,
+	0x3f, 0xec, 0xef, 0xd2, 0x89, 0x8e, 0x22, 0xa0, 0x5c, 0xd3, 0x1d, 0x0e, 0x85, 0x13, 0x1d, 0x95,
+	0xad, 0x41, 0x1b, 0x8c, 0xa3, 0x61, 0x3c, 0x13, 0x4e, 0x9c, 0x9f, 0x3e, 0x6f, 0x43, 0xf6, 0xb2,
+	0x0d, 0xd9, 0xcf, 0x6d, 0xc8, 0x9e, 0x76, 0xe1, 0xe0, 0x65, 0x17, 0x0e, 0xbe, 0xed, 0xc2, 0xc1,
+	0x97, 0xa3, 0xdf, 0xef, 0xff, 0xe1, 0xcf, 0x17, 0x80, 0x8f, 0xb5, 0xb6, 0xb7, 0x63, 0x7a, 0x0d,
+	0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x31, 0xe2, 0x6d, 0xf1, 0x23, 0x03, 0x00, 0x00,
+}
+
+func (m *PeriodRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EntryCount != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.EntryCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ClosedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPeriod(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.EndHeight != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintPeriod(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PeriodId != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.PeriodId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodLeaf) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodLeaf) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodLeaf) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintPeriod(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PeriodId != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.PeriodId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrentPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrentPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrentPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochEnded {
+		i--
+		if m.EpochEnded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PeriodId != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.PeriodId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntryInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintPeriod(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Total != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Index != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintPeriod(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PeriodId != 0 {
+		i = encodeVarintPeriod(dAtA, i, uint64(m.PeriodId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeriod(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeriod(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeriodRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		n += 1 + sovPeriod(uint64(m.PeriodId))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovPeriod(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovPeriod(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovPeriod(uint64(m.EndHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ClosedAt)
+	n += 1 + l + sovPeriod(uint64(l))
+	if m.EntryCount != 0 {
+		n += 1 + sovPeriod(uint64(m.EntryCount))
+	}
+	return n
+}
+
+func (m *PeriodLeaf) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		n += 1 + sovPeriod(uint64(m.PeriodId))
+	}
+	if m.EntryId != 0 {
+		n += 1 + sovPeriod(uint64(m.EntryId))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovPeriod(uint64(l))
+	}
+	return n
+}
+
+func (m *CurrentPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		n += 1 + sovPeriod(uint64(m.PeriodId))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovPeriod(uint64(m.StartHeight))
+	}
+	if m.EpochEnded {
+		n += 2
+	}
+	return n
+}
+
+func (m *EntryInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		n += 1 + sovPeriod(uint64(m.PeriodId))
+	}
+	if m.EntryId != 0 {
+		n += 1 + sovPeriod(uint64(m.EntryId))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovPeriod(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPeriod(uint64(m.Index))
+	}
+	if m.Total != 0 {
+		n += 1 + sovPeriod(uint64(m.Total))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovPeriod(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPeriod(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeriod(x uint64) (n int) {
+	return sovPeriod(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PeriodRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeriod
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodId", wireType)
+			}
+			m.PeriodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ClosedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryCount", wireType)
+			}
+			m.EntryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeriod(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodLeaf) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeriod
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodLeaf: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodLeaf: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodId", wireType)
+			}
+			m.PeriodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeriod(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeriod
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodId", wireType)
+			}
+			m.PeriodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEnded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochEnded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeriod(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntryInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeriod
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntryInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntryInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodId", wireType)
+			}
+			m.PeriodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeriod(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeriod
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeriod(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeriod
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeriod
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeriod
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeriod
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeriod
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeriod        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeriod          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeriod = fmt.Errorf("proto: unexpected end of group")
+)
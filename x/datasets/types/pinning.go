@@ -0,0 +1,112 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Field length limits, in bytes, enforced on pinner registrations.
+const (
+	MaxPinnerMonikerLength  = 64
+	MaxPinnerEndpointLength = 256
+)
+
+// Reputation of the pinners in the retrievability challenges. A pinner
+// registers with the maximum reputation, loses the challenge failure penalty
+// of the params for each failed or missed challenge and recovers
+// PinnerReputationReward for each passed one.
+const (
+	MaxPinnerReputation    = 1000
+	PinnerReputationReward = 10
+)
+
+// Validate performs the stateless validation of a pinner. The address is
+// decoded by the keeper, which owns the address codec.
+func (p Pinner) Validate() error {
+	if p.Address == "" {
+		return errorsmod.Wrap(ErrInvalidPinner, "empty pinner address")
+	}
+	if err := validateText(ErrInvalidPinner, "moniker", p.Moniker, MaxPinnerMonikerLength, true); err != nil {
+		return err
+	}
+	if err := validateText(ErrInvalidPinner, "endpoint", p.Endpoint, MaxPinnerEndpointLength, false); err != nil {
+		return err
+	}
+	if p.Reputation > MaxPinnerReputation {
+		return errorsmod.Wrapf(ErrInvalidPinner, "reputation %d exceeds the maximum %d", p.Reputation, MaxPinnerReputation)
+	}
+	return nil
+}
+
+// PassChallenge records a passed challenge in the score of the pinner.
+func (p *Pinner) PassChallenge() {
+	p.ChallengesPassed++
+	p.Reputation = min(p.Reputation+PinnerReputationReward, MaxPinnerReputation)
+}
+
+// FailChallenge records a failed or missed challenge in the score of the
+// pinner, which loses penalty.
+func (p *Pinner) FailChallenge(penalty uint64) {
+	p.ChallengesFailed++
+	p.Reputation -= min(p.Reputation, penalty)
+}
+
+// ValidateBasic performs the stateless validation of MsgRegisterPinner.
+func (msg *MsgRegisterPinner) ValidateBasic() error {
+	return Pinner{Address: msg.Pinner, Moniker: msg.Moniker, Endpoint: msg.Endpoint}.Validate()
+}
+
+// ValidateBasic performs the stateless validation of MsgAttestPin.
+func (msg *MsgAttestPin) ValidateBasic() error {
+	if err := ValidateCid(msg.Cid); err != nil {
+		return err
+	}
+	if msg.ProofHeight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidPinAttestation, "proof height %d must be positive", msg.ProofHeight)
+	}
+	return nil
+}
+
+// Validate performs the stateless validation of a pin attestation.
+func (a PinAttestation) Validate() error {
+	if a.Pinner == "" {
+		return errorsmod.Wrapf(ErrInvalidPinAttestation, "attestation of entry %d has no pinner", a.EntryId)
+	}
+	if a.Cid == "" {
+		return errorsmod.Wrapf(ErrInvalidPinAttestation, "attestation of entry %d by %s has no cid", a.EntryId, a.Pinner)
+	}
+	if a.ProofHeight <= 0 || a.ProofHeight > a.AttestedHeight || a.ExpiresHeight <= a.AttestedHeight {
+		return errorsmod.Wrapf(ErrInvalidPinAttestation, "attestation of entry %d by %s has inconsistent heights", a.EntryId, a.Pinner)
+	}
+	return nil
+}
+
+// ValidateBasic performs the stateless validation of MsgRespondChallenge. The
+// proof itself is verified against the challenge.
+func (msg *MsgRespondChallenge) ValidateBasic() error {
+	if len(msg.Chunk) == 0 {
+		return errorsmod.Wrap(ErrInvalidChallenge, "empty chunk")
+	}
+	if len(msg.Path) > MaxUnixFSPathDepth {
+		return errorsmod.Wrapf(ErrInvalidChallenge, "path of %d blocks exceeds the maximum depth %d", len(msg.Path), MaxUnixFSPathDepth)
+	}
+	for i, block := range append([][]byte{msg.Chunk}, msg.Path...) {
+		if len(block) > MaxUnixFSBlockSize {
+			return errorsmod.Wrapf(ErrInvalidChallenge, "block %d of %d bytes exceeds the maximum size %d", i, len(block), MaxUnixFSBlockSize)
+		}
+	}
+	return nil
+}
+
+// Validate performs the stateless validation of a challenge.
+func (c Challenge) Validate() error {
+	if c.Pinner == "" {
+		return errorsmod.Wrapf(ErrInvalidChallenge, "challenge %d has no pinner", c.Id)
+	}
+	if err := ValidateCid(c.Cid); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChallenge, "challenge %d: %s", c.Id, err)
+	}
+	if c.IssuedHeight <= 0 || c.DeadlineHeight < c.IssuedHeight {
+		return errorsmod.Wrapf(ErrInvalidChallenge, "challenge %d has inconsistent heights", c.Id)
+	}
+	return nil
+}
@@ -31,6 +31,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EntryOrderBy defines the sort order of an entry listing.
+type EntryOrderBy int32
+
+const (
+	// ENTRY_ORDER_BY_ID sorts entries by id.
+	ENTRY_ORDER_BY_ID EntryOrderBy = 0
+	// ENTRY_ORDER_BY_CREATED sorts entries by creation height, then id.
+	ENTRY_ORDER_BY_CREATED EntryOrderBy = 1
+	// ENTRY_ORDER_BY_FILE_SIZE sorts entries by file size, then id.
+	ENTRY_ORDER_BY_FILE_SIZE EntryOrderBy = 2
+	// ENTRY_ORDER_BY_UPDATED sorts entries by the height of their last
+	// modification, then id.
+	ENTRY_ORDER_BY_UPDATED EntryOrderBy = 3
+)
+
+var EntryOrderBy_name = map[int32]string{
+	0: "ENTRY_ORDER_BY_ID",
+	1: "ENTRY_ORDER_BY_CREATED",
+	2: "ENTRY_ORDER_BY_FILE_SIZE",
+	3: "ENTRY_ORDER_BY_UPDATED",
+}
+
+var EntryOrderBy_value = map[string]int32{
+	"ENTRY_ORDER_BY_ID":        0,
+	"ENTRY_ORDER_BY_CREATED":   1,
+	"ENTRY_ORDER_BY_FILE_SIZE": 2,
+	"ENTRY_ORDER_BY_UPDATED":   3,
+}
+
+func (x EntryOrderBy) String() string {
+	return proto.EnumName(EntryOrderBy_name, int32(x))
+}
+
+func (EntryOrderBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -162,6 +199,11 @@ func (m *QueryGetEntryRequest) GetId() uint64 {
 // QueryGetEntryResponse defines the QueryGetEntryResponse message.
 type QueryGetEntryResponse struct {
 	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	// pin_count is the number of pinners with a current attestation for the
+	// content of the entry.
+	PinCount uint32 `protobuf:"varint,2,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
+	// pinners are the addresses of these pinners.
+	Pinners []string `protobuf:"bytes,3,rep,name=pinners,proto3" json:"pinners,omitempty"`
 }
 
 func (m *QueryGetEntryResponse) Reset()         { *m = QueryGetEntryResponse{} }
@@ -204,16 +246,103 @@ func (m *QueryGetEntryResponse) GetEntry() Entry {
 	return Entry{}
 }
 
+func (m *QueryGetEntryResponse) GetPinCount() uint32 {
+	if m != nil {
+		return m.PinCount
+	}
+	return 0
+}
+
+func (m *QueryGetEntryResponse) GetPinners() []string {
+	if m != nil {
+		return m.Pinners
+	}
+	return nil
+}
+
+// EntryPins describes the current pin attestations of an entry.
+type EntryPins struct {
+	EntryId uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// pin_count is the number of pinners with a current attestation for the
+	// content of the entry.
+	PinCount uint32 `protobuf:"varint,2,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
+	// pinners are the addresses of these pinners.
+	Pinners []string `protobuf:"bytes,3,rep,name=pinners,proto3" json:"pinners,omitempty"`
+}
+
+func (m *EntryPins) Reset()         { *m = EntryPins{} }
+func (m *EntryPins) String() string { return proto.CompactTextString(m) }
+func (*EntryPins) ProtoMessage()    {}
+func (*EntryPins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{4}
+}
+func (m *EntryPins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryPins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryPins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntryPins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryPins.Merge(m, src)
+}
+func (m *EntryPins) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryPins) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryPins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryPins proto.InternalMessageInfo
+
+func (m *EntryPins) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *EntryPins) GetPinCount() uint32 {
+	if m != nil {
+		return m.PinCount
+	}
+	return 0
+}
+
+func (m *EntryPins) GetPinners() []string {
+	if m != nil {
+		return m.Pinners
+	}
+	return nil
+}
+
 // QueryAllEntryRequest defines the QueryAllEntryRequest message.
 type QueryAllEntryRequest struct {
+	// pagination follows the key, offset, limit, count_total and reverse
+	// semantics of other listings; next_key is only valid for the same filter
+	// and order_by.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// include_retracted lists retracted entries too.
+	IncludeRetracted bool `protobuf:"varint,2,opt,name=include_retracted,json=includeRetracted,proto3" json:"include_retracted,omitempty"`
+	// filter restricts the listing to the entries matching all of its criteria.
+	Filter *EntryFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// order_by sorts the listing, ascending unless pagination.reverse is set.
+	OrderBy EntryOrderBy `protobuf:"varint,4,opt,name=order_by,json=orderBy,proto3,enum=govchain.datasets.v1.EntryOrderBy" json:"order_by,omitempty"`
 }
 
 func (m *QueryAllEntryRequest) Reset()         { *m = QueryAllEntryRequest{} }
 func (m *QueryAllEntryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEntryRequest) ProtoMessage()    {}
 func (*QueryAllEntryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{4}
+	return fileDescriptor_56363c6e756e2454, []int{5}
 }
 func (m *QueryAllEntryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,17 +378,140 @@ func (m *QueryAllEntryRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllEntryRequest) GetIncludeRetracted() bool {
+	if m != nil {
+		return m.IncludeRetracted
+	}
+	return false
+}
+
+func (m *QueryAllEntryRequest) GetFilter() *EntryFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *QueryAllEntryRequest) GetOrderBy() EntryOrderBy {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ENTRY_ORDER_BY_ID
+}
+
+// EntryFilter defines the criteria of an entry listing. Unset criteria match
+// every entry.
+type EntryFilter struct {
+	Agency   string `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency,omitempty"`
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	// min_created_height is the lowest creation height listed, inclusive.
+	MinCreatedHeight int64 `protobuf:"varint,4,opt,name=min_created_height,json=minCreatedHeight,proto3" json:"min_created_height,omitempty"`
+	// max_created_height is the highest creation height listed, inclusive.
+	MaxCreatedHeight int64 `protobuf:"varint,5,opt,name=max_created_height,json=maxCreatedHeight,proto3" json:"max_created_height,omitempty"`
+	// min_updated_height is the lowest height of the last modification listed,
+	// inclusive.
+	MinUpdatedHeight int64 `protobuf:"varint,6,opt,name=min_updated_height,json=minUpdatedHeight,proto3" json:"min_updated_height,omitempty"`
+	// max_updated_height is the highest height of the last modification
+	// listed, inclusive.
+	MaxUpdatedHeight int64 `protobuf:"varint,7,opt,name=max_updated_height,json=maxUpdatedHeight,proto3" json:"max_updated_height,omitempty"`
+}
+
+func (m *EntryFilter) Reset()         { *m = EntryFilter{} }
+func (m *EntryFilter) String() string { return proto.CompactTextString(m) }
+func (*EntryFilter) ProtoMessage()    {}
+func (*EntryFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{6}
+}
+func (m *EntryFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntryFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntryFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntryFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntryFilter.Merge(m, src)
+}
+func (m *EntryFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntryFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntryFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntryFilter proto.InternalMessageInfo
+
+func (m *EntryFilter) GetAgency() string {
+	if m != nil {
+		return m.Agency
+	}
+	return ""
+}
+
+func (m *EntryFilter) GetCategory() string {
+	if m != nil {
+		return m.Category
+	}
+	return ""
+}
+
+func (m *EntryFilter) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
+	}
+	return ""
+}
+
+func (m *EntryFilter) GetMinCreatedHeight() int64 {
+	if m != nil {
+		return m.MinCreatedHeight
+	}
+	return 0
+}
+
+func (m *EntryFilter) GetMaxCreatedHeight() int64 {
+	if m != nil {
+		return m.MaxCreatedHeight
+	}
+	return 0
+}
+
+func (m *EntryFilter) GetMinUpdatedHeight() int64 {
+	if m != nil {
+		return m.MinUpdatedHeight
+	}
+	return 0
+}
+
+func (m *EntryFilter) GetMaxUpdatedHeight() int64 {
+	if m != nil {
+		return m.MaxUpdatedHeight
+	}
+	return 0
+}
+
 // QueryAllEntryResponse defines the QueryAllEntryResponse message.
 type QueryAllEntryResponse struct {
 	Entry      []Entry             `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pins are the pins of the listed entries, in the same order.
+	Pins []EntryPins `protobuf:"bytes,3,rep,name=pins,proto3" json:"pins"`
 }
 
 func (m *QueryAllEntryResponse) Reset()         { *m = QueryAllEntryResponse{} }
 func (m *QueryAllEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllEntryResponse) ProtoMessage()    {}
 func (*QueryAllEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{5}
+	return fileDescriptor_56363c6e756e2454, []int{7}
 }
 func (m *QueryAllEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,16 +554,24 @@ func (m *QueryAllEntryResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryAllEntryResponse) GetPins() []EntryPins {
+	if m != nil {
+		return m.Pins
+	}
+	return nil
+}
+
 // QueryEntriesByAgencyRequest defines the QueryEntriesByAgencyRequest message.
 type QueryEntriesByAgencyRequest struct {
-	Agency string `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency,omitempty"`
+	Agency     string             `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesByAgencyRequest) Reset()         { *m = QueryEntriesByAgencyRequest{} }
 func (m *QueryEntriesByAgencyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEntriesByAgencyRequest) ProtoMessage()    {}
 func (*QueryEntriesByAgencyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{6}
+	return fileDescriptor_56363c6e756e2454, []int{8}
 }
 func (m *QueryEntriesByAgencyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,15 +607,24 @@ func (m *QueryEntriesByAgencyRequest) GetAgency() string {
 	return ""
 }
 
+func (m *QueryEntriesByAgencyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEntriesByAgencyResponse defines the QueryEntriesByAgencyResponse message.
 type QueryEntriesByAgencyResponse struct {
+	Entry      []Entry             `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesByAgencyResponse) Reset()         { *m = QueryEntriesByAgencyResponse{} }
 func (m *QueryEntriesByAgencyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEntriesByAgencyResponse) ProtoMessage()    {}
 func (*QueryEntriesByAgencyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{7}
+	return fileDescriptor_56363c6e756e2454, []int{9}
 }
 func (m *QueryEntriesByAgencyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,16 +653,31 @@ func (m *QueryEntriesByAgencyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEntriesByAgencyResponse proto.InternalMessageInfo
 
+func (m *QueryEntriesByAgencyResponse) GetEntry() []Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *QueryEntriesByAgencyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEntriesByCategoryRequest defines the QueryEntriesByCategoryRequest message.
 type QueryEntriesByCategoryRequest struct {
-	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Category   string             `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesByCategoryRequest) Reset()         { *m = QueryEntriesByCategoryRequest{} }
 func (m *QueryEntriesByCategoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEntriesByCategoryRequest) ProtoMessage()    {}
 func (*QueryEntriesByCategoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{8}
+	return fileDescriptor_56363c6e756e2454, []int{10}
 }
 func (m *QueryEntriesByCategoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,15 +713,24 @@ func (m *QueryEntriesByCategoryRequest) GetCategory() string {
 	return ""
 }
 
+func (m *QueryEntriesByCategoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEntriesByCategoryResponse defines the QueryEntriesByCategoryResponse message.
 type QueryEntriesByCategoryResponse struct {
+	Entry      []Entry             `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesByCategoryResponse) Reset()         { *m = QueryEntriesByCategoryResponse{} }
 func (m *QueryEntriesByCategoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEntriesByCategoryResponse) ProtoMessage()    {}
 func (*QueryEntriesByCategoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{9}
+	return fileDescriptor_56363c6e756e2454, []int{11}
 }
 func (m *QueryEntriesByCategoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,16 +759,31 @@ func (m *QueryEntriesByCategoryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEntriesByCategoryResponse proto.InternalMessageInfo
 
+func (m *QueryEntriesByCategoryResponse) GetEntry() []Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *QueryEntriesByCategoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEntriesByMimetypeRequest defines the QueryEntriesByMimetypeRequest message.
 type QueryEntriesByMimetypeRequest struct {
-	MimeType string `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	MimeType   string             `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesByMimetypeRequest) Reset()         { *m = QueryEntriesByMimetypeRequest{} }
 func (m *QueryEntriesByMimetypeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEntriesByMimetypeRequest) ProtoMessage()    {}
 func (*QueryEntriesByMimetypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{10}
+	return fileDescriptor_56363c6e756e2454, []int{12}
 }
 func (m *QueryEntriesByMimetypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,15 +819,24 @@ func (m *QueryEntriesByMimetypeRequest) GetMimeType() string {
 	return ""
 }
 
+func (m *QueryEntriesByMimetypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryEntriesByMimetypeResponse defines the QueryEntriesByMimetypeResponse message.
 type QueryEntriesByMimetypeResponse struct {
+	Entry      []Entry             `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesByMimetypeResponse) Reset()         { *m = QueryEntriesByMimetypeResponse{} }
 func (m *QueryEntriesByMimetypeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEntriesByMimetypeResponse) ProtoMessage()    {}
 func (*QueryEntriesByMimetypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56363c6e756e2454, []int{11}
+	return fileDescriptor_56363c6e756e2454, []int{13}
 }
 func (m *QueryEntriesByMimetypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,863 +865,9039 @@ func (m *QueryEntriesByMimetypeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEntriesByMimetypeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "govchain.datasets.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "govchain.datasets.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetEntryRequest)(nil), "govchain.datasets.v1.QueryGetEntryRequest")
-	proto.RegisterType((*QueryGetEntryResponse)(nil), "govchain.datasets.v1.QueryGetEntryResponse")
-	proto.RegisterType((*QueryAllEntryRequest)(nil), "govchain.datasets.v1.QueryAllEntryRequest")
-	proto.RegisterType((*QueryAllEntryResponse)(nil), "govchain.datasets.v1.QueryAllEntryResponse")
-	proto.RegisterType((*QueryEntriesByAgencyRequest)(nil), "govchain.datasets.v1.QueryEntriesByAgencyRequest")
-	proto.RegisterType((*QueryEntriesByAgencyResponse)(nil), "govchain.datasets.v1.QueryEntriesByAgencyResponse")
-	proto.RegisterType((*QueryEntriesByCategoryRequest)(nil), "govchain.datasets.v1.QueryEntriesByCategoryRequest")
-	proto.RegisterType((*QueryEntriesByCategoryResponse)(nil), "govchain.datasets.v1.QueryEntriesByCategoryResponse")
-	proto.RegisterType((*QueryEntriesByMimetypeRequest)(nil), "govchain.datasets.v1.QueryEntriesByMimetypeRequest")
-	proto.RegisterType((*QueryEntriesByMimetypeResponse)(nil), "govchain.datasets.v1.QueryEntriesByMimetypeResponse")
+func (m *QueryEntriesByMimetypeResponse) GetEntry() []Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("govchain/datasets/v1/query.proto", fileDescriptor_56363c6e756e2454) }
-
-var fileDescriptor_56363c6e756e2454 = []byte{
-	// 703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xc1, 0x6b, 0x13, 0x41,
-	0x14, 0xc6, 0xb3, 0xb1, 0x8d, 0xcd, 0x08, 0x4a, 0xc7, 0x28, 0xba, 0x49, 0xd7, 0x38, 0x4a, 0xad,
-	0x15, 0x76, 0x4c, 0xda, 0x5a, 0x41, 0x45, 0x1a, 0xd1, 0x5e, 0x14, 0x6a, 0x10, 0x0f, 0x1e, 0x2c,
-	0x93, 0x64, 0x58, 0x07, 0xba, 0x3b, 0xdb, 0xce, 0x36, 0x18, 0x42, 0x2e, 0x7a, 0xf2, 0x20, 0x08,
-	0x9e, 0xfc, 0x0f, 0x3c, 0x8a, 0x67, 0xbd, 0xf7, 0x58, 0xf0, 0xe2, 0x45, 0x91, 0x56, 0xf0, 0xdf,
-	0x90, 0x9d, 0x99, 0x4d, 0x9a, 0xcd, 0x36, 0xdd, 0x5e, 0xda, 0xdd, 0xb7, 0xef, 0xfb, 0xde, 0x6f,
-	0x67, 0xe6, 0xdb, 0x80, 0xb2, 0xc3, 0xdb, 0xcd, 0x57, 0x84, 0x79, 0xb8, 0x45, 0x02, 0x22, 0x68,
-	0x20, 0x70, 0xbb, 0x82, 0x37, 0xb7, 0xe9, 0x56, 0xc7, 0xf6, 0xb7, 0x78, 0xc0, 0x61, 0x21, 0xea,
-	0xb0, 0xa3, 0x0e, 0xbb, 0x5d, 0x31, 0xa7, 0x89, 0xcb, 0x3c, 0x8e, 0xe5, 0x5f, 0xd5, 0x68, 0xce,
-	0x37, 0xb9, 0x70, 0xb9, 0xc0, 0x0d, 0x22, 0xa8, 0x72, 0xc0, 0xed, 0x4a, 0x83, 0x06, 0xa4, 0x82,
-	0x7d, 0xe2, 0x30, 0x8f, 0x04, 0x8c, 0x7b, 0xba, 0xb7, 0xe0, 0x70, 0x87, 0xcb, 0x4b, 0x1c, 0x5e,
-	0xe9, 0x6a, 0xc9, 0xe1, 0xdc, 0xd9, 0xa0, 0x98, 0xf8, 0x0c, 0x13, 0xcf, 0xe3, 0x81, 0x94, 0x08,
-	0xfd, 0x34, 0x19, 0x95, 0x7a, 0x41, 0x84, 0x6a, 0x5e, 0x4e, 0xec, 0xf0, 0xc9, 0x16, 0x71, 0xb5,
-	0x09, 0x2a, 0x00, 0xf8, 0x34, 0x44, 0x5b, 0x93, 0xc5, 0x3a, 0xdd, 0xdc, 0xa6, 0x22, 0x40, 0xcf,
-	0xc1, 0xd9, 0xa1, 0xaa, 0xf0, 0xb9, 0x27, 0x28, 0xbc, 0x0f, 0x72, 0x4a, 0x7c, 0xc1, 0x28, 0x1b,
-	0x73, 0xa7, 0xaa, 0x25, 0x3b, 0x69, 0x2d, 0x6c, 0xa5, 0xaa, 0xe5, 0x77, 0x7e, 0x5f, 0xca, 0x7c,
-	0xfe, 0xf7, 0x65, 0xde, 0xa8, 0x6b, 0x19, 0x9a, 0x05, 0x05, 0xe9, 0xbb, 0x4a, 0x83, 0x87, 0x21,
-	0xa7, 0x9e, 0x07, 0x4f, 0x83, 0x2c, 0x6b, 0x49, 0xd3, 0x89, 0x7a, 0x96, 0xb5, 0xd0, 0x1a, 0x38,
-	0x17, 0xeb, 0xd3, 0x04, 0xcb, 0x60, 0x52, 0xbe, 0xa0, 0x06, 0x28, 0x26, 0x03, 0x48, 0x4d, 0x6d,
-	0x22, 0x9c, 0x5f, 0x57, 0xfd, 0xe8, 0xa5, 0x9e, 0xbc, 0xb2, 0xb1, 0x31, 0x34, 0xf9, 0x11, 0x00,
-	0x83, 0xcd, 0xd0, 0xae, 0xb3, 0xb6, 0xda, 0x39, 0x3b, 0xdc, 0x39, 0x5b, 0xed, 0xbd, 0xde, 0x39,
-	0x7b, 0x8d, 0x38, 0x54, 0x6b, 0xeb, 0x07, 0x94, 0xe8, 0x93, 0xa1, 0x91, 0x07, 0x03, 0x46, 0x91,
-	0x4f, 0x1c, 0x07, 0x19, 0xae, 0x0e, 0xa1, 0x65, 0x25, 0xda, 0xb5, 0x23, 0xd1, 0xd4, 0xd4, 0x21,
-	0xb6, 0x25, 0x50, 0x94, 0x68, 0xe1, 0x0c, 0x46, 0x45, 0xad, 0xb3, 0xe2, 0x50, 0xaf, 0xd9, 0x5f,
-	0x82, 0xf3, 0x20, 0x47, 0x64, 0x41, 0xbe, 0x7e, 0xbe, 0xae, 0xef, 0x90, 0x05, 0x4a, 0xc9, 0x32,
-	0x35, 0x02, 0xdd, 0x01, 0x33, 0xc3, 0xcf, 0x1f, 0x90, 0x80, 0x3a, 0x7c, 0xb0, 0xb6, 0x26, 0x98,
-	0x6a, 0xea, 0x92, 0xb6, 0xee, 0xdf, 0xa3, 0x32, 0xb0, 0x0e, 0x13, 0x6b, 0xfb, 0xbb, 0x71, 0xfb,
-	0x27, 0xcc, 0xa5, 0x41, 0xc7, 0x8f, 0x96, 0x1f, 0x16, 0x41, 0xde, 0x65, 0x2e, 0x5d, 0x0f, 0x6b,
-	0x91, 0x7f, 0x58, 0x78, 0xd6, 0xf1, 0xe9, 0xa8, 0xff, 0x40, 0xad, 0xfc, 0xab, 0xbf, 0x4e, 0x82,
-	0x49, 0xd9, 0x02, 0xdf, 0x1a, 0x20, 0xa7, 0xce, 0x2c, 0x9c, 0x4b, 0xde, 0x9d, 0xd1, 0x88, 0x98,
-	0xd7, 0x53, 0x74, 0xea, 0x37, 0xb9, 0xfa, 0xe6, 0xc7, 0xdf, 0x8f, 0x59, 0x0b, 0x96, 0xf0, 0x98,
-	0x3c, 0xc2, 0xf7, 0x06, 0x98, 0x8a, 0xce, 0x3b, 0x9c, 0x1f, 0xe3, 0x1e, 0x0b, 0x8f, 0x79, 0x23,
-	0x55, 0xaf, 0x66, 0x99, 0x93, 0x2c, 0x08, 0x96, 0xf1, 0xe1, 0x5f, 0x0f, 0xdc, 0x65, 0xad, 0x1e,
-	0x7c, 0x67, 0x80, 0xfc, 0x63, 0x26, 0x52, 0x00, 0xc5, 0x32, 0x35, 0x16, 0x28, 0x1e, 0x0f, 0x74,
-	0x45, 0x02, 0xcd, 0xc0, 0xe2, 0x18, 0x20, 0xf8, 0xd5, 0x00, 0x67, 0x62, 0xc7, 0x10, 0x56, 0xc6,
-	0x4c, 0x49, 0x3e, 0xe9, 0x66, 0xf5, 0x38, 0x12, 0xcd, 0x77, 0x5b, 0xf2, 0x55, 0xe1, 0xcd, 0xc3,
-	0xf9, 0x18, 0x15, 0xeb, 0x8d, 0xce, 0xba, 0x8a, 0x0d, 0xee, 0xaa, 0xff, 0x3d, 0xf8, 0xcd, 0x00,
-	0xd3, 0x23, 0xc7, 0x1b, 0x2e, 0xa4, 0x61, 0x88, 0x25, 0xc9, 0x5c, 0x3c, 0x9e, 0x28, 0x4a, 0x90,
-	0x44, 0xbf, 0x05, 0x17, 0x8f, 0x44, 0x8f, 0x62, 0x89, 0xbb, 0xd1, 0x55, 0x0f, 0x7e, 0x3f, 0x88,
-	0x1f, 0xa5, 0x27, 0x1d, 0x7e, 0x2c, 0xa9, 0xe9, 0xf0, 0xe3, 0x01, 0x45, 0xf7, 0x24, 0xfe, 0x32,
-	0x5c, 0x3a, 0x12, 0xdf, 0xd5, 0x52, 0xdc, 0xed, 0x7f, 0x10, 0x7a, 0xb5, 0x85, 0x9d, 0x3d, 0xcb,
-	0xd8, 0xdd, 0xb3, 0x8c, 0x3f, 0x7b, 0x96, 0xf1, 0x61, 0xdf, 0xca, 0xec, 0xee, 0x5b, 0x99, 0x9f,
-	0xfb, 0x56, 0xe6, 0xc5, 0xc5, 0xbe, 0xdf, 0xeb, 0x81, 0x63, 0x28, 0x12, 0x8d, 0x9c, 0xfc, 0x55,
-	0x5c, 0xf8, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xe6, 0x88, 0x56, 0x07, 0x08, 0x00, 0x00,
+func (m *QueryEntriesByMimetypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListEntry Queries a list of Entry items.
-	GetEntry(ctx context.Context, in *QueryGetEntryRequest, opts ...grpc.CallOption) (*QueryGetEntryResponse, error)
-	// ListEntry defines the ListEntry RPC.
-	ListEntry(ctx context.Context, in *QueryAllEntryRequest, opts ...grpc.CallOption) (*QueryAllEntryResponse, error)
-	// EntriesByAgency Queries a list of EntriesByAgency items.
-	EntriesByAgency(ctx context.Context, in *QueryEntriesByAgencyRequest, opts ...grpc.CallOption) (*QueryEntriesByAgencyResponse, error)
-	// EntriesByCategory Queries a list of EntriesByCategory items.
-	EntriesByCategory(ctx context.Context, in *QueryEntriesByCategoryRequest, opts ...grpc.CallOption) (*QueryEntriesByCategoryResponse, error)
-	// EntriesByMimetype Queries a list of EntriesByMimetype items.
-	EntriesByMimetype(ctx context.Context, in *QueryEntriesByMimetypeRequest, opts ...grpc.CallOption) (*QueryEntriesByMimetypeResponse, error)
+// QueryGetAgencyRequest defines the QueryGetAgencyRequest message.
+type QueryGetAgencyRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetAgencyRequest) Reset()         { *m = QueryGetAgencyRequest{} }
+func (m *QueryGetAgencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAgencyRequest) ProtoMessage()    {}
+func (*QueryGetAgencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{14}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetAgencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetAgencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAgencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAgencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAgencyRequest.Merge(m, src)
+}
+func (m *QueryGetAgencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAgencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAgencyRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetEntry(ctx context.Context, in *QueryGetEntryRequest, opts ...grpc.CallOption) (*QueryGetEntryResponse, error) {
-	out := new(QueryGetEntryResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/GetEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAgencyRequest proto.InternalMessageInfo
+
+func (m *QueryGetAgencyRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListEntry(ctx context.Context, in *QueryAllEntryRequest, opts ...grpc.CallOption) (*QueryAllEntryResponse, error) {
-	out := new(QueryAllEntryResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/ListEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetAgencyResponse defines the QueryGetAgencyResponse message.
+type QueryGetAgencyResponse struct {
+	Agency Agency `protobuf:"bytes,1,opt,name=agency,proto3" json:"agency"`
 }
 
-func (c *queryClient) EntriesByAgency(ctx context.Context, in *QueryEntriesByAgencyRequest, opts ...grpc.CallOption) (*QueryEntriesByAgencyResponse, error) {
-	out := new(QueryEntriesByAgencyResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntriesByAgency", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetAgencyResponse) Reset()         { *m = QueryGetAgencyResponse{} }
+func (m *QueryGetAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAgencyResponse) ProtoMessage()    {}
+func (*QueryGetAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{15}
+}
+func (m *QueryGetAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAgencyResponse.Merge(m, src)
+}
+func (m *QueryGetAgencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAgencyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EntriesByCategory(ctx context.Context, in *QueryEntriesByCategoryRequest, opts ...grpc.CallOption) (*QueryEntriesByCategoryResponse, error) {
-	out := new(QueryEntriesByCategoryResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntriesByCategory", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetAgencyResponse proto.InternalMessageInfo
+
+func (m *QueryGetAgencyResponse) GetAgency() Agency {
+	if m != nil {
+		return m.Agency
 	}
-	return out, nil
+	return Agency{}
 }
 
-func (c *queryClient) EntriesByMimetype(ctx context.Context, in *QueryEntriesByMimetypeRequest, opts ...grpc.CallOption) (*QueryEntriesByMimetypeResponse, error) {
-	out := new(QueryEntriesByMimetypeResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntriesByMimetype", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAllAgencyRequest defines the QueryAllAgencyRequest message.
+type QueryAllAgencyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAgencyRequest) Reset()         { *m = QueryAllAgencyRequest{} }
+func (m *QueryAllAgencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAgencyRequest) ProtoMessage()    {}
+func (*QueryAllAgencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{16}
+}
+func (m *QueryAllAgencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAgencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAgencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllAgencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAgencyRequest.Merge(m, src)
+}
+func (m *QueryAllAgencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAgencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAgencyRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListEntry Queries a list of Entry items.
-	GetEntry(context.Context, *QueryGetEntryRequest) (*QueryGetEntryResponse, error)
-	// ListEntry defines the ListEntry RPC.
-	ListEntry(context.Context, *QueryAllEntryRequest) (*QueryAllEntryResponse, error)
-	// EntriesByAgency Queries a list of EntriesByAgency items.
-	EntriesByAgency(context.Context, *QueryEntriesByAgencyRequest) (*QueryEntriesByAgencyResponse, error)
-	// EntriesByCategory Queries a list of EntriesByCategory items.
-	EntriesByCategory(context.Context, *QueryEntriesByCategoryRequest) (*QueryEntriesByCategoryResponse, error)
-	// EntriesByMimetype Queries a list of EntriesByMimetype items.
-	EntriesByMimetype(context.Context, *QueryEntriesByMimetypeRequest) (*QueryEntriesByMimetypeResponse, error)
+var xxx_messageInfo_QueryAllAgencyRequest proto.InternalMessageInfo
+
+func (m *QueryAllAgencyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryAllAgencyResponse defines the QueryAllAgencyResponse message.
+type QueryAllAgencyResponse struct {
+	Agency     []Agency            `protobuf:"bytes,1,rep,name=agency,proto3" json:"agency"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllAgencyResponse) Reset()         { *m = QueryAllAgencyResponse{} }
+func (m *QueryAllAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAgencyResponse) ProtoMessage()    {}
+func (*QueryAllAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{17}
 }
-func (*UnimplementedQueryServer) GetEntry(ctx context.Context, req *QueryGetEntryRequest) (*QueryGetEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+func (m *QueryAllAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListEntry(ctx context.Context, req *QueryAllEntryRequest) (*QueryAllEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListEntry not implemented")
+func (m *QueryAllAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EntriesByAgency(ctx context.Context, req *QueryEntriesByAgencyRequest) (*QueryEntriesByAgencyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EntriesByAgency not implemented")
+func (m *QueryAllAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAgencyResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EntriesByCategory(ctx context.Context, req *QueryEntriesByCategoryRequest) (*QueryEntriesByCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EntriesByCategory not implemented")
+func (m *QueryAllAgencyResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EntriesByMimetype(ctx context.Context, req *QueryEntriesByMimetypeRequest) (*QueryEntriesByMimetypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EntriesByMimetype not implemented")
+func (m *QueryAllAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAgencyResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryAllAgencyResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryAllAgencyResponse) GetAgency() []Agency {
+	if m != nil {
+		return m.Agency
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Query/GetEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetEntry(ctx, req.(*QueryGetEntryRequest))
+func (m *QueryAllAgencyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEntryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Query/ListEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListEntry(ctx, req.(*QueryAllEntryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEntryHistoryRequest defines the QueryEntryHistoryRequest message.
+type QueryEntryHistoryRequest struct {
+	EntryId    uint64             `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_EntriesByAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEntriesByAgencyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EntriesByAgency(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Query/EntriesByAgency",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EntriesByAgency(ctx, req.(*QueryEntriesByAgencyRequest))
+func (m *QueryEntryHistoryRequest) Reset()         { *m = QueryEntryHistoryRequest{} }
+func (m *QueryEntryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryHistoryRequest) ProtoMessage()    {}
+func (*QueryEntryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{18}
+}
+func (m *QueryEntryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEntryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryHistoryRequest.Merge(m, src)
+}
+func (m *QueryEntryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_EntriesByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEntriesByCategoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EntriesByCategory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Query/EntriesByCategory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EntriesByCategory(ctx, req.(*QueryEntriesByCategoryRequest))
+var xxx_messageInfo_QueryEntryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryEntryHistoryRequest) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_EntriesByMimetype_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEntriesByMimetypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EntriesByMimetype(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Query/EntriesByMimetype",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EntriesByMimetype(ctx, req.(*QueryEntriesByMimetypeRequest))
+func (m *QueryEntryHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "govchain.datasets.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetEntry",
-			Handler:    _Query_GetEntry_Handler,
-		},
-		{
-			MethodName: "ListEntry",
-			Handler:    _Query_ListEntry_Handler,
-		},
-		{
-			MethodName: "EntriesByAgency",
-			Handler:    _Query_EntriesByAgency_Handler,
-		},
-		{
-			MethodName: "EntriesByCategory",
-			Handler:    _Query_EntriesByCategory_Handler,
-		},
-		{
-			MethodName: "EntriesByMimetype",
-			Handler:    _Query_EntriesByMimetype_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "govchain/datasets/v1/query.proto",
+// QueryEntryHistoryResponse defines the QueryEntryHistoryResponse message.
+type QueryEntryHistoryResponse struct {
+	Revisions  []EntryRevision     `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntryHistoryResponse) Reset()         { *m = QueryEntryHistoryResponse{} }
+func (m *QueryEntryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryHistoryResponse) ProtoMessage()    {}
+func (*QueryEntryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{19}
+}
+func (m *QueryEntryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryHistoryResponse.Merge(m, src)
+}
+func (m *QueryEntryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryEntryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEntryHistoryResponse) GetRevisions() []EntryRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEntryHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEntryRevisionRequest defines the QueryEntryRevisionRequest message.
+type QueryEntryRevisionRequest struct {
+	EntryId  uint64 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEntryRevisionRequest) Reset()         { *m = QueryEntryRevisionRequest{} }
+func (m *QueryEntryRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryRevisionRequest) ProtoMessage()    {}
+func (*QueryEntryRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{20}
+}
+func (m *QueryEntryRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryRevisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEntryRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryRevisionRequest.Merge(m, src)
+}
+func (m *QueryEntryRevisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryRevisionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEntryRevisionRequest proto.InternalMessageInfo
+
+func (m *QueryEntryRevisionRequest) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntryRevisionRequest) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-func (m *QueryGetEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+// QueryEntryRevisionResponse defines the QueryEntryRevisionResponse message.
+type QueryEntryRevisionResponse struct {
+	Revision EntryRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision"`
+}
+
+func (m *QueryEntryRevisionResponse) Reset()         { *m = QueryEntryRevisionResponse{} }
+func (m *QueryEntryRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryRevisionResponse) ProtoMessage()    {}
+func (*QueryEntryRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{21}
+}
+func (m *QueryEntryRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryRevisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEntryRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryRevisionResponse.Merge(m, src)
+}
+func (m *QueryEntryRevisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryRevisionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEntryRevisionResponse proto.InternalMessageInfo
+
+func (m *QueryEntryRevisionResponse) GetRevision() EntryRevision {
+	if m != nil {
+		return m.Revision
 	}
-	return dAtA[:n], nil
+	return EntryRevision{}
 }
 
-func (m *QueryGetEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySearchEntriesRequest defines the QuerySearchEntriesRequest message.
+type QuerySearchEntriesRequest struct {
+	// query is the free text to search for, e.g. "flood 2024 budget".
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// pagination follows the offset, limit and count_total semantics of other
+	// listings; next_key encodes the offset of the next result.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySearchEntriesRequest) Reset()         { *m = QuerySearchEntriesRequest{} }
+func (m *QuerySearchEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchEntriesRequest) ProtoMessage()    {}
+func (*QuerySearchEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{22}
+}
+func (m *QuerySearchEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySearchEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchEntriesRequest.Merge(m, src)
+}
+func (m *QuerySearchEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchEntriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllEntryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySearchEntriesRequest proto.InternalMessageInfo
+
+func (m *QuerySearchEntriesRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllEntryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySearchEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QuerySearchEntriesResponse defines the QuerySearchEntriesResponse message.
+type QuerySearchEntriesResponse struct {
+	Results    []EntrySearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySearchEntriesResponse) Reset()         { *m = QuerySearchEntriesResponse{} }
+func (m *QuerySearchEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchEntriesResponse) ProtoMessage()    {}
+func (*QuerySearchEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{23}
+}
+func (m *QuerySearchEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySearchEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySearchEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchEntriesResponse.Merge(m, src)
+}
+func (m *QuerySearchEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchEntriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySearchEntriesResponse proto.InternalMessageInfo
+
+func (m *QuerySearchEntriesResponse) GetResults() []EntrySearchResult {
+	if m != nil {
+		return m.Results
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySearchEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Entry) > 0 {
-		for iNdEx := len(m.Entry) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// EntrySearchResult is an entry matching a search query. Results are ranked
+// by matched_terms, then by score.
+type EntrySearchResult struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+	// matched_terms is the number of distinct query terms found in the entry.
+	MatchedTerms uint32 `protobuf:"varint,2,opt,name=matched_terms,json=matchedTerms,proto3" json:"matched_terms,omitempty"`
+	// score is the sum of the weights of the matched terms.
+	Score uint64 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (m *QueryEntriesByAgencyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntrySearchResult) Reset()         { *m = EntrySearchResult{} }
+func (m *EntrySearchResult) String() string { return proto.CompactTextString(m) }
+func (*EntrySearchResult) ProtoMessage()    {}
+func (*EntrySearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{24}
+}
+func (m *EntrySearchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntrySearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntrySearchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEntriesByAgencyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EntrySearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntrySearchResult.Merge(m, src)
+}
+func (m *EntrySearchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntrySearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntrySearchResult.DiscardUnknown(m)
 }
 
-func (m *QueryEntriesByAgencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Agency) > 0 {
-		i -= len(m.Agency)
-		copy(dAtA[i:], m.Agency)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agency)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EntrySearchResult proto.InternalMessageInfo
+
+func (m *EntrySearchResult) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return len(dAtA) - i, nil
+	return Entry{}
 }
 
-func (m *QueryEntriesByAgencyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EntrySearchResult) GetMatchedTerms() uint32 {
+	if m != nil {
+		return m.MatchedTerms
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryEntriesByAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EntrySearchResult) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
 
-func (m *QueryEntriesByAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryEntryByCidRequest defines the QueryEntryByCidRequest message.
+type QueryEntryByCidRequest struct {
+	IpfsCid string `protobuf:"bytes,1,opt,name=ipfs_cid,json=ipfsCid,proto3" json:"ipfs_cid,omitempty"`
 }
 
-func (m *QueryEntriesByCategoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntryByCidRequest) Reset()         { *m = QueryEntryByCidRequest{} }
+func (m *QueryEntryByCidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryByCidRequest) ProtoMessage()    {}
+func (*QueryEntryByCidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{25}
 }
-
-func (m *QueryEntriesByCategoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntryByCidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryEntriesByCategoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEntryByCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryByCidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEntriesByCategoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEntryByCidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryByCidRequest.Merge(m, src)
 }
-
-func (m *QueryEntriesByCategoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEntryByCidRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryEntriesByCategoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEntryByCidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryByCidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEntriesByMimetypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEntryByCidRequest proto.InternalMessageInfo
+
+func (m *QueryEntryByCidRequest) GetIpfsCid() string {
+	if m != nil {
+		return m.IpfsCid
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEntriesByMimetypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEntryByCidResponse defines the QueryEntryByCidResponse message.
+type QueryEntryByCidResponse struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
 }
 
-func (m *QueryEntriesByMimetypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryEntryByCidResponse) Reset()         { *m = QueryEntryByCidResponse{} }
+func (m *QueryEntryByCidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryByCidResponse) ProtoMessage()    {}
+func (*QueryEntryByCidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{26}
+}
+func (m *QueryEntryByCidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryByCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryByCidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEntryByCidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryByCidResponse.Merge(m, src)
+}
+func (m *QueryEntryByCidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryByCidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryByCidResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEntriesByMimetypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEntryByCidResponse proto.InternalMessageInfo
+
+func (m *QueryEntryByCidResponse) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	return dAtA[:n], nil
+	return Entry{}
 }
 
-func (m *QueryEntriesByMimetypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEntryByChecksumRequest defines the QueryEntryByChecksumRequest message.
+type QueryEntryByChecksumRequest struct {
+	ChecksumSha_256 string `protobuf:"bytes,1,opt,name=checksum_sha_256,json=checksumSha256,proto3" json:"checksum_sha_256,omitempty"`
 }
 
-func (m *QueryEntriesByMimetypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEntryByChecksumRequest) Reset()         { *m = QueryEntryByChecksumRequest{} }
+func (m *QueryEntryByChecksumRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryByChecksumRequest) ProtoMessage()    {}
+func (*QueryEntryByChecksumRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{27}
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryEntryByChecksumRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEntryByChecksumRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryByChecksumRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEntryByChecksumRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryByChecksumRequest.Merge(m, src)
+}
+func (m *QueryEntryByChecksumRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryByChecksumRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryByChecksumRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEntryByChecksumRequest proto.InternalMessageInfo
+
+func (m *QueryEntryByChecksumRequest) GetChecksumSha_256() string {
+	if m != nil {
+		return m.ChecksumSha_256
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryGetEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
-	}
-	return n
+// QueryEntryByChecksumResponse defines the QueryEntryByChecksumResponse message.
+type QueryEntryByChecksumResponse struct {
+	Entry Entry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
 }
 
-func (m *QueryGetEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEntryByChecksumResponse) Reset()         { *m = QueryEntryByChecksumResponse{} }
+func (m *QueryEntryByChecksumResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryByChecksumResponse) ProtoMessage()    {}
+func (*QueryEntryByChecksumResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{28}
+}
+func (m *QueryEntryByChecksumResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryByChecksumResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryByChecksumResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Entry.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryEntryByChecksumResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryByChecksumResponse.Merge(m, src)
+}
+func (m *QueryEntryByChecksumResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryByChecksumResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryByChecksumResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllEntryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEntryByChecksumResponse proto.InternalMessageInfo
+
+func (m *QueryEntryByChecksumResponse) GetEntry() Entry {
+	if m != nil {
+		return m.Entry
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return Entry{}
+}
+
+// QueryDatasetStatsRequest defines the QueryDatasetStatsRequest message.
+type QueryDatasetStatsRequest struct {
+}
+
+func (m *QueryDatasetStatsRequest) Reset()         { *m = QueryDatasetStatsRequest{} }
+func (m *QueryDatasetStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDatasetStatsRequest) ProtoMessage()    {}
+func (*QueryDatasetStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{29}
+}
+func (m *QueryDatasetStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatasetStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatasetStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDatasetStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatasetStatsRequest.Merge(m, src)
+}
+func (m *QueryDatasetStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatasetStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatasetStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDatasetStatsRequest proto.InternalMessageInfo
+
+// QueryDatasetStatsResponse defines the QueryDatasetStatsResponse message.
+// Retracted entries are only counted in totals.retracted_entries.
+type QueryDatasetStatsResponse struct {
+	Totals     EntryTotals   `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals"`
+	ByAgency   []StatsBucket `protobuf:"bytes,2,rep,name=by_agency,json=byAgency,proto3" json:"by_agency"`
+	ByCategory []StatsBucket `protobuf:"bytes,3,rep,name=by_category,json=byCategory,proto3" json:"by_category"`
+	ByMimeType []StatsBucket `protobuf:"bytes,4,rep,name=by_mime_type,json=byMimeType,proto3" json:"by_mime_type"`
+	// by_created_month counts the entries by the UTC month of their creation,
+	// formatted as YYYY-MM.
+	ByCreatedMonth []StatsBucket `protobuf:"bytes,5,rep,name=by_created_month,json=byCreatedMonth,proto3" json:"by_created_month"`
+}
+
+func (m *QueryDatasetStatsResponse) Reset()         { *m = QueryDatasetStatsResponse{} }
+func (m *QueryDatasetStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDatasetStatsResponse) ProtoMessage()    {}
+func (*QueryDatasetStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{30}
+}
+func (m *QueryDatasetStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDatasetStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDatasetStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Entry) > 0 {
-		for _, e := range m.Entry {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDatasetStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDatasetStatsResponse.Merge(m, src)
+}
+func (m *QueryDatasetStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDatasetStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDatasetStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDatasetStatsResponse proto.InternalMessageInfo
+
+func (m *QueryDatasetStatsResponse) GetTotals() EntryTotals {
+	if m != nil {
+		return m.Totals
+	}
+	return EntryTotals{}
+}
+
+func (m *QueryDatasetStatsResponse) GetByAgency() []StatsBucket {
+	if m != nil {
+		return m.ByAgency
+	}
+	return nil
+}
+
+func (m *QueryDatasetStatsResponse) GetByCategory() []StatsBucket {
+	if m != nil {
+		return m.ByCategory
+	}
+	return nil
+}
+
+func (m *QueryDatasetStatsResponse) GetByMimeType() []StatsBucket {
+	if m != nil {
+		return m.ByMimeType
+	}
+	return nil
+}
+
+func (m *QueryDatasetStatsResponse) GetByCreatedMonth() []StatsBucket {
+	if m != nil {
+		return m.ByCreatedMonth
+	}
+	return nil
+}
+
+// QueryPeriodRootRequest defines the QueryPeriodRootRequest message.
+type QueryPeriodRootRequest struct {
+	PeriodId uint64 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+}
+
+func (m *QueryPeriodRootRequest) Reset()         { *m = QueryPeriodRootRequest{} }
+func (m *QueryPeriodRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodRootRequest) ProtoMessage()    {}
+func (*QueryPeriodRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{31}
+}
+func (m *QueryPeriodRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryPeriodRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodRootRequest.Merge(m, src)
+}
+func (m *QueryPeriodRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodRootRequest proto.InternalMessageInfo
+
+func (m *QueryPeriodRootRequest) GetPeriodId() uint64 {
+	if m != nil {
+		return m.PeriodId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryEntriesByAgencyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryPeriodRootResponse defines the QueryPeriodRootResponse message.
+type QueryPeriodRootResponse struct {
+	PeriodRoot PeriodRoot `protobuf:"bytes,1,opt,name=period_root,json=periodRoot,proto3" json:"period_root"`
+}
+
+func (m *QueryPeriodRootResponse) Reset()         { *m = QueryPeriodRootResponse{} }
+func (m *QueryPeriodRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodRootResponse) ProtoMessage()    {}
+func (*QueryPeriodRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{32}
+}
+func (m *QueryPeriodRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Agency)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryPeriodRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodRootResponse.Merge(m, src)
+}
+func (m *QueryPeriodRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodRootResponse proto.InternalMessageInfo
+
+func (m *QueryPeriodRootResponse) GetPeriodRoot() PeriodRoot {
+	if m != nil {
+		return m.PeriodRoot
 	}
-	return n
+	return PeriodRoot{}
 }
 
-func (m *QueryEntriesByAgencyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryEntryInclusionProofRequest defines the QueryEntryInclusionProofRequest message.
+type QueryEntryInclusionProofRequest struct {
+	PeriodId uint64 `protobuf:"varint,1,opt,name=period_id,json=periodId,proto3" json:"period_id,omitempty"`
+	EntryId  uint64 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// content_hash, when set, must be the content hash the entry was included
+	// with.
+	ContentHash []byte `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+}
+
+func (m *QueryEntryInclusionProofRequest) Reset()         { *m = QueryEntryInclusionProofRequest{} }
+func (m *QueryEntryInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryInclusionProofRequest) ProtoMessage()    {}
+func (*QueryEntryInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{33}
+}
+func (m *QueryEntryInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryEntryInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryEntryInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryInclusionProofRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEntriesByCategoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryEntryInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryEntryInclusionProofRequest) GetPeriodId() uint64 {
+	if m != nil {
+		return m.PeriodId
 	}
-	var l int
-	_ = l
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QueryEntryInclusionProofRequest) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *QueryEntryInclusionProofRequest) GetContentHash() []byte {
+	if m != nil {
+		return m.ContentHash
+	}
+	return nil
+}
+
+// QueryEntryInclusionProofResponse defines the QueryEntryInclusionProofResponse message.
+type QueryEntryInclusionProofResponse struct {
+	Proof EntryInclusionProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	// root is the Merkle root of the period the proof verifies against.
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *QueryEntryInclusionProofResponse) Reset()         { *m = QueryEntryInclusionProofResponse{} }
+func (m *QueryEntryInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryInclusionProofResponse) ProtoMessage()    {}
+func (*QueryEntryInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{34}
+}
+func (m *QueryEntryInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEntryInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryEntryInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntryInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryEntryInclusionProofResponse) GetProof() EntryInclusionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return EntryInclusionProof{}
+}
+
+func (m *QueryEntryInclusionProofResponse) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+// QueryPinnersRequest defines the QueryPinnersRequest message.
+type QueryPinnersRequest struct {
+	EntryId    uint64             `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPinnersRequest) Reset()         { *m = QueryPinnersRequest{} }
+func (m *QueryPinnersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPinnersRequest) ProtoMessage()    {}
+func (*QueryPinnersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{35}
+}
+func (m *QueryPinnersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPinnersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPinnersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPinnersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPinnersRequest.Merge(m, src)
+}
+func (m *QueryPinnersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPinnersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPinnersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPinnersRequest proto.InternalMessageInfo
+
+func (m *QueryPinnersRequest) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
+	}
+	return 0
+}
+
+func (m *QueryPinnersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPinnersResponse defines the QueryPinnersResponse message.
+type QueryPinnersResponse struct {
+	// attestations are the current pin attestations of the entry, by pinner
+	// address.
+	Attestations []PinAttestation    `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPinnersResponse) Reset()         { *m = QueryPinnersResponse{} }
+func (m *QueryPinnersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPinnersResponse) ProtoMessage()    {}
+func (*QueryPinnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{36}
+}
+func (m *QueryPinnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPinnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPinnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPinnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPinnersResponse.Merge(m, src)
+}
+func (m *QueryPinnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPinnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPinnersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPinnersResponse proto.InternalMessageInfo
+
+func (m *QueryPinnersResponse) GetAttestations() []PinAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *QueryPinnersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetPinnerRequest defines the QueryGetPinnerRequest message.
+type QueryGetPinnerRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetPinnerRequest) Reset()         { *m = QueryGetPinnerRequest{} }
+func (m *QueryGetPinnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPinnerRequest) ProtoMessage()    {}
+func (*QueryGetPinnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{37}
+}
+func (m *QueryGetPinnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPinnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPinnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPinnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPinnerRequest.Merge(m, src)
+}
+func (m *QueryGetPinnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPinnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPinnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPinnerRequest proto.InternalMessageInfo
+
+func (m *QueryGetPinnerRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetPinnerResponse defines the QueryGetPinnerResponse message.
+type QueryGetPinnerResponse struct {
+	Pinner Pinner `protobuf:"bytes,1,opt,name=pinner,proto3" json:"pinner"`
+}
+
+func (m *QueryGetPinnerResponse) Reset()         { *m = QueryGetPinnerResponse{} }
+func (m *QueryGetPinnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPinnerResponse) ProtoMessage()    {}
+func (*QueryGetPinnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{38}
+}
+func (m *QueryGetPinnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPinnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPinnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPinnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPinnerResponse.Merge(m, src)
+}
+func (m *QueryGetPinnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPinnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPinnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPinnerResponse proto.InternalMessageInfo
+
+func (m *QueryGetPinnerResponse) GetPinner() Pinner {
+	if m != nil {
+		return m.Pinner
+	}
+	return Pinner{}
+}
+
+// QueryAllowedPinnersRequest defines the QueryAllowedPinnersRequest message.
+type QueryAllowedPinnersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedPinnersRequest) Reset()         { *m = QueryAllowedPinnersRequest{} }
+func (m *QueryAllowedPinnersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedPinnersRequest) ProtoMessage()    {}
+func (*QueryAllowedPinnersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{39}
+}
+func (m *QueryAllowedPinnersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedPinnersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedPinnersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedPinnersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedPinnersRequest.Merge(m, src)
+}
+func (m *QueryAllowedPinnersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedPinnersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedPinnersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedPinnersRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedPinnersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllowedPinnersResponse defines the QueryAllowedPinnersResponse message.
+type QueryAllowedPinnersResponse struct {
+	// addresses are the allowed pinner addresses, in key order.
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllowedPinnersResponse) Reset()         { *m = QueryAllowedPinnersResponse{} }
+func (m *QueryAllowedPinnersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedPinnersResponse) ProtoMessage()    {}
+func (*QueryAllowedPinnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{40}
+}
+func (m *QueryAllowedPinnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedPinnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedPinnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllowedPinnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedPinnersResponse.Merge(m, src)
+}
+func (m *QueryAllowedPinnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedPinnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedPinnersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllowedPinnersResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedPinnersResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryAllowedPinnersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChallengesRequest defines the QueryChallengesRequest message.
+type QueryChallengesRequest struct {
+	Pinner     string             `protobuf:"bytes,1,opt,name=pinner,proto3" json:"pinner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengesRequest) Reset()         { *m = QueryChallengesRequest{} }
+func (m *QueryChallengesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengesRequest) ProtoMessage()    {}
+func (*QueryChallengesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{41}
+}
+func (m *QueryChallengesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengesRequest.Merge(m, src)
+}
+func (m *QueryChallengesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChallengesRequest proto.InternalMessageInfo
+
+func (m *QueryChallengesRequest) GetPinner() string {
+	if m != nil {
+		return m.Pinner
+	}
+	return ""
+}
+
+func (m *QueryChallengesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChallengesResponse defines the QueryChallengesResponse message.
+type QueryChallengesResponse struct {
+	// challenges are the open challenges of the pinner, by id.
+	Challenges []Challenge         `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChallengesResponse) Reset()         { *m = QueryChallengesResponse{} }
+func (m *QueryChallengesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChallengesResponse) ProtoMessage()    {}
+func (*QueryChallengesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{42}
+}
+func (m *QueryChallengesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChallengesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChallengesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChallengesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChallengesResponse.Merge(m, src)
+}
+func (m *QueryChallengesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChallengesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChallengesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChallengesResponse proto.InternalMessageInfo
+
+func (m *QueryChallengesResponse) GetChallenges() []Challenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+func (m *QueryChallengesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetChallengeRequest defines the QueryGetChallengeRequest message.
+type QueryGetChallengeRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetChallengeRequest) Reset()         { *m = QueryGetChallengeRequest{} }
+func (m *QueryGetChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChallengeRequest) ProtoMessage()    {}
+func (*QueryGetChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{43}
+}
+func (m *QueryGetChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChallengeRequest.Merge(m, src)
+}
+func (m *QueryGetChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryGetChallengeRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetChallengeResponse defines the QueryGetChallengeResponse message.
+type QueryGetChallengeResponse struct {
+	Challenge Challenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+}
+
+func (m *QueryGetChallengeResponse) Reset()         { *m = QueryGetChallengeResponse{} }
+func (m *QueryGetChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChallengeResponse) ProtoMessage()    {}
+func (*QueryGetChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56363c6e756e2454, []int{44}
+}
+func (m *QueryGetChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChallengeResponse.Merge(m, src)
+}
+func (m *QueryGetChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryGetChallengeResponse) GetChallenge() Challenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return Challenge{}
+}
+
+func init() {
+	proto.RegisterEnum("govchain.datasets.v1.EntryOrderBy", EntryOrderBy_name, EntryOrderBy_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "govchain.datasets.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "govchain.datasets.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetEntryRequest)(nil), "govchain.datasets.v1.QueryGetEntryRequest")
+	proto.RegisterType((*QueryGetEntryResponse)(nil), "govchain.datasets.v1.QueryGetEntryResponse")
+	proto.RegisterType((*EntryPins)(nil), "govchain.datasets.v1.EntryPins")
+	proto.RegisterType((*QueryAllEntryRequest)(nil), "govchain.datasets.v1.QueryAllEntryRequest")
+	proto.RegisterType((*EntryFilter)(nil), "govchain.datasets.v1.EntryFilter")
+	proto.RegisterType((*QueryAllEntryResponse)(nil), "govchain.datasets.v1.QueryAllEntryResponse")
+	proto.RegisterType((*QueryEntriesByAgencyRequest)(nil), "govchain.datasets.v1.QueryEntriesByAgencyRequest")
+	proto.RegisterType((*QueryEntriesByAgencyResponse)(nil), "govchain.datasets.v1.QueryEntriesByAgencyResponse")
+	proto.RegisterType((*QueryEntriesByCategoryRequest)(nil), "govchain.datasets.v1.QueryEntriesByCategoryRequest")
+	proto.RegisterType((*QueryEntriesByCategoryResponse)(nil), "govchain.datasets.v1.QueryEntriesByCategoryResponse")
+	proto.RegisterType((*QueryEntriesByMimetypeRequest)(nil), "govchain.datasets.v1.QueryEntriesByMimetypeRequest")
+	proto.RegisterType((*QueryEntriesByMimetypeResponse)(nil), "govchain.datasets.v1.QueryEntriesByMimetypeResponse")
+	proto.RegisterType((*QueryGetAgencyRequest)(nil), "govchain.datasets.v1.QueryGetAgencyRequest")
+	proto.RegisterType((*QueryGetAgencyResponse)(nil), "govchain.datasets.v1.QueryGetAgencyResponse")
+	proto.RegisterType((*QueryAllAgencyRequest)(nil), "govchain.datasets.v1.QueryAllAgencyRequest")
+	proto.RegisterType((*QueryAllAgencyResponse)(nil), "govchain.datasets.v1.QueryAllAgencyResponse")
+	proto.RegisterType((*QueryEntryHistoryRequest)(nil), "govchain.datasets.v1.QueryEntryHistoryRequest")
+	proto.RegisterType((*QueryEntryHistoryResponse)(nil), "govchain.datasets.v1.QueryEntryHistoryResponse")
+	proto.RegisterType((*QueryEntryRevisionRequest)(nil), "govchain.datasets.v1.QueryEntryRevisionRequest")
+	proto.RegisterType((*QueryEntryRevisionResponse)(nil), "govchain.datasets.v1.QueryEntryRevisionResponse")
+	proto.RegisterType((*QuerySearchEntriesRequest)(nil), "govchain.datasets.v1.QuerySearchEntriesRequest")
+	proto.RegisterType((*QuerySearchEntriesResponse)(nil), "govchain.datasets.v1.QuerySearchEntriesResponse")
+	proto.RegisterType((*EntrySearchResult)(nil), "govchain.datasets.v1.EntrySearchResult")
+	proto.RegisterType((*QueryEntryByCidRequest)(nil), "govchain.datasets.v1.QueryEntryByCidRequest")
+	proto.RegisterType((*QueryEntryByCidResponse)(nil), "govchain.datasets.v1.QueryEntryByCidResponse")
+	proto.RegisterType((*QueryEntryByChecksumRequest)(nil), "govchain.datasets.v1.QueryEntryByChecksumRequest")
+	proto.RegisterType((*QueryEntryByChecksumResponse)(nil), "govchain.datasets.v1.QueryEntryByChecksumResponse")
+	proto.RegisterType((*QueryDatasetStatsRequest)(nil), "govchain.datasets.v1.QueryDatasetStatsRequest")
+	proto.RegisterType((*QueryDatasetStatsResponse)(nil), "govchain.datasets.v1.QueryDatasetStatsResponse")
+	proto.RegisterType((*QueryPeriodRootRequest)(nil), "govchain.datasets.v1.QueryPeriodRootRequest")
+	proto.RegisterType((*QueryPeriodRootResponse)(nil), "govchain.datasets.v1.QueryPeriodRootResponse")
+	proto.RegisterType((*QueryEntryInclusionProofRequest)(nil), "govchain.datasets.v1.QueryEntryInclusionProofRequest")
+	proto.RegisterType((*QueryEntryInclusionProofResponse)(nil), "govchain.datasets.v1.QueryEntryInclusionProofResponse")
+	proto.RegisterType((*QueryPinnersRequest)(nil), "govchain.datasets.v1.QueryPinnersRequest")
+	proto.RegisterType((*QueryPinnersResponse)(nil), "govchain.datasets.v1.QueryPinnersResponse")
+	proto.RegisterType((*QueryGetPinnerRequest)(nil), "govchain.datasets.v1.QueryGetPinnerRequest")
+	proto.RegisterType((*QueryGetPinnerResponse)(nil), "govchain.datasets.v1.QueryGetPinnerResponse")
+	proto.RegisterType((*QueryAllowedPinnersRequest)(nil), "govchain.datasets.v1.QueryAllowedPinnersRequest")
+	proto.RegisterType((*QueryAllowedPinnersResponse)(nil), "govchain.datasets.v1.QueryAllowedPinnersResponse")
+	proto.RegisterType((*QueryChallengesRequest)(nil), "govchain.datasets.v1.QueryChallengesRequest")
+	proto.RegisterType((*QueryChallengesResponse)(nil), "govchain.datasets.v1.QueryChallengesResponse")
+	proto.RegisterType((*QueryGetChallengeRequest)(nil), "govchain.datasets.v1.QueryGetChallengeRequest")
+	proto.RegisterType((*QueryGetChallengeResponse)(nil), "govchain.datasets.v1.QueryGetChallengeResponse")
+}
+
+func init() { proto.RegisterFile("govchain/datasets/v1/query.proto", fileDescriptor_56363c6e756e2454) }
+
+var fileDescriptor_56363c6e756e2454 = []byte{
+	// 2318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x50, 0x5f, 0xe4, 0x48, 0x76, 0xe4, 0x89, 0xe2, 0xd2, 0x94, 0x22, 0xcb, 0x6b, 0xd7,
+	0x96, 0x15, 0x99, 0x6b, 0xd1, 0x96, 0xd2, 0x04, 0x09, 0x02, 0x7d, 0x59, 0x16, 0x10, 0x27, 0xca,
+	0x5a, 0x69, 0x91, 0x00, 0xc5, 0x76, 0xc9, 0x1d, 0x93, 0x8b, 0x90, 0xbb, 0xcc, 0xee, 0x4a, 0x15,
+	0xc1, 0xf2, 0xd0, 0x26, 0x45, 0xda, 0x02, 0x01, 0x5a, 0xb4, 0x08, 0x7a, 0x68, 0x80, 0xa0, 0x29,
+	0xd0, 0xf4, 0xd0, 0x0f, 0xf4, 0xd0, 0x53, 0x7b, 0xcf, 0xa5, 0x40, 0x80, 0xf6, 0xd0, 0x53, 0x51,
+	0xd8, 0x05, 0xfa, 0x6f, 0x14, 0x3b, 0xf3, 0x66, 0xbf, 0xb8, 0x5c, 0x92, 0x0e, 0x5b, 0xf8, 0x62,
+	0xef, 0xce, 0xbe, 0x37, 0xf3, 0x9b, 0x37, 0xef, 0xbd, 0x79, 0xef, 0x47, 0xe1, 0xe5, 0xaa, 0x75,
+	0x52, 0xa9, 0x69, 0x86, 0x29, 0xeb, 0x9a, 0xab, 0x39, 0xd4, 0x75, 0xe4, 0x93, 0x75, 0xf9, 0xdd,
+	0x63, 0x6a, 0xb7, 0x8a, 0x4d, 0xdb, 0x72, 0x2d, 0x32, 0x2f, 0x24, 0x8a, 0x42, 0xa2, 0x78, 0xb2,
+	0x5e, 0x38, 0xa7, 0x35, 0x0c, 0xd3, 0x92, 0xd9, 0xbf, 0x5c, 0xb0, 0xb0, 0x5a, 0xb1, 0x9c, 0x86,
+	0xe5, 0xc8, 0x65, 0xcd, 0xa1, 0x7c, 0x06, 0xf9, 0x64, 0xbd, 0x4c, 0x5d, 0x6d, 0x5d, 0x6e, 0x6a,
+	0x55, 0xc3, 0xd4, 0x5c, 0xc3, 0x32, 0x41, 0x76, 0xbe, 0x6a, 0x55, 0x2d, 0xf6, 0x28, 0x7b, 0x4f,
+	0x30, 0xba, 0x58, 0xb5, 0xac, 0x6a, 0x9d, 0xca, 0x5a, 0xd3, 0x90, 0x35, 0xd3, 0xb4, 0x5c, 0xa6,
+	0xe2, 0xc0, 0xd7, 0x4b, 0x89, 0x50, 0xb5, 0x2a, 0x35, 0x2b, 0xad, 0x54, 0x91, 0xa6, 0x66, 0x6b,
+	0x8d, 0xf4, 0x59, 0x9a, 0xd4, 0x36, 0x2c, 0x1d, 0x44, 0xa4, 0x64, 0x11, 0xc3, 0x34, 0x0d, 0xb3,
+	0x0a, 0x32, 0xc9, 0x76, 0x73, 0x5c, 0xcd, 0x75, 0x52, 0x24, 0x4a, 0x32, 0x35, 0x5d, 0x61, 0x59,
+	0x69, 0x1e, 0x93, 0x37, 0x3c, 0x33, 0x1d, 0x32, 0x7c, 0x0a, 0x7d, 0xf7, 0x98, 0x3a, 0xae, 0xf4,
+	0x75, 0xfc, 0x74, 0x64, 0xd4, 0x69, 0x5a, 0xa6, 0x43, 0xc9, 0x2b, 0x78, 0x8a, 0xef, 0x23, 0x8f,
+	0x96, 0xd1, 0xca, 0x4c, 0x69, 0xb1, 0x98, 0x74, 0x2e, 0x45, 0xae, 0xb5, 0x9d, 0xfb, 0xfc, 0x9f,
+	0x17, 0xc7, 0x3e, 0xfb, 0xcf, 0x1f, 0x56, 0x91, 0x02, 0x6a, 0xd2, 0x55, 0x3c, 0xcf, 0xe6, 0xdd,
+	0xa7, 0xee, 0x9e, 0x07, 0x02, 0xd6, 0x23, 0x67, 0x71, 0xc6, 0xd0, 0xd9, 0xa4, 0x13, 0x4a, 0xc6,
+	0xd0, 0xa5, 0x0f, 0x10, 0x7e, 0x26, 0x26, 0x08, 0x10, 0x9e, 0xc7, 0x93, 0x0c, 0x3e, 0x20, 0x58,
+	0x48, 0x42, 0x50, 0x2a, 0x32, 0x9d, 0xed, 0x09, 0x0f, 0x80, 0xc2, 0xe5, 0xc9, 0x02, 0xce, 0x35,
+	0x0d, 0x53, 0xad, 0x58, 0xc7, 0xa6, 0x9b, 0xcf, 0x2c, 0xa3, 0x95, 0x33, 0x4a, 0xb6, 0x69, 0x98,
+	0x3b, 0xde, 0x3b, 0xc9, 0xe3, 0x69, 0xcf, 0xb4, 0xd4, 0x76, 0xf2, 0xe3, 0xcb, 0xe3, 0x2b, 0x39,
+	0x45, 0xbc, 0x4a, 0xdf, 0xc4, 0x39, 0x36, 0xd9, 0xa1, 0x61, 0x3a, 0xe4, 0x02, 0xce, 0xb2, 0xc9,
+	0x54, 0x1f, 0xec, 0x34, 0x7b, 0x3f, 0xd0, 0x1f, 0x77, 0xfa, 0xef, 0x67, 0xc0, 0x22, 0x5b, 0xf5,
+	0x7a, 0xc4, 0x22, 0x77, 0x30, 0x0e, 0x1c, 0x16, 0x36, 0x7b, 0xb5, 0xc8, 0xbd, 0xbb, 0xe8, 0x79,
+	0x77, 0x91, 0xc7, 0x07, 0x78, 0x77, 0xf1, 0x50, 0xab, 0x52, 0xd0, 0x55, 0x42, 0x9a, 0xe4, 0x39,
+	0x7c, 0xce, 0x30, 0x2b, 0xf5, 0x63, 0x9d, 0xaa, 0x36, 0x75, 0x6d, 0xad, 0xe2, 0x52, 0x9d, 0xe1,
+	0xcb, 0x2a, 0x73, 0xf0, 0x41, 0x11, 0xe3, 0xe4, 0x05, 0x3c, 0xf5, 0xc0, 0xa8, 0xbb, 0xd4, 0xce,
+	0x8f, 0xb3, 0x05, 0x2f, 0x25, 0x9f, 0x2f, 0x03, 0x7a, 0x87, 0x09, 0x2a, 0xa0, 0x40, 0x5e, 0xc6,
+	0x59, 0xcb, 0xd6, 0xa9, 0xad, 0x96, 0x5b, 0xf9, 0x89, 0x65, 0xb4, 0x72, 0xb6, 0x24, 0xa5, 0x28,
+	0xbf, 0xee, 0x89, 0x6e, 0xb7, 0x94, 0x69, 0x8b, 0x3f, 0x48, 0x1f, 0x65, 0xf0, 0x4c, 0x68, 0x5a,
+	0x72, 0x1e, 0x4f, 0xf1, 0xa0, 0x62, 0x5b, 0xcf, 0x29, 0xf0, 0x46, 0x0a, 0x38, 0x5b, 0xd1, 0x5c,
+	0x5a, 0xb5, 0xec, 0x16, 0xdb, 0x45, 0x4e, 0xf1, 0xdf, 0xbd, 0x23, 0x68, 0x18, 0x0d, 0xaa, 0xba,
+	0xad, 0x26, 0x65, 0x1b, 0xc8, 0x29, 0x59, 0x6f, 0xe0, 0xa8, 0xd5, 0xa4, 0x64, 0x0d, 0x93, 0x86,
+	0x77, 0x3e, 0x36, 0xd5, 0x5c, 0xaa, 0xab, 0x35, 0x6a, 0x54, 0x6b, 0x2e, 0x43, 0x3a, 0xae, 0xcc,
+	0x35, 0x0c, 0x73, 0x87, 0x7f, 0xb8, 0xcb, 0xc6, 0x99, 0xb4, 0x76, 0x1a, 0x97, 0x9e, 0x04, 0x69,
+	0xed, 0xb4, 0x5b, 0xda, 0x30, 0xd5, 0xe3, 0xa6, 0x1e, 0x96, 0x9e, 0xf2, 0xe7, 0x7e, 0x93, 0x7f,
+	0x88, 0xce, 0x1d, 0x93, 0x9e, 0xf6, 0xe7, 0x8e, 0x48, 0x4b, 0x7f, 0x17, 0x91, 0x10, 0x38, 0x48,
+	0x77, 0x24, 0x8c, 0x0f, 0x15, 0x09, 0xfb, 0x11, 0xd7, 0xca, 0xb0, 0x93, 0xbe, 0xd6, 0xd7, 0xb5,
+	0xf8, 0xaa, 0x11, 0xdf, 0x7a, 0x01, 0x4f, 0x34, 0x0d, 0x93, 0xfb, 0xf4, 0x4c, 0xe9, 0x62, 0xca,
+	0x79, 0x7b, 0xd1, 0x03, 0x20, 0x98, 0x8a, 0xd4, 0xc1, 0x0b, 0x6c, 0x57, 0xde, 0x57, 0x83, 0x3a,
+	0xdb, 0xad, 0x2d, 0x76, 0xbe, 0xc2, 0xfb, 0x7b, 0x1d, 0xff, 0x9d, 0x04, 0xe8, 0x8f, 0x11, 0x15,
+	0xd2, 0x27, 0x08, 0x2f, 0x26, 0xaf, 0xff, 0xa4, 0x18, 0x57, 0x7a, 0x0f, 0xe1, 0x67, 0xa3, 0x10,
+	0x77, 0xc0, 0xd1, 0x85, 0x91, 0xc2, 0xb1, 0x80, 0x62, 0xb1, 0x30, 0x2a, 0x43, 0xfd, 0x12, 0xe1,
+	0xa5, 0x5e, 0x28, 0x9e, 0x18, 0x53, 0xbd, 0xdf, 0x65, 0xaa, 0x7b, 0x46, 0x83, 0x7a, 0x69, 0x40,
+	0x98, 0x2a, 0x92, 0x1a, 0x50, 0x2c, 0x35, 0xfc, 0xef, 0x6c, 0x15, 0xc0, 0x78, 0x62, 0x6c, 0x75,
+	0x2d, 0xb8, 0x58, 0xa3, 0x21, 0x17, 0x5c, 0xc1, 0x39, 0x76, 0x05, 0x1f, 0xe1, 0xf3, 0x71, 0x41,
+	0xd8, 0xc4, 0x8b, 0x91, 0xe0, 0xec, 0x59, 0x05, 0x70, 0x2d, 0xd8, 0x06, 0x68, 0x48, 0x6a, 0x90,
+	0xcd, 0xa2, 0xcb, 0x8f, 0xe8, 0xbe, 0x93, 0x3e, 0x46, 0x80, 0x3b, 0xb4, 0x42, 0x02, 0xee, 0xf1,
+	0xe1, 0x70, 0x8f, 0xce, 0xfe, 0x1d, 0x9c, 0xf7, 0x7d, 0xa4, 0x75, 0xd7, 0x70, 0xdc, 0x50, 0x40,
+	0xa7, 0x94, 0x17, 0xa3, 0xf2, 0xd1, 0xdf, 0x22, 0x7c, 0x21, 0x61, 0x7d, 0xb0, 0xd0, 0x3e, 0xce,
+	0xd9, 0xf4, 0xc4, 0x70, 0xbc, 0x82, 0x17, 0x8c, 0x74, 0x39, 0xc5, 0x45, 0x15, 0x90, 0x05, 0x5b,
+	0x05, 0xba, 0xa3, 0x33, 0x97, 0x12, 0x86, 0x2b, 0xd6, 0x1b, 0xc0, 0x5e, 0x05, 0x9c, 0x15, 0x68,
+	0xd8, 0xf2, 0x13, 0x8a, 0xff, 0x2e, 0x55, 0x70, 0x21, 0x69, 0x4e, 0xb0, 0xc1, 0x5e, 0x48, 0x93,
+	0xbb, 0xe1, 0x10, 0x26, 0x08, 0x16, 0x69, 0x01, 0xf0, 0xfb, 0x54, 0xb3, 0x2b, 0x35, 0xc8, 0x08,
+	0x02, 0xf8, 0x3c, 0x9e, 0x64, 0xfb, 0x87, 0x70, 0xe3, 0x2f, 0x23, 0x3b, 0xe3, 0xdf, 0x21, 0xd8,
+	0x60, 0x6c, 0x6d, 0xff, 0x90, 0xa7, 0x6d, 0xea, 0x1c, 0xd7, 0x5d, 0x71, 0xc4, 0xd7, 0x52, 0x2e,
+	0x6e, 0x3e, 0x85, 0xc2, 0xe4, 0x61, 0x8f, 0x42, 0x7b, 0x74, 0x87, 0xfc, 0x01, 0xc2, 0xe7, 0xba,
+	0x56, 0x7b, 0xfc, 0x4a, 0xff, 0x32, 0x3e, 0xd3, 0xd0, 0xdc, 0x4a, 0x8d, 0xea, 0xaa, 0x4b, 0xed,
+	0x86, 0x03, 0xe5, 0xf8, 0x2c, 0x0c, 0x1e, 0x79, 0x63, 0xde, 0x11, 0x38, 0x15, 0xcb, 0xe6, 0x85,
+	0xe2, 0x84, 0xc2, 0x5f, 0xa4, 0x5b, 0x90, 0x3c, 0xf8, 0xac, 0xad, 0x1d, 0x43, 0x0f, 0xf9, 0x9a,
+	0xd1, 0x7c, 0xe0, 0xa8, 0x15, 0x3f, 0x49, 0x4e, 0x7b, 0xef, 0x3b, 0x86, 0x2e, 0x29, 0xf8, 0x2b,
+	0x5d, 0x4a, 0x5f, 0xb2, 0x5b, 0x91, 0xf6, 0x43, 0xf5, 0x91, 0x37, 0x67, 0x8d, 0x56, 0xde, 0x71,
+	0x8e, 0x1b, 0x02, 0xcd, 0x0a, 0x9e, 0xab, 0xc0, 0x90, 0xea, 0xd4, 0x34, 0xb5, 0xb4, 0xb1, 0x09,
+	0xa8, 0xce, 0x8a, 0xf1, 0xfb, 0x35, 0xad, 0xb4, 0xb1, 0x29, 0x7d, 0x23, 0x54, 0xe8, 0x44, 0x26,
+	0xfa, 0xb2, 0x08, 0x0b, 0x90, 0xc8, 0x76, 0xb9, 0xd8, 0x7d, 0xaf, 0xeb, 0x14, 0xed, 0xe3, 0x8f,
+	0xc6, 0xc1, 0xfb, 0xa3, 0x1f, 0x83, 0x2e, 0xd2, 0xb5, 0x5c, 0xad, 0x2e, 0xba, 0xc8, 0xb4, 0x2e,
+	0xe3, 0x88, 0x09, 0x8a, 0x64, 0xcc, 0xd5, 0xc8, 0x2e, 0xce, 0x95, 0x5b, 0x2a, 0xe4, 0xf2, 0x0c,
+	0xf3, 0xe1, 0x1e, 0x73, 0xb0, 0x85, 0xb7, 0x8f, 0x2b, 0xef, 0x50, 0xe1, 0xbd, 0xd9, 0x32, 0x94,
+	0x7a, 0xe4, 0x2e, 0x9e, 0x29, 0xb7, 0x54, 0xbf, 0x82, 0x1a, 0x1f, 0x6e, 0x1e, 0x5c, 0xf6, 0x2b,
+	0x21, 0x72, 0x80, 0x67, 0xcb, 0x2d, 0x35, 0x28, 0x30, 0x26, 0x86, 0x9e, 0xea, 0x9e, 0xa8, 0x45,
+	0xde, 0xc0, 0x73, 0x1e, 0x28, 0xe8, 0x3b, 0x1a, 0x96, 0xe9, 0xd6, 0xf2, 0x93, 0xc3, 0x4d, 0x77,
+	0xb6, 0xdc, 0x82, 0xf6, 0xe4, 0x9e, 0xa7, 0x2e, 0x6d, 0x80, 0x4f, 0x1f, 0x32, 0x7a, 0x41, 0xb1,
+	0x2c, 0x37, 0x54, 0x15, 0x71, 0xce, 0x21, 0x48, 0xa0, 0x59, 0x3e, 0x70, 0xa0, 0x4b, 0x65, 0xf0,
+	0xea, 0xb0, 0x9a, 0x9f, 0x41, 0x66, 0x40, 0xcf, 0xb6, 0x2c, 0x17, 0x4e, 0x71, 0xb9, 0x07, 0x17,
+	0xe0, 0xab, 0x8b, 0xdd, 0x36, 0xfd, 0x11, 0xe9, 0x3b, 0xf8, 0x62, 0xe0, 0x9c, 0x07, 0x5e, 0x37,
+	0xea, 0xe5, 0xce, 0x43, 0xdb, 0xb2, 0x1e, 0x0c, 0x82, 0x31, 0x72, 0x01, 0x64, 0xa2, 0x17, 0xc0,
+	0x25, 0x3c, 0x5b, 0xb1, 0x4c, 0x97, 0x9a, 0xae, 0x5a, 0xd3, 0x9c, 0x1a, 0x0b, 0xf3, 0x59, 0x65,
+	0x06, 0xc6, 0xee, 0x6a, 0x4e, 0x4d, 0xea, 0xe0, 0xe5, 0xde, 0xab, 0xfb, 0xb7, 0xc1, 0x64, 0xd3,
+	0x1b, 0x80, 0x4d, 0x5e, 0x4f, 0x71, 0xd5, 0xe8, 0x0c, 0x22, 0x58, 0x98, 0x36, 0x21, 0x78, 0x82,
+	0x99, 0x2a, 0xc3, 0x50, 0xb0, 0x67, 0xe9, 0x54, 0x70, 0x2c, 0x9c, 0x0a, 0xf8, 0x3f, 0x16, 0x01,
+	0xbf, 0x47, 0x40, 0x3a, 0xf8, 0x4b, 0xc3, 0x6e, 0x5f, 0xc3, 0xb3, 0x9a, 0xeb, 0x52, 0x07, 0x38,
+	0x2f, 0xb8, 0x1f, 0xae, 0xf4, 0x38, 0x59, 0xc3, 0xdc, 0x0a, 0x84, 0x61, 0xbf, 0x11, 0xfd, 0xd1,
+	0xdd, 0x10, 0xeb, 0x41, 0xd5, 0xca, 0x31, 0x0b, 0x6b, 0xe5, 0xf1, 0xb4, 0xa6, 0xeb, 0x36, 0x75,
+	0x1c, 0x91, 0x95, 0xe1, 0x35, 0x5c, 0xbf, 0x0a, 0x95, 0xa0, 0x0e, 0xe4, 0xf4, 0x4b, 0x1f, 0x16,
+	0x8b, 0xc9, 0x88, 0xd4, 0xc3, 0x35, 0x24, 0x1d, 0xae, 0xd6, 0xad, 0x7a, 0xdd, 0xfa, 0x36, 0xd5,
+	0x63, 0x67, 0x37, 0xaa, 0x22, 0xf6, 0x7d, 0x04, 0xe9, 0x3f, 0xbe, 0x0c, 0xec, 0x60, 0x11, 0xe7,
+	0x60, 0x9b, 0x94, 0x1f, 0x52, 0x4e, 0x09, 0x06, 0x46, 0x67, 0xf5, 0x53, 0x30, 0xe1, 0x4e, 0x4d,
+	0xab, 0xd7, 0xa9, 0x59, 0x0d, 0x0a, 0x98, 0xf3, 0x11, 0x13, 0xe6, 0x84, 0x79, 0x46, 0xe6, 0xa1,
+	0xbf, 0x41, 0x90, 0x7d, 0xc2, 0x4b, 0xfb, 0x21, 0x89, 0x2b, 0xfe, 0x28, 0xb8, 0x68, 0x0f, 0xee,
+	0xc1, 0xd7, 0x16, 0xb9, 0x27, 0x50, 0x1c, 0x9d, 0x95, 0x56, 0xe1, 0x22, 0xdc, 0xa7, 0xae, 0xbf,
+	0x5e, 0x2f, 0x5e, 0xf3, 0x5b, 0x70, 0x2f, 0x46, 0x65, 0x61, 0x63, 0x3b, 0x38, 0xe7, 0xe3, 0x03,
+	0xe7, 0x19, 0x70, 0x5f, 0x81, 0xde, 0xea, 0x77, 0x11, 0x9e, 0x0d, 0x53, 0x6c, 0xe4, 0x19, 0x7c,
+	0x6e, 0xef, 0xb5, 0x23, 0xe5, 0x2d, 0xf5, 0x75, 0x65, 0x77, 0x4f, 0x51, 0xb7, 0xdf, 0x52, 0x0f,
+	0x76, 0xe7, 0xc6, 0x48, 0x01, 0x9f, 0x8f, 0x0d, 0xef, 0x28, 0x7b, 0x5b, 0x47, 0x7b, 0xbb, 0x73,
+	0x88, 0x2c, 0xe2, 0x7c, 0xec, 0xdb, 0x9d, 0x83, 0x57, 0xf7, 0xd4, 0xfb, 0x07, 0x6f, 0xef, 0xcd,
+	0x65, 0x12, 0x34, 0xdf, 0x3c, 0xdc, 0x65, 0x9a, 0xe3, 0x85, 0x89, 0x1f, 0x7c, 0xba, 0x34, 0x56,
+	0xfa, 0xc5, 0x02, 0x9e, 0x64, 0xdb, 0x24, 0xef, 0x21, 0x3c, 0xc5, 0xd9, 0x60, 0xb2, 0x92, 0xbc,
+	0x95, 0x6e, 0xf2, 0xb9, 0x70, 0x7d, 0x00, 0x49, 0x6e, 0x32, 0xe9, 0xca, 0xf7, 0xfe, 0xf6, 0xef,
+	0x9f, 0x66, 0x96, 0xc8, 0xa2, 0x9c, 0x42, 0xba, 0x93, 0x0f, 0x11, 0xce, 0x0a, 0x22, 0x99, 0xac,
+	0xa6, 0xcc, 0x1e, 0xa3, 0xa5, 0x0b, 0xcf, 0x0d, 0x24, 0x0b, 0x58, 0x56, 0x18, 0x16, 0x89, 0x2c,
+	0x27, 0x63, 0x61, 0x49, 0x5c, 0x6e, 0x1b, 0x7a, 0x87, 0xfc, 0x10, 0xe1, 0xdc, 0xab, 0x86, 0x33,
+	0x00, 0xa0, 0x18, 0x2b, 0x9c, 0x0a, 0x28, 0x4e, 0x10, 0x4a, 0x97, 0x19, 0xa0, 0x67, 0xc9, 0x42,
+	0x0a, 0x20, 0xf2, 0x47, 0x84, 0x9f, 0x8a, 0x91, 0x60, 0x64, 0x3d, 0x65, 0x95, 0x64, 0xc2, 0xae,
+	0x50, 0x1a, 0x46, 0x05, 0xf0, 0x7d, 0x8d, 0xe1, 0x2b, 0x91, 0x9b, 0xbd, 0xf1, 0x19, 0xd4, 0x51,
+	0xfd, 0x52, 0x4f, 0x6e, 0xf3, 0xff, 0x3b, 0xe4, 0xcf, 0xd0, 0x30, 0x44, 0x08, 0x29, 0x72, 0x6b,
+	0x10, 0x0c, 0x31, 0x12, 0xad, 0x70, 0x7b, 0x38, 0x25, 0x80, 0xfe, 0x12, 0x83, 0xbe, 0x49, 0x6e,
+	0xf7, 0x85, 0x2e, 0xea, 0x4b, 0xb9, 0x2d, 0x9e, 0x3a, 0xe4, 0x2f, 0x61, 0xf8, 0x82, 0x23, 0x1a,
+	0x0c, 0x7e, 0x8c, 0xd8, 0x1a, 0x0c, 0x7e, 0x9c, 0x86, 0x92, 0x5e, 0x66, 0xf0, 0x9f, 0x27, 0x1b,
+	0x7d, 0xe1, 0x37, 0x40, 0x55, 0x6e, 0xfb, 0xe5, 0x6d, 0x87, 0xfc, 0x04, 0xe1, 0x9c, 0x4f, 0x0b,
+	0x91, 0x3e, 0x41, 0x12, 0xf5, 0x93, 0xb5, 0xc1, 0x84, 0x01, 0xe7, 0x75, 0x86, 0xf3, 0x32, 0xb9,
+	0x24, 0xa7, 0xfc, 0xec, 0xc6, 0x63, 0xea, 0x43, 0x84, 0xb1, 0x17, 0x53, 0x03, 0x80, 0x8a, 0x73,
+	0x4f, 0xa9, 0xa0, 0xba, 0x68, 0xa4, 0x7e, 0x39, 0x07, 0x08, 0xa3, 0x5f, 0x8b, 0x3c, 0x0c, 0x1c,
+	0x0b, 0x29, 0xf6, 0x39, 0xa9, 0x18, 0x19, 0x54, 0x90, 0x07, 0x96, 0x07, 0x5c, 0x9b, 0x0c, 0xd7,
+	0x4d, 0x52, 0x4c, 0xcd, 0x3f, 0xa2, 0xb6, 0xec, 0xc8, 0x35, 0x00, 0xf6, 0x27, 0x84, 0xcf, 0x44,
+	0xb8, 0x0c, 0xd2, 0x77, 0xe9, 0x18, 0x11, 0x53, 0xb8, 0x39, 0xb8, 0x02, 0x80, 0xdd, 0x66, 0x60,
+	0x5f, 0x22, 0x2f, 0x0e, 0x08, 0x56, 0xf0, 0x2a, 0x72, 0x5b, 0x3c, 0x75, 0xc8, 0x67, 0x08, 0x9f,
+	0x89, 0x50, 0x1c, 0xa9, 0xc0, 0x93, 0x88, 0x98, 0x54, 0xe0, 0x89, 0xec, 0x89, 0x74, 0x9b, 0x01,
+	0x2f, 0x92, 0xb5, 0x64, 0xe0, 0x0e, 0x53, 0x52, 0x21, 0x82, 0xe4, 0x36, 0xab, 0x21, 0x3a, 0xe4,
+	0x13, 0x84, 0x71, 0x40, 0x0f, 0x90, 0xb5, 0x7e, 0xf6, 0x0a, 0x53, 0x0f, 0x85, 0x1b, 0x03, 0x4a,
+	0x03, 0xc2, 0x0d, 0x86, 0x50, 0x26, 0x37, 0x52, 0x4c, 0xcb, 0x32, 0x93, 0xa1, 0xcb, 0x6d, 0xc1,
+	0x69, 0xb0, 0x9c, 0xfa, 0x54, 0x8c, 0x24, 0xe8, 0x7b, 0x11, 0x74, 0x33, 0x13, 0x7d, 0x2f, 0x82,
+	0x04, 0x0e, 0x62, 0x20, 0x67, 0x60, 0x88, 0x41, 0x51, 0x6e, 0xc7, 0xc9, 0x8f, 0x0e, 0xf9, 0x19,
+	0xc2, 0xb3, 0x61, 0xb6, 0x21, 0x35, 0xde, 0x12, 0x38, 0x8b, 0xd4, 0x78, 0x4b, 0xa2, 0x31, 0xfa,
+	0x5d, 0xaf, 0xec, 0x67, 0x78, 0x76, 0xf0, 0x41, 0x0b, 0x9c, 0x7a, 0xf0, 0x5d, 0xfd, 0x79, 0xea,
+	0xc1, 0x77, 0xb7, 0xe5, 0xfd, 0x0e, 0x9e, 0x77, 0xcd, 0x72, 0xdb, 0x6f, 0xa7, 0x3b, 0xb2, 0xd7,
+	0x87, 0x92, 0xbf, 0x22, 0xfc, 0x74, 0x42, 0x03, 0x4b, 0x36, 0xfa, 0x9d, 0x64, 0x62, 0xc3, 0x5e,
+	0xd8, 0x1c, 0x56, 0x0d, 0xd0, 0xef, 0x33, 0xf4, 0x5b, 0xe4, 0x95, 0x81, 0xd1, 0x77, 0x25, 0x09,
+	0xde, 0x6b, 0xff, 0x1c, 0xe1, 0x69, 0x68, 0x98, 0x48, 0x6a, 0x29, 0x19, 0xe9, 0xdd, 0x0a, 0xab,
+	0x83, 0x88, 0x3e, 0x66, 0xaa, 0x85, 0x5f, 0xfb, 0xc9, 0x47, 0xfc, 0xe2, 0xe4, 0xd3, 0xf5, 0xbb,
+	0x38, 0x23, 0x8d, 0x6e, 0xbf, 0x8b, 0x33, 0xda, 0xe2, 0x4a, 0x45, 0x06, 0x70, 0x85, 0x5c, 0x95,
+	0x7b, 0xfe, 0x19, 0x09, 0xb5, 0xe5, 0x36, 0xf4, 0x8c, 0x1d, 0xf2, 0x2b, 0x84, 0xcf, 0x46, 0x7b,
+	0x4d, 0x72, 0x33, 0xfd, 0x52, 0xec, 0xee, 0x7e, 0x0b, 0xeb, 0x43, 0x68, 0x00, 0xce, 0x1b, 0x0c,
+	0xe7, 0x35, 0xf2, 0xd5, 0x1e, 0x77, 0x29, 0xd7, 0x52, 0x85, 0xfd, 0x3e, 0x45, 0x18, 0x07, 0x1d,
+	0x61, 0x6a, 0x34, 0x75, 0xf5, 0xac, 0xa9, 0xd1, 0xd4, 0xdd, 0x66, 0xf6, 0xab, 0x4e, 0x85, 0x09,
+	0xf9, 0xff, 0x1d, 0x39, 0xd4, 0x59, 0x7e, 0x8c, 0xf0, 0x6c, 0xb8, 0xc1, 0x4b, 0x4d, 0x45, 0x09,
+	0x5d, 0x63, 0x6a, 0x2a, 0x4a, 0xea, 0x1c, 0xa5, 0x35, 0x86, 0xf5, 0x2a, 0xb9, 0x92, 0x8c, 0xd5,
+	0xc7, 0xc6, 0x4a, 0xa5, 0xed, 0x5b, 0x9f, 0x3f, 0x5c, 0x42, 0x5f, 0x3c, 0x5c, 0x42, 0xff, 0x7a,
+	0xb8, 0x84, 0x7e, 0xfc, 0x68, 0x69, 0xec, 0x8b, 0x47, 0x4b, 0x63, 0xff, 0x78, 0xb4, 0x34, 0xf6,
+	0xf6, 0x05, 0x5f, 0xfd, 0x34, 0x98, 0xc0, 0xab, 0xf9, 0x9c, 0xf2, 0x14, 0xfb, 0x73, 0xa1, 0x5b,
+	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x88, 0x24, 0x60, 0x19, 0xac, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListEntry Queries a list of Entry items.
+	GetEntry(ctx context.Context, in *QueryGetEntryRequest, opts ...grpc.CallOption) (*QueryGetEntryResponse, error)
+	// ListEntry defines the ListEntry RPC.
+	ListEntry(ctx context.Context, in *QueryAllEntryRequest, opts ...grpc.CallOption) (*QueryAllEntryResponse, error)
+	// EntriesByAgency Queries a list of EntriesByAgency items.
+	EntriesByAgency(ctx context.Context, in *QueryEntriesByAgencyRequest, opts ...grpc.CallOption) (*QueryEntriesByAgencyResponse, error)
+	// EntriesByCategory Queries a list of EntriesByCategory items.
+	EntriesByCategory(ctx context.Context, in *QueryEntriesByCategoryRequest, opts ...grpc.CallOption) (*QueryEntriesByCategoryResponse, error)
+	// EntriesByMimetype Queries a list of EntriesByMimetype items.
+	EntriesByMimetype(ctx context.Context, in *QueryEntriesByMimetypeRequest, opts ...grpc.CallOption) (*QueryEntriesByMimetypeResponse, error)
+	// GetAgency Queries a registered agency by id.
+	GetAgency(ctx context.Context, in *QueryGetAgencyRequest, opts ...grpc.CallOption) (*QueryGetAgencyResponse, error)
+	// ListAgency Queries a list of registered agencies.
+	ListAgency(ctx context.Context, in *QueryAllAgencyRequest, opts ...grpc.CallOption) (*QueryAllAgencyResponse, error)
+	// EntryHistory Queries the revisions of an entry, oldest first.
+	EntryHistory(ctx context.Context, in *QueryEntryHistoryRequest, opts ...grpc.CallOption) (*QueryEntryHistoryResponse, error)
+	// EntryRevision Queries a single revision of an entry.
+	EntryRevision(ctx context.Context, in *QueryEntryRevisionRequest, opts ...grpc.CallOption) (*QueryEntryRevisionResponse, error)
+	// SearchEntries Queries the entries matching the terms of a full-text query
+	// over their title, description, agency and category, best match first.
+	// Only the 1000 most recent entries of each term are ranked.
+	SearchEntries(ctx context.Context, in *QuerySearchEntriesRequest, opts ...grpc.CallOption) (*QuerySearchEntriesResponse, error)
+	// EntryByCid Queries the entry that registered an IPFS CID.
+	EntryByCid(ctx context.Context, in *QueryEntryByCidRequest, opts ...grpc.CallOption) (*QueryEntryByCidResponse, error)
+	// EntryByChecksum Queries the entry that registered a SHA-256 checksum.
+	EntryByChecksum(ctx context.Context, in *QueryEntryByChecksumRequest, opts ...grpc.CallOption) (*QueryEntryByChecksumResponse, error)
+	// DatasetStats Queries the entry counters maintained by the module.
+	DatasetStats(ctx context.Context, in *QueryDatasetStatsRequest, opts ...grpc.CallOption) (*QueryDatasetStatsResponse, error)
+	// PeriodRoot Queries the Merkle root snapshot of a closed reporting period.
+	PeriodRoot(ctx context.Context, in *QueryPeriodRootRequest, opts ...grpc.CallOption) (*QueryPeriodRootResponse, error)
+	// EntryInclusionProof Queries the proof that an entry is included in the
+	// Merkle root of a closed reporting period.
+	EntryInclusionProof(ctx context.Context, in *QueryEntryInclusionProofRequest, opts ...grpc.CallOption) (*QueryEntryInclusionProofResponse, error)
+	// Pinners Queries the current pin attestations of an entry.
+	Pinners(ctx context.Context, in *QueryPinnersRequest, opts ...grpc.CallOption) (*QueryPinnersResponse, error)
+	// GetPinner Queries a registered pinner by its address.
+	GetPinner(ctx context.Context, in *QueryGetPinnerRequest, opts ...grpc.CallOption) (*QueryGetPinnerResponse, error)
+	// AllowedPinners Queries the addresses allowed to register as pinners and
+	// to attest to pins.
+	AllowedPinners(ctx context.Context, in *QueryAllowedPinnersRequest, opts ...grpc.CallOption) (*QueryAllowedPinnersResponse, error)
+	// Challenges Queries the open challenges of a pinner.
+	Challenges(ctx context.Context, in *QueryChallengesRequest, opts ...grpc.CallOption) (*QueryChallengesResponse, error)
+	// GetChallenge Queries an open challenge by its id.
+	GetChallenge(ctx context.Context, in *QueryGetChallengeRequest, opts ...grpc.CallOption) (*QueryGetChallengeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetEntry(ctx context.Context, in *QueryGetEntryRequest, opts ...grpc.CallOption) (*QueryGetEntryResponse, error) {
+	out := new(QueryGetEntryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/GetEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListEntry(ctx context.Context, in *QueryAllEntryRequest, opts ...grpc.CallOption) (*QueryAllEntryResponse, error) {
+	out := new(QueryAllEntryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/ListEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntriesByAgency(ctx context.Context, in *QueryEntriesByAgencyRequest, opts ...grpc.CallOption) (*QueryEntriesByAgencyResponse, error) {
+	out := new(QueryEntriesByAgencyResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntriesByAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntriesByCategory(ctx context.Context, in *QueryEntriesByCategoryRequest, opts ...grpc.CallOption) (*QueryEntriesByCategoryResponse, error) {
+	out := new(QueryEntriesByCategoryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntriesByCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntriesByMimetype(ctx context.Context, in *QueryEntriesByMimetypeRequest, opts ...grpc.CallOption) (*QueryEntriesByMimetypeResponse, error) {
+	out := new(QueryEntriesByMimetypeResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntriesByMimetype", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAgency(ctx context.Context, in *QueryGetAgencyRequest, opts ...grpc.CallOption) (*QueryGetAgencyResponse, error) {
+	out := new(QueryGetAgencyResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/GetAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAgency(ctx context.Context, in *QueryAllAgencyRequest, opts ...grpc.CallOption) (*QueryAllAgencyResponse, error) {
+	out := new(QueryAllAgencyResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/ListAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntryHistory(ctx context.Context, in *QueryEntryHistoryRequest, opts ...grpc.CallOption) (*QueryEntryHistoryResponse, error) {
+	out := new(QueryEntryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntryRevision(ctx context.Context, in *QueryEntryRevisionRequest, opts ...grpc.CallOption) (*QueryEntryRevisionResponse, error) {
+	out := new(QueryEntryRevisionResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntryRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchEntries(ctx context.Context, in *QuerySearchEntriesRequest, opts ...grpc.CallOption) (*QuerySearchEntriesResponse, error) {
+	out := new(QuerySearchEntriesResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/SearchEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntryByCid(ctx context.Context, in *QueryEntryByCidRequest, opts ...grpc.CallOption) (*QueryEntryByCidResponse, error) {
+	out := new(QueryEntryByCidResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntryByCid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntryByChecksum(ctx context.Context, in *QueryEntryByChecksumRequest, opts ...grpc.CallOption) (*QueryEntryByChecksumResponse, error) {
+	out := new(QueryEntryByChecksumResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntryByChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DatasetStats(ctx context.Context, in *QueryDatasetStatsRequest, opts ...grpc.CallOption) (*QueryDatasetStatsResponse, error) {
+	out := new(QueryDatasetStatsResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/DatasetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PeriodRoot(ctx context.Context, in *QueryPeriodRootRequest, opts ...grpc.CallOption) (*QueryPeriodRootResponse, error) {
+	out := new(QueryPeriodRootResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/PeriodRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntryInclusionProof(ctx context.Context, in *QueryEntryInclusionProofRequest, opts ...grpc.CallOption) (*QueryEntryInclusionProofResponse, error) {
+	out := new(QueryEntryInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/EntryInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Pinners(ctx context.Context, in *QueryPinnersRequest, opts ...grpc.CallOption) (*QueryPinnersResponse, error) {
+	out := new(QueryPinnersResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/Pinners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPinner(ctx context.Context, in *QueryGetPinnerRequest, opts ...grpc.CallOption) (*QueryGetPinnerResponse, error) {
+	out := new(QueryGetPinnerResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/GetPinner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedPinners(ctx context.Context, in *QueryAllowedPinnersRequest, opts ...grpc.CallOption) (*QueryAllowedPinnersResponse, error) {
+	out := new(QueryAllowedPinnersResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/AllowedPinners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Challenges(ctx context.Context, in *QueryChallengesRequest, opts ...grpc.CallOption) (*QueryChallengesResponse, error) {
+	out := new(QueryChallengesResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/Challenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetChallenge(ctx context.Context, in *QueryGetChallengeRequest, opts ...grpc.CallOption) (*QueryGetChallengeResponse, error) {
+	out := new(QueryGetChallengeResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Query/GetChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListEntry Queries a list of Entry items.
+	GetEntry(context.Context, *QueryGetEntryRequest) (*QueryGetEntryResponse, error)
+	// ListEntry defines the ListEntry RPC.
+	ListEntry(context.Context, *QueryAllEntryRequest) (*QueryAllEntryResponse, error)
+	// EntriesByAgency Queries a list of EntriesByAgency items.
+	EntriesByAgency(context.Context, *QueryEntriesByAgencyRequest) (*QueryEntriesByAgencyResponse, error)
+	// EntriesByCategory Queries a list of EntriesByCategory items.
+	EntriesByCategory(context.Context, *QueryEntriesByCategoryRequest) (*QueryEntriesByCategoryResponse, error)
+	// EntriesByMimetype Queries a list of EntriesByMimetype items.
+	EntriesByMimetype(context.Context, *QueryEntriesByMimetypeRequest) (*QueryEntriesByMimetypeResponse, error)
+	// GetAgency Queries a registered agency by id.
+	GetAgency(context.Context, *QueryGetAgencyRequest) (*QueryGetAgencyResponse, error)
+	// ListAgency Queries a list of registered agencies.
+	ListAgency(context.Context, *QueryAllAgencyRequest) (*QueryAllAgencyResponse, error)
+	// EntryHistory Queries the revisions of an entry, oldest first.
+	EntryHistory(context.Context, *QueryEntryHistoryRequest) (*QueryEntryHistoryResponse, error)
+	// EntryRevision Queries a single revision of an entry.
+	EntryRevision(context.Context, *QueryEntryRevisionRequest) (*QueryEntryRevisionResponse, error)
+	// SearchEntries Queries the entries matching the terms of a full-text query
+	// over their title, description, agency and category, best match first.
+	// Only the 1000 most recent entries of each term are ranked.
+	SearchEntries(context.Context, *QuerySearchEntriesRequest) (*QuerySearchEntriesResponse, error)
+	// EntryByCid Queries the entry that registered an IPFS CID.
+	EntryByCid(context.Context, *QueryEntryByCidRequest) (*QueryEntryByCidResponse, error)
+	// EntryByChecksum Queries the entry that registered a SHA-256 checksum.
+	EntryByChecksum(context.Context, *QueryEntryByChecksumRequest) (*QueryEntryByChecksumResponse, error)
+	// DatasetStats Queries the entry counters maintained by the module.
+	DatasetStats(context.Context, *QueryDatasetStatsRequest) (*QueryDatasetStatsResponse, error)
+	// PeriodRoot Queries the Merkle root snapshot of a closed reporting period.
+	PeriodRoot(context.Context, *QueryPeriodRootRequest) (*QueryPeriodRootResponse, error)
+	// EntryInclusionProof Queries the proof that an entry is included in the
+	// Merkle root of a closed reporting period.
+	EntryInclusionProof(context.Context, *QueryEntryInclusionProofRequest) (*QueryEntryInclusionProofResponse, error)
+	// Pinners Queries the current pin attestations of an entry.
+	Pinners(context.Context, *QueryPinnersRequest) (*QueryPinnersResponse, error)
+	// GetPinner Queries a registered pinner by its address.
+	GetPinner(context.Context, *QueryGetPinnerRequest) (*QueryGetPinnerResponse, error)
+	// AllowedPinners Queries the addresses allowed to register as pinners and
+	// to attest to pins.
+	AllowedPinners(context.Context, *QueryAllowedPinnersRequest) (*QueryAllowedPinnersResponse, error)
+	// Challenges Queries the open challenges of a pinner.
+	Challenges(context.Context, *QueryChallengesRequest) (*QueryChallengesResponse, error)
+	// GetChallenge Queries an open challenge by its id.
+	GetChallenge(context.Context, *QueryGetChallengeRequest) (*QueryGetChallengeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetEntry(ctx context.Context, req *QueryGetEntryRequest) (*QueryGetEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+}
+func (*UnimplementedQueryServer) ListEntry(ctx context.Context, req *QueryAllEntryRequest) (*QueryAllEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntry not implemented")
+}
+func (*UnimplementedQueryServer) EntriesByAgency(ctx context.Context, req *QueryEntriesByAgencyRequest) (*QueryEntriesByAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntriesByAgency not implemented")
+}
+func (*UnimplementedQueryServer) EntriesByCategory(ctx context.Context, req *QueryEntriesByCategoryRequest) (*QueryEntriesByCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntriesByCategory not implemented")
+}
+func (*UnimplementedQueryServer) EntriesByMimetype(ctx context.Context, req *QueryEntriesByMimetypeRequest) (*QueryEntriesByMimetypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntriesByMimetype not implemented")
+}
+func (*UnimplementedQueryServer) GetAgency(ctx context.Context, req *QueryGetAgencyRequest) (*QueryGetAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgency not implemented")
+}
+func (*UnimplementedQueryServer) ListAgency(ctx context.Context, req *QueryAllAgencyRequest) (*QueryAllAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgency not implemented")
+}
+func (*UnimplementedQueryServer) EntryHistory(ctx context.Context, req *QueryEntryHistoryRequest) (*QueryEntryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntryHistory not implemented")
+}
+func (*UnimplementedQueryServer) EntryRevision(ctx context.Context, req *QueryEntryRevisionRequest) (*QueryEntryRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntryRevision not implemented")
+}
+func (*UnimplementedQueryServer) SearchEntries(ctx context.Context, req *QuerySearchEntriesRequest) (*QuerySearchEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEntries not implemented")
+}
+func (*UnimplementedQueryServer) EntryByCid(ctx context.Context, req *QueryEntryByCidRequest) (*QueryEntryByCidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntryByCid not implemented")
+}
+func (*UnimplementedQueryServer) EntryByChecksum(ctx context.Context, req *QueryEntryByChecksumRequest) (*QueryEntryByChecksumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntryByChecksum not implemented")
+}
+func (*UnimplementedQueryServer) DatasetStats(ctx context.Context, req *QueryDatasetStatsRequest) (*QueryDatasetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DatasetStats not implemented")
+}
+func (*UnimplementedQueryServer) PeriodRoot(ctx context.Context, req *QueryPeriodRootRequest) (*QueryPeriodRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodRoot not implemented")
+}
+func (*UnimplementedQueryServer) EntryInclusionProof(ctx context.Context, req *QueryEntryInclusionProofRequest) (*QueryEntryInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntryInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) Pinners(ctx context.Context, req *QueryPinnersRequest) (*QueryPinnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pinners not implemented")
+}
+func (*UnimplementedQueryServer) GetPinner(ctx context.Context, req *QueryGetPinnerRequest) (*QueryGetPinnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinner not implemented")
+}
+func (*UnimplementedQueryServer) AllowedPinners(ctx context.Context, req *QueryAllowedPinnersRequest) (*QueryAllowedPinnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedPinners not implemented")
+}
+func (*UnimplementedQueryServer) Challenges(ctx context.Context, req *QueryChallengesRequest) (*QueryChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Challenges not implemented")
+}
+func (*UnimplementedQueryServer) GetChallenge(ctx context.Context, req *QueryGetChallengeRequest) (*QueryGetChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChallenge not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/GetEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEntry(ctx, req.(*QueryGetEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/ListEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListEntry(ctx, req.(*QueryAllEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntriesByAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntriesByAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntriesByAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntriesByAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntriesByAgency(ctx, req.(*QueryEntriesByAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntriesByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntriesByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntriesByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntriesByCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntriesByCategory(ctx, req.(*QueryEntriesByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntriesByMimetype_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntriesByMimetypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntriesByMimetype(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntriesByMimetype",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntriesByMimetype(ctx, req.(*QueryEntriesByMimetypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/GetAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAgency(ctx, req.(*QueryGetAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAgencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/ListAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAgency(ctx, req.(*QueryAllAgencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntryHistory(ctx, req.(*QueryEntryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntryRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntryRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntryRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntryRevision(ctx, req.(*QueryEntryRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/SearchEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchEntries(ctx, req.(*QuerySearchEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntryByCid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryByCidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntryByCid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntryByCid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntryByCid(ctx, req.(*QueryEntryByCidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntryByChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryByChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntryByChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntryByChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntryByChecksum(ctx, req.(*QueryEntryByChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DatasetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDatasetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DatasetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/DatasetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DatasetStats(ctx, req.(*QueryDatasetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/PeriodRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodRoot(ctx, req.(*QueryPeriodRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntryInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntryInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/EntryInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntryInclusionProof(ctx, req.(*QueryEntryInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Pinners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPinnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pinners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/Pinners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pinners(ctx, req.(*QueryPinnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPinner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPinnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPinner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/GetPinner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPinner(ctx, req.(*QueryGetPinnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedPinners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedPinnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedPinners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/AllowedPinners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedPinners(ctx, req.(*QueryAllowedPinnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Challenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Challenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/Challenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Challenges(ctx, req.(*QueryChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Query/GetChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChallenge(ctx, req.(*QueryGetChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "govchain.datasets.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetEntry",
+			Handler:    _Query_GetEntry_Handler,
+		},
+		{
+			MethodName: "ListEntry",
+			Handler:    _Query_ListEntry_Handler,
+		},
+		{
+			MethodName: "EntriesByAgency",
+			Handler:    _Query_EntriesByAgency_Handler,
+		},
+		{
+			MethodName: "EntriesByCategory",
+			Handler:    _Query_EntriesByCategory_Handler,
+		},
+		{
+			MethodName: "EntriesByMimetype",
+			Handler:    _Query_EntriesByMimetype_Handler,
+		},
+		{
+			MethodName: "GetAgency",
+			Handler:    _Query_GetAgency_Handler,
+		},
+		{
+			MethodName: "ListAgency",
+			Handler:    _Query_ListAgency_Handler,
+		},
+		{
+			MethodName: "EntryHistory",
+			Handler:    _Query_EntryHistory_Handler,
+		},
+		{
+			MethodName: "EntryRevision",
+			Handler:    _Query_EntryRevision_Handler,
+		},
+		{
+			MethodName: "SearchEntries",
+			Handler:    _Query_SearchEntries_Handler,
+		},
+		{
+			MethodName: "EntryByCid",
+			Handler:    _Query_EntryByCid_Handler,
+		},
+		{
+			MethodName: "EntryByChecksum",
+			Handler:    _Query_EntryByChecksum_Handler,
+		},
+		{
+			MethodName: "DatasetStats",
+			Handler:    _Query_DatasetStats_Handler,
+		},
+		{
+			MethodName: "PeriodRoot",
+			Handler:    _Query_PeriodRoot_Handler,
+		},
+		{
+			MethodName: "EntryInclusionProof",
+			Handler:    _Query_EntryInclusionProof_Handler,
+		},
+		{
+			MethodName: "Pinners",
+			Handler:    _Query_Pinners_Handler,
+		},
+		{
+			MethodName: "GetPinner",
+			Handler:    _Query_GetPinner_Handler,
+		},
+		{
+			MethodName: "AllowedPinners",
+			Handler:    _Query_AllowedPinners_Handler,
+		},
+		{
+			MethodName: "Challenges",
+			Handler:    _Query_Challenges_Handler,
+		},
+		{
+			MethodName: "GetChallenge",
+			Handler:    _Query_GetChallenge_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "govchain/datasets/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pinners) > 0 {
+		for iNdEx := len(m.Pinners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pinners[iNdEx])
+			copy(dAtA[i:], m.Pinners[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Pinners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PinCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PinCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EntryPins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryPins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryPins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pinners) > 0 {
+		for iNdEx := len(m.Pinners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pinners[iNdEx])
+			copy(dAtA[i:], m.Pinners[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Pinners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PinCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PinCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderBy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderBy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IncludeRetracted {
+		i--
+		if m.IncludeRetracted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntryFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntryFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntryFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxUpdatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinUpdatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinUpdatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxCreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinCreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCreatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pins) > 0 {
+		for iNdEx := len(m.Pins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entry) > 0 {
+		for iNdEx := len(m.Entry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntriesByAgencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntriesByAgencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntriesByAgencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntriesByAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntriesByAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntriesByAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entry) > 0 {
+		for iNdEx := len(m.Entry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntriesByCategoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntriesByCategoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntriesByCategoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntriesByCategoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntriesByCategoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntriesByCategoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entry) > 0 {
+		for iNdEx := len(m.Entry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntriesByMimetypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntriesByMimetypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntriesByMimetypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntriesByMimetypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntriesByMimetypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntriesByMimetypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entry) > 0 {
+		for iNdEx := len(m.Entry) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entry[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAgencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAgencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAgencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Agency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAgencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAgencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAgencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agency) > 0 {
+		for iNdEx := len(m.Agency) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agency[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryRevisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryRevisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryRevisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryRevisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryRevisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryRevisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Revision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntrySearchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntrySearchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntrySearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MatchedTerms != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatchedTerms))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryByCidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryByCidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryByCidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IpfsCid) > 0 {
+		i -= len(m.IpfsCid)
+		copy(dAtA[i:], m.IpfsCid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IpfsCid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryByCidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryByCidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryByCidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryByChecksumRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryByChecksumRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryByChecksumRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChecksumSha_256) > 0 {
+		i -= len(m.ChecksumSha_256)
+		copy(dAtA[i:], m.ChecksumSha_256)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChecksumSha_256)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryByChecksumResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryByChecksumResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryByChecksumResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatasetStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatasetStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatasetStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDatasetStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDatasetStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDatasetStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ByCreatedMonth) > 0 {
+		for iNdEx := len(m.ByCreatedMonth) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByCreatedMonth[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ByMimeType) > 0 {
+		for iNdEx := len(m.ByMimeType) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByMimeType[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ByCategory) > 0 {
+		for iNdEx := len(m.ByCategory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByCategory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ByAgency) > 0 {
+		for iNdEx := len(m.ByAgency) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ByAgency[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Totals.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PeriodRoot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentHash) > 0 {
+		i -= len(m.ContentHash)
+		copy(dAtA[i:], m.ContentHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContentHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PeriodId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PeriodId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPinnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPinnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPinnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPinnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPinnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPinnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPinnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPinnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPinnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPinnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPinnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPinnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pinner.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedPinnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedPinnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedPinnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedPinnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedPinnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedPinnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pinner) > 0 {
+		i -= len(m.Pinner)
+		copy(dAtA[i:], m.Pinner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Pinner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChallengesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChallengesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChallengesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PinCount != 0 {
+		n += 1 + sovQuery(uint64(m.PinCount))
+	}
+	if len(m.Pinners) > 0 {
+		for _, s := range m.Pinners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EntryPins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovQuery(uint64(m.EntryId))
+	}
+	if m.PinCount != 0 {
+		n += 1 + sovQuery(uint64(m.PinCount))
+	}
+	if len(m.Pinners) > 0 {
+		for _, s := range m.Pinners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeRetracted {
+		n += 2
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovQuery(uint64(m.OrderBy))
+	}
+	return n
+}
+
+func (m *EntryFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinCreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinCreatedHeight))
+	}
+	if m.MaxCreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCreatedHeight))
+	}
+	if m.MinUpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinUpdatedHeight))
+	}
+	if m.MaxUpdatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxUpdatedHeight))
+	}
+	return n
+}
+
+func (m *QueryAllEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entry) > 0 {
+		for _, e := range m.Entry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Pins) > 0 {
+		for _, e := range m.Pins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEntriesByAgencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntriesByAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entry) > 0 {
+		for _, e := range m.Entry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntriesByCategoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntriesByCategoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entry) > 0 {
+		for _, e := range m.Entry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntriesByMimetypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntriesByMimetypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entry) > 0 {
+		for _, e := range m.Entry {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAgencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Agency.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAgencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agency) > 0 {
+		for _, e := range m.Agency {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovQuery(uint64(m.EntryId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryRevisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovQuery(uint64(m.EntryId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovQuery(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *QueryEntryRevisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Revision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySearchEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EntrySearchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MatchedTerms != 0 {
+		n += 1 + sovQuery(uint64(m.MatchedTerms))
+	}
+	if m.Score != 0 {
+		n += 1 + sovQuery(uint64(m.Score))
+	}
+	return n
+}
+
+func (m *QueryEntryByCidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IpfsCid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryByCidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEntryByChecksumRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChecksumSha_256)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryByChecksumResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDatasetStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDatasetStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Totals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ByAgency) > 0 {
+		for _, e := range m.ByAgency {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ByCategory) > 0 {
+		for _, e := range m.ByCategory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ByMimeType) > 0 {
+		for _, e := range m.ByMimeType {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ByCreatedMonth) > 0 {
+		for _, e := range m.ByCreatedMonth {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPeriodRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodId))
+	}
+	return n
+}
+
+func (m *QueryPeriodRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PeriodRoot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEntryInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodId != 0 {
+		n += 1 + sovQuery(uint64(m.PeriodId))
+	}
+	if m.EntryId != 0 {
+		n += 1 + sovQuery(uint64(m.EntryId))
+	}
+	l = len(m.ContentHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPinnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EntryId != 0 {
+		n += 1 + sovQuery(uint64(m.EntryId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPinnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPinnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPinnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pinner.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowedPinnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedPinnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pinner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChallengesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Challenge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinCount", wireType)
+			}
+			m.PinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pinners = append(m.Pinners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntryPins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntryPins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntryPins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinCount", wireType)
+			}
+			m.PinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PinCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pinners = append(m.Pinners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeRetracted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeRetracted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &EntryFilter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			m.OrderBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBy |= EntryOrderBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntryFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntryFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntryFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCreatedHeight", wireType)
+			}
+			m.MinCreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreatedHeight", wireType)
+			}
+			m.MaxCreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUpdatedHeight", wireType)
+			}
+			m.MinUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdatedHeight", wireType)
+			}
+			m.MaxUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entry = append(m.Entry, Entry{})
+			if err := m.Entry[len(m.Entry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pins = append(m.Pins, EntryPins{})
+			if err := m.Pins[len(m.Pins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntriesByAgencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntriesByAgencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntriesByAgencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntriesByAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntriesByAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntriesByAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entry = append(m.Entry, Entry{})
+			if err := m.Entry[len(m.Entry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntriesByCategoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntriesByCategoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntriesByCategoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntriesByCategoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntriesByCategoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntriesByCategoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entry = append(m.Entry, Entry{})
+			if err := m.Entry[len(m.Entry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntriesByMimetypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntriesByMimetypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntriesByMimetypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntriesByMimetypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntriesByMimetypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntriesByMimetypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entry = append(m.Entry, Entry{})
+			if err := m.Entry[len(m.Entry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAgencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAgencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAgencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Agency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAgencyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAgencyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAgencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = append(m.Agency, Agency{})
+			if err := m.Agency[len(m.Agency)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, EntryRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryRevisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryRevisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryRevisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryRevisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchEntriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchEntriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, EntrySearchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EntrySearchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EntrySearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EntrySearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchedTerms", wireType)
+			}
+			m.MatchedTerms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatchedTerms |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryByCidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryByCidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryByCidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryByCidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryByCidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryByCidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryByChecksumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryByChecksumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryByChecksumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryByChecksumResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryByChecksumResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryByChecksumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDatasetStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatasetStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatasetStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDatasetStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDatasetStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDatasetStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Totals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByAgency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByAgency = append(m.ByAgency, StatsBucket{})
+			if err := m.ByAgency[len(m.ByAgency)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByCategory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByCategory = append(m.ByCategory, StatsBucket{})
+			if err := m.ByCategory[len(m.ByCategory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByMimeType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByMimeType = append(m.ByMimeType, StatsBucket{})
+			if err := m.ByMimeType[len(m.ByMimeType)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByCreatedMonth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByCreatedMonth = append(m.ByCreatedMonth, StatsBucket{})
+			if err := m.ByCreatedMonth[len(m.ByCreatedMonth)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodId", wireType)
+			}
+			m.PeriodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodRoot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodRoot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodId", wireType)
+			}
+			m.PeriodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentHash = append(m.ContentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContentHash == nil {
+				m.ContentHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEntriesByCategoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryEntriesByMimetypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryEntryInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEntriesByMimetypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPinnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1427,12 +9920,67 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPinnersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPinnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
+			}
+			m.EntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1454,7 +10002,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPinnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1477,15 +10025,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPinnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPinnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1512,7 +10060,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, PinAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1537,7 +10122,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPinnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1560,17 +10145,17 @@ func (m *QueryGetEntryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPinnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPinnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1580,11 +10165,24 @@ func (m *QueryGetEntryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1606,7 +10204,7 @@ func (m *QueryGetEntryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetPinnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1629,15 +10227,15 @@ func (m *QueryGetEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetPinnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetPinnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1664,7 +10262,7 @@ func (m *QueryGetEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pinner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1689,7 +10287,7 @@ func (m *QueryGetEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEntryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedPinnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1712,10 +10310,10 @@ func (m *QueryAllEntryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEntryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedPinnersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedPinnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1775,7 +10373,7 @@ func (m *QueryAllEntryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedPinnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1798,17 +10396,17 @@ func (m *QueryAllEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedPinnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedPinnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1818,25 +10416,23 @@ func (m *QueryAllEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entry = append(m.Entry, Entry{})
-			if err := m.Entry[len(m.Entry)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1895,7 +10491,7 @@ func (m *QueryAllEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntriesByAgencyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1918,15 +10514,15 @@ func (m *QueryEntriesByAgencyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntriesByAgencyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChallengesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntriesByAgencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1954,58 +10550,44 @@ func (m *QueryEntriesByAgencyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agency = string(dAtA[iNdEx:postIndex])
+			m.Pinner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEntriesByAgencyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntriesByAgencyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntriesByAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2027,7 +10609,7 @@ func (m *QueryEntriesByAgencyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntriesByCategoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChallengesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2050,17 +10632,17 @@ func (m *QueryEntriesByCategoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntriesByCategoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChallengesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntriesByCategoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2070,23 +10652,61 @@ func (m *QueryEntriesByCategoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
+			m.Challenges = append(m.Challenges, Challenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2109,7 +10729,7 @@ func (m *QueryEntriesByCategoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntriesByCategoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChallengeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,12 +10752,31 @@ func (m *QueryEntriesByCategoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntriesByCategoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChallengeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntriesByCategoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2159,7 +10798,7 @@ func (m *QueryEntriesByCategoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEntriesByMimetypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2182,17 +10821,17 @@ func (m *QueryEntriesByMimetypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntriesByMimetypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntriesByMimetypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2202,74 +10841,25 @@ func (m *QueryEntriesByMimetypeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryEntriesByMimetypeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEntriesByMimetypeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEntriesByMimetypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
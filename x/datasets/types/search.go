@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// MinSearchTermLength is the minimum length, in runes, of an indexed term.
+	MinSearchTermLength = 2
+	// MaxSearchTermLength is the maximum length, in bytes, of an indexed term.
+	MaxSearchTermLength = 64
+	// MaxSearchQueryTerms is the maximum number of terms of a search query.
+	MaxSearchQueryTerms = 16
+	// MaxSearchTermPostings is the maximum number of entries considered for
+	// each term of a search query, the most recent ones.
+	MaxSearchTermPostings = 1000
+	// MaxSearchResults is the maximum number of ranked results of a search
+	// query.
+	MaxSearchResults = MaxSearchQueryTerms * MaxSearchTermPostings
+)
+
+// Weights of a term found in the searchable fields of an entry.
+const (
+	SearchWeightTitle       = 3
+	SearchWeightAgency      = 2
+	SearchWeightCategory    = 2
+	SearchWeightDescription = 1
+)
+
+// stopWords are frequent English words that are not indexed.
+var stopWords = map[string]bool{
+	"an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "for": true, "from": true, "in": true, "is": true, "it": true,
+	"of": true, "on": true, "or": true, "the": true, "to": true, "with": true,
+}
+
+// Tokenize splits s into lower-cased search terms on any character that is
+// not a letter or a digit. Stop words and terms that are too short or too
+// long are dropped, and each term is returned once, in order of appearance.
+func Tokenize(s string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(term)) < MinSearchTermLength || len(term) > MaxSearchTermLength || stopWords[term] || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// SearchTerms returns the weighted search terms of the entry. The weight of a
+// term is the sum of the weights of the fields it appears in. Retracted
+// entries have no search terms.
+func (e Entry) SearchTerms() map[string]uint32 {
+	terms := make(map[string]uint32)
+	if e.IsRetracted() {
+		return terms
+	}
+	for _, field := range []struct {
+		value  string
+		weight uint32
+	}{
+		{e.Title, SearchWeightTitle},
+		{e.Agency, SearchWeightAgency},
+		{e.Category, SearchWeightCategory},
+		{e.Description, SearchWeightDescription},
+	} {
+		for _, term := range Tokenize(field.value) {
+			terms[term] += field.weight
+		}
+	}
+	return terms
+}
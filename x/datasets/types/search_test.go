@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t,
+		[]string{"flood", "2024", "budget", "région", "données"},
+		types.Tokenize("Flood 2024: the BUDGET of a région (données, flood)"),
+	)
+	require.Empty(t, types.Tokenize(" - a / of "))
+}
+
+func TestEntrySearchTerms(t *testing.T) {
+	entry := types.Entry{
+		Title:       "Flood Budget",
+		Description: "Flood defence spending",
+		Agency:      "FEMA",
+		Category:    "budget",
+	}
+	require.Equal(t, map[string]uint32{
+		"flood":    types.SearchWeightTitle + types.SearchWeightDescription,
+		"budget":   types.SearchWeightTitle + types.SearchWeightCategory,
+		"defence":  types.SearchWeightDescription,
+		"spending": types.SearchWeightDescription,
+		"fema":     types.SearchWeightAgency,
+	}, entry.SearchTerms())
+
+	entry.Status = types.ENTRY_STATUS_RETRACTED
+	require.Empty(t, entry.SearchTerms())
+}
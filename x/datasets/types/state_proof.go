@@ -0,0 +1,182 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// EntryStoreKey returns the key of the entry with the given id in the module
+// store, as queried with a store proof.
+func EntryStoreKey(id uint64) []byte {
+	key, err := collections.EncodeKeyWithPrefix(EntryKey, collections.Uint64Key, id)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// EntryStateProof is a self-contained proof that an entry is stored in the
+// application state committed by a block header. It carries the header, its
+// commit and the validator set that signed it, so that it can be re-verified
+// offline.
+type EntryStateProof struct {
+	ChainID string `json:"chain_id"`
+	// Height is the height of the proven state. Its app hash is committed by
+	// the header of the next block.
+	Height   int64  `json:"height"`
+	StoreKey string `json:"store_key"`
+	Key      []byte `json:"key"`
+	// Value is the protobuf encoding of the entry.
+	Value []byte `json:"value"`
+	// Entry is the JSON encoding of the entry, for readability only: it is not
+	// covered by the proof.
+	Entry        json.RawMessage        `json:"entry"`
+	Proof        *cmtcrypto.ProofOps    `json:"proof"`
+	SignedHeader *cmttypes.SignedHeader `json:"signed_header"`
+	ValidatorSet *cmttypes.ValidatorSet `json:"validator_set"`
+}
+
+// AppHash returns the app hash committed by the header of the proof.
+func (p EntryStateProof) AppHash() []byte {
+	if p.SignedHeader == nil || p.SignedHeader.Header == nil {
+		return nil
+	}
+	return p.SignedHeader.AppHash
+}
+
+// Verify checks the chain of trust of the proof from trustedAppHash, obtained
+// from a trusted source such as a light client: the header commits to the
+// proven state and to trustedAppHash, its commit is signed by its validator
+// set, and the entry value is proven against this app hash. The app hash
+// embedded in the header is not trusted by itself.
+func (p EntryStateProof) Verify(trustedAppHash []byte) error {
+	if len(trustedAppHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing trusted app hash")
+	}
+	if err := p.verifyHeader(); err != nil {
+		return err
+	}
+	if !bytes.Equal(trustedAppHash, p.AppHash()) {
+		return errorsmod.Wrapf(ErrInvalidStateProof, "header app hash %X is not the trusted app hash %X", p.AppHash(), trustedAppHash)
+	}
+	return p.VerifyValue(trustedAppHash)
+}
+
+// VerifyValidators checks the chain of trust of the proof from
+// trustedValidatorsHash, the hash of a validator set obtained from a trusted
+// source: the header names this validator set and commits to the proven
+// state, its commit is signed by more than two thirds of the voting power of
+// the set, and the entry value is proven against its app hash.
+func (p EntryStateProof) VerifyValidators(trustedValidatorsHash []byte) error {
+	if len(trustedValidatorsHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing trusted validators hash")
+	}
+	if err := p.verifyHeader(); err != nil {
+		return err
+	}
+	if validatorsHash := p.SignedHeader.ValidatorsHash; !bytes.Equal(trustedValidatorsHash, validatorsHash) {
+		return errorsmod.Wrapf(ErrInvalidStateProof, "header validators hash %X is not the trusted validators hash %X", validatorsHash.Bytes(), trustedValidatorsHash)
+	}
+	return p.VerifyValue(p.AppHash())
+}
+
+// VerifyTrusted checks the chain of trust of the proof from the trust roots
+// that are set: the app hash its header must commit to, as checked by Verify,
+// the validators hash it must name, as checked by VerifyValidators, or both.
+// At least one of them is required.
+func (p EntryStateProof) VerifyTrusted(trustedAppHash, trustedValidatorsHash []byte) error {
+	if len(trustedAppHash) == 0 && len(trustedValidatorsHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing trusted app hash or validators hash")
+	}
+	if len(trustedAppHash) > 0 {
+		if err := p.Verify(trustedAppHash); err != nil {
+			return err
+		}
+	}
+	if len(trustedValidatorsHash) > 0 {
+		if err := p.VerifyValidators(trustedValidatorsHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyHeader checks that the header of the proof is well formed, signed by
+// its validator set and commits to the state at the height of the proof.
+func (p EntryStateProof) verifyHeader() error {
+	if p.SignedHeader == nil || p.SignedHeader.Header == nil {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing signed header")
+	}
+	if err := p.SignedHeader.ValidateBasic(p.ChainID); err != nil {
+		return errorsmod.Wrap(ErrInvalidStateProof, err.Error())
+	}
+	if err := p.VerifyCommit(); err != nil {
+		return err
+	}
+	if p.SignedHeader.Height != p.Height+1 {
+		return errorsmod.Wrapf(ErrInvalidStateProof, "header height %d does not commit to the state at height %d", p.SignedHeader.Height, p.Height)
+	}
+	return nil
+}
+
+// VerifyCommit checks that the validator set of the proof is the one named by
+// its header, and that the commit of the header carries valid signatures of
+// more than two thirds of its voting power. Every signature is checked. The
+// header must have passed ValidateBasic.
+func (p EntryStateProof) VerifyCommit() error {
+	if p.ValidatorSet == nil || p.ValidatorSet.IsNilOrEmpty() {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing validator set")
+	}
+	if err := p.ValidatorSet.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidStateProof, err.Error())
+	}
+	if hash := p.ValidatorSet.Hash(); !bytes.Equal(hash, p.SignedHeader.ValidatorsHash) {
+		return errorsmod.Wrapf(ErrInvalidStateProof, "validator set hash %X is not the header validators hash %X", hash, p.SignedHeader.ValidatorsHash)
+	}
+	commit := p.SignedHeader.Commit
+	if err := p.ValidatorSet.VerifyCommit(p.ChainID, commit.BlockID, p.SignedHeader.Height, commit); err != nil {
+		return errorsmod.Wrap(ErrInvalidStateProof, err.Error())
+	}
+	return nil
+}
+
+// VerifyValue checks that the proof leads from the entry value, stored under
+// the key of the proof in the module store, to appHash.
+func (p EntryStateProof) VerifyValue(appHash []byte) error {
+	if p.StoreKey != StoreKey {
+		return errorsmod.Wrapf(ErrInvalidStateProof, "store key %q is not %q", p.StoreKey, StoreKey)
+	}
+	if !bytes.HasPrefix(p.Key, EntryKey) {
+		return errorsmod.Wrapf(ErrInvalidStateProof, "key %X is not an entry key", p.Key)
+	}
+	if len(p.Value) == 0 {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing entry value")
+	}
+	if p.Proof == nil {
+		return errorsmod.Wrap(ErrInvalidStateProof, "missing proof")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(p.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(p.Key, merkle.KeyEncodingURL)
+	if err := rootmulti.DefaultProofRuntime().VerifyValue(p.Proof, appHash, keyPath.String(), p.Value); err != nil {
+		return errorsmod.Wrap(ErrInvalidStateProof, err.Error())
+	}
+	return nil
+}
+
+// DecodeEntry returns the proven entry.
+func (p EntryStateProof) DecodeEntry() (Entry, error) {
+	var entry Entry
+	if err := entry.Unmarshal(p.Value); err != nil {
+		return Entry{}, errorsmod.Wrap(ErrInvalidStateProof, err.Error())
+	}
+	return entry, nil
+}
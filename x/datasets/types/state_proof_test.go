@@ -0,0 +1,207 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtprotoversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cmtversion "github.com/cometbft/cometbft/version"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+// proveEntry commits entry to a multistore holding the datasets store and
+// returns its state proof along with the app hash of the commit.
+func proveEntry(t *testing.T, entry types.Entry) (types.EntryStateProof, []byte) {
+	t.Helper()
+
+	db := dbm.NewMemDB()
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(storetypes.NewKVStoreKey("bank"), storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	value, err := entry.Marshal()
+	require.NoError(t, err)
+	ms.GetKVStore(key).Set(types.EntryStoreKey(entry.Id), value)
+	ms.GetKVStore(key).Set(types.EntryStoreKey(entry.Id+1), []byte("other"))
+	commit := ms.Commit()
+
+	res, err := ms.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", types.StoreKey),
+		Data:   types.EntryStoreKey(entry.Id),
+		Height: commit.Version,
+		Prove:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, value, res.Value)
+
+	return types.EntryStateProof{
+		ChainID:  "govchain",
+		Height:   commit.Version,
+		StoreKey: types.StoreKey,
+		Key:      types.EntryStoreKey(entry.Id),
+		Value:    res.Value,
+		Proof:    res.ProofOps,
+	}, commit.Hash
+}
+
+func TestEntryStateProof(t *testing.T) {
+	entry := types.Entry{Id: 7, Title: "title", Agency: "NOAA"}
+	proof, appHash := proveEntry(t, entry)
+
+	require.NoError(t, proof.VerifyValue(appHash))
+	decoded, err := proof.DecodeEntry()
+	require.NoError(t, err)
+	require.Equal(t, entry.Title, decoded.Title)
+
+	t.Run("WrongAppHash", func(t *testing.T) {
+		other := append([]byte{}, appHash...)
+		other[0] ^= 1
+		require.ErrorIs(t, proof.VerifyValue(other), types.ErrInvalidStateProof)
+	})
+
+	t.Run("TamperedValue", func(t *testing.T) {
+		tampered := proof
+		tampered.Value = append([]byte{}, proof.Value...)
+		tampered.Value[len(tampered.Value)-1] ^= 1
+		require.ErrorIs(t, tampered.VerifyValue(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("OtherKey", func(t *testing.T) {
+		tampered := proof
+		tampered.Key = types.EntryStoreKey(entry.Id + 1)
+		require.ErrorIs(t, tampered.VerifyValue(appHash), types.ErrInvalidStateProof)
+
+		tampered.Key = append([]byte("p_datasets"), proof.Key[len(types.EntryKey):]...)
+		require.ErrorIs(t, tampered.VerifyValue(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("OtherStore", func(t *testing.T) {
+		tampered := proof
+		tampered.StoreKey = "bank"
+		require.ErrorIs(t, tampered.VerifyValue(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("MissingHeader", func(t *testing.T) {
+		require.ErrorIs(t, proof.Verify(appHash), types.ErrInvalidStateProof)
+	})
+}
+
+// signHeader sets the signed header of proof, committing to appHash and
+// signed by a new validator set, and sets the validator set.
+func signHeader(t *testing.T, proof *types.EntryStateProof, appHash []byte) {
+	t.Helper()
+
+	valSet, privVals := cmttypes.RandValidatorSet(4, 10)
+	header := cmttypes.Header{
+		Version:            cmtprotoversion.Consensus{Block: cmtversion.BlockProtocol},
+		ChainID:            proof.ChainID,
+		Height:             proof.Height + 1,
+		Time:               time.Now(),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    valSet.Proposer.Address,
+	}
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := cmttypes.NewVoteSet(header.ChainID, header.Height, 0, cmtproto.PrecommitType, valSet)
+	extCommit, err := cmttypes.MakeExtCommit(blockID, header.Height, 0, voteSet, privVals, header.Time, false)
+	require.NoError(t, err)
+
+	proof.SignedHeader = &cmttypes.SignedHeader{Header: &header, Commit: extCommit.ToCommit()}
+	proof.ValidatorSet = valSet
+}
+
+func TestEntryStateProofChainOfTrust(t *testing.T) {
+	proof, appHash := proveEntry(t, types.Entry{Id: 7, Title: "title", Agency: "NOAA"})
+	signHeader(t, &proof, appHash)
+
+	require.NoError(t, proof.Verify(appHash))
+	require.ErrorIs(t, proof.Verify([]byte{1}), types.ErrInvalidStateProof)
+
+	validatorsHash := proof.ValidatorSet.Hash()
+	require.NoError(t, proof.VerifyValidators(validatorsHash))
+	require.ErrorIs(t, proof.VerifyValidators([]byte{1}), types.ErrInvalidStateProof)
+
+	require.NoError(t, proof.VerifyTrusted(appHash, nil))
+	require.NoError(t, proof.VerifyTrusted(nil, validatorsHash))
+	require.NoError(t, proof.VerifyTrusted(appHash, validatorsHash))
+	require.ErrorIs(t, proof.VerifyTrusted(appHash, []byte{1}), types.ErrInvalidStateProof)
+
+	t.Run("NoTrustRoot", func(t *testing.T) {
+		// the header of the proof is not trusted by itself
+		require.ErrorIs(t, proof.Verify(nil), types.ErrInvalidStateProof)
+		require.ErrorIs(t, proof.VerifyValidators(nil), types.ErrInvalidStateProof)
+		require.ErrorIs(t, proof.VerifyTrusted(nil, nil), types.ErrInvalidStateProof)
+	})
+
+	t.Run("SelfSignedHeader", func(t *testing.T) {
+		// a header signed by another validator set is consistent, but is
+		// rejected by the trusted validators hash
+		tampered := proof
+		signHeader(t, &tampered, appHash)
+		require.NoError(t, tampered.Verify(appHash))
+		require.ErrorIs(t, tampered.VerifyValidators(validatorsHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("OtherChain", func(t *testing.T) {
+		tampered := proof
+		tampered.ChainID = "other"
+		require.ErrorIs(t, tampered.Verify(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("OtherHeight", func(t *testing.T) {
+		tampered := proof
+		tampered.Height++
+		require.ErrorIs(t, tampered.Verify(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("OtherValidatorSet", func(t *testing.T) {
+		tampered := proof
+		tampered.ValidatorSet, _ = cmttypes.RandValidatorSet(4, 10)
+		require.ErrorIs(t, tampered.Verify(appHash), types.ErrInvalidStateProof)
+		tampered.ValidatorSet = nil
+		require.ErrorIs(t, tampered.Verify(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("ForgedSignature", func(t *testing.T) {
+		commit := *proof.SignedHeader.Commit
+		commit.Signatures = append([]cmttypes.CommitSig{}, commit.Signatures...)
+		sig := append([]byte{}, commit.Signatures[2].Signature...)
+		sig[0] ^= 1
+		commit.Signatures[2].Signature = sig
+
+		tampered := proof
+		tampered.SignedHeader = &cmttypes.SignedHeader{Header: proof.SignedHeader.Header, Commit: &commit}
+		require.ErrorIs(t, tampered.Verify(appHash), types.ErrInvalidStateProof)
+	})
+
+	t.Run("ForgedAppHash", func(t *testing.T) {
+		// the signatures cover the header, hence its app hash
+		header := *proof.SignedHeader.Header
+		header.AppHash = []byte{1}
+
+		tampered := proof
+		tampered.SignedHeader = &cmttypes.SignedHeader{Header: &header, Commit: proof.SignedHeader.Commit}
+		require.ErrorIs(t, tampered.Verify(header.AppHash), types.ErrInvalidStateProof)
+	})
+}
+
+func TestEntryStoreKey(t *testing.T) {
+	require.Equal(t, append([]byte("entry/value/"), 0, 0, 0, 0, 0, 0, 1, 2), types.EntryStoreKey(258))
+}
@@ -0,0 +1,39 @@
+package types
+
+// Statistics dimensions the entries are counted by.
+const (
+	StatsDimensionAgency       = "agency"
+	StatsDimensionCategory     = "category"
+	StatsDimensionMimeType     = "mime_type"
+	StatsDimensionCreatedMonth = "created_month"
+)
+
+// StatsDimensions lists the statistics dimensions in a stable order.
+var StatsDimensions = []string{
+	StatsDimensionAgency,
+	StatsDimensionCategory,
+	StatsDimensionMimeType,
+	StatsDimensionCreatedMonth,
+}
+
+// CreatedMonth returns the UTC month the entry was created in, formatted as
+// YYYY-MM.
+func (e Entry) CreatedMonth() string {
+	return e.CreatedAt.UTC().Format("2006-01")
+}
+
+// StatsKey returns the key under which the entry is counted in dimension.
+func (e Entry) StatsKey(dimension string) string {
+	switch dimension {
+	case StatsDimensionAgency:
+		return e.Agency
+	case StatsDimensionCategory:
+		return e.Category
+	case StatsDimensionMimeType:
+		return e.MimeType
+	case StatsDimensionCreatedMonth:
+		return e.CreatedMonth()
+	default:
+		return ""
+	}
+}
@@ -12,18 +12,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -134,13 +139,17 @@ type MsgCreateEntry struct {
 	FileName        string `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	FileUrl         string `protobuf:"bytes,7,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
 	FallbackUrl     string `protobuf:"bytes,8,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
-	FileSize        string `protobuf:"bytes,9,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	ChecksumSha_256 string `protobuf:"bytes,10,opt,name=checksum_sha_256,json=checksumSha256,proto3" json:"checksum_sha_256,omitempty"`
 	Agency          string `protobuf:"bytes,11,opt,name=agency,proto3" json:"agency,omitempty"`
 	Category        string `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
 	Submitter       string `protobuf:"bytes,13,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	Timestamp       string `protobuf:"bytes,14,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	PinCount        string `protobuf:"bytes,15,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
+	// mirror_of links the entry as a mirror of an existing entry with the same
+	// IPFS CID or SHA-256 checksum. Without it, such duplicates are rejected.
+	MirrorOf *MirrorLink `protobuf:"bytes,16,opt,name=mirror_of,json=mirrorOf,proto3" json:"mirror_of,omitempty"`
+	// file_size is the size of the dataset file in bytes.
+	FileSize uint64 `protobuf:"varint,17,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// published_at is the publication time of the dataset.
+	PublishedAt time.Time `protobuf:"bytes,18,opt,name=published_at,json=publishedAt,proto3,stdtime" json:"published_at"`
 }
 
 func (m *MsgCreateEntry) Reset()         { *m = MsgCreateEntry{} }
@@ -232,13 +241,6 @@ func (m *MsgCreateEntry) GetFallbackUrl() string {
 	return ""
 }
 
-func (m *MsgCreateEntry) GetFileSize() string {
-	if m != nil {
-		return m.FileSize
-	}
-	return ""
-}
-
 func (m *MsgCreateEntry) GetChecksumSha_256() string {
 	if m != nil {
 		return m.ChecksumSha_256
@@ -267,18 +269,25 @@ func (m *MsgCreateEntry) GetSubmitter() string {
 	return ""
 }
 
-func (m *MsgCreateEntry) GetTimestamp() string {
+func (m *MsgCreateEntry) GetMirrorOf() *MirrorLink {
 	if m != nil {
-		return m.Timestamp
+		return m.MirrorOf
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgCreateEntry) GetPinCount() string {
+func (m *MsgCreateEntry) GetFileSize() uint64 {
 	if m != nil {
-		return m.PinCount
+		return m.FileSize
 	}
-	return ""
+	return 0
+}
+
+func (m *MsgCreateEntry) GetPublishedAt() time.Time {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return time.Time{}
 }
 
 // MsgCreateEntryResponse defines the MsgCreateEntryResponse message.
@@ -337,13 +346,24 @@ type MsgUpdateEntry struct {
 	FileName        string `protobuf:"bytes,7,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 	FileUrl         string `protobuf:"bytes,8,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
 	FallbackUrl     string `protobuf:"bytes,9,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
-	FileSize        string `protobuf:"bytes,10,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	ChecksumSha_256 string `protobuf:"bytes,11,opt,name=checksum_sha_256,json=checksumSha256,proto3" json:"checksum_sha_256,omitempty"`
 	Agency          string `protobuf:"bytes,12,opt,name=agency,proto3" json:"agency,omitempty"`
 	Category        string `protobuf:"bytes,13,opt,name=category,proto3" json:"category,omitempty"`
 	Submitter       string `protobuf:"bytes,14,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	Timestamp       string `protobuf:"bytes,15,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	PinCount        string `protobuf:"bytes,16,opt,name=pin_count,json=pinCount,proto3" json:"pin_count,omitempty"`
+	// change_reason describes why the entry is revised. It is recorded in the
+	// entry history.
+	ChangeReason string `protobuf:"bytes,17,opt,name=change_reason,json=changeReason,proto3" json:"change_reason,omitempty"`
+	// update_mask lists the entry fields to replace. When empty, the fields set
+	// to a non-default value in the message are replaced.
+	UpdateMask *types.FieldMask `protobuf:"bytes,18,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// new_revision declares that the update publishes new content for the
+	// entry. It is required to change ipfs_cid, checksum_sha_256 or file_size
+	// once the entry is pinned.
+	NewRevision bool `protobuf:"varint,19,opt,name=new_revision,json=newRevision,proto3" json:"new_revision,omitempty"`
+	// file_size is the size of the dataset file in bytes.
+	FileSize uint64 `protobuf:"varint,20,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// published_at is the publication time of the dataset.
+	PublishedAt time.Time `protobuf:"bytes,21,opt,name=published_at,json=publishedAt,proto3,stdtime" json:"published_at"`
 }
 
 func (m *MsgUpdateEntry) Reset()         { *m = MsgUpdateEntry{} }
@@ -442,13 +462,6 @@ func (m *MsgUpdateEntry) GetFallbackUrl() string {
 	return ""
 }
 
-func (m *MsgUpdateEntry) GetFileSize() string {
-	if m != nil {
-		return m.FileSize
-	}
-	return ""
-}
-
 func (m *MsgUpdateEntry) GetChecksumSha_256() string {
 	if m != nil {
 		return m.ChecksumSha_256
@@ -477,18 +490,39 @@ func (m *MsgUpdateEntry) GetSubmitter() string {
 	return ""
 }
 
-func (m *MsgUpdateEntry) GetTimestamp() string {
+func (m *MsgUpdateEntry) GetChangeReason() string {
 	if m != nil {
-		return m.Timestamp
+		return m.ChangeReason
 	}
 	return ""
 }
 
-func (m *MsgUpdateEntry) GetPinCount() string {
+func (m *MsgUpdateEntry) GetUpdateMask() *types.FieldMask {
 	if m != nil {
-		return m.PinCount
+		return m.UpdateMask
 	}
-	return ""
+	return nil
+}
+
+func (m *MsgUpdateEntry) GetNewRevision() bool {
+	if m != nil {
+		return m.NewRevision
+	}
+	return false
+}
+
+func (m *MsgUpdateEntry) GetFileSize() uint64 {
+	if m != nil {
+		return m.FileSize
+	}
+	return 0
+}
+
+func (m *MsgUpdateEntry) GetPublishedAt() time.Time {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return time.Time{}
 }
 
 // MsgUpdateEntryResponse defines the MsgUpdateEntryResponse message.
@@ -532,6 +566,8 @@ var xxx_messageInfo_MsgUpdateEntryResponse proto.InternalMessageInfo
 type MsgDeleteEntry struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// reason explains why the entry is retracted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgDeleteEntry) Reset()         { *m = MsgDeleteEntry{} }
@@ -581,6 +617,13 @@ func (m *MsgDeleteEntry) GetId() uint64 {
 	return 0
 }
 
+func (m *MsgDeleteEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgDeleteEntryResponse defines the MsgDeleteEntryResponse message.
 type MsgDeleteEntryResponse struct {
 }
@@ -618,934 +661,5363 @@ func (m *MsgDeleteEntryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteEntryResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "govchain.datasets.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "govchain.datasets.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateEntry)(nil), "govchain.datasets.v1.MsgCreateEntry")
-	proto.RegisterType((*MsgCreateEntryResponse)(nil), "govchain.datasets.v1.MsgCreateEntryResponse")
-	proto.RegisterType((*MsgUpdateEntry)(nil), "govchain.datasets.v1.MsgUpdateEntry")
-	proto.RegisterType((*MsgUpdateEntryResponse)(nil), "govchain.datasets.v1.MsgUpdateEntryResponse")
-	proto.RegisterType((*MsgDeleteEntry)(nil), "govchain.datasets.v1.MsgDeleteEntry")
-	proto.RegisterType((*MsgDeleteEntryResponse)(nil), "govchain.datasets.v1.MsgDeleteEntryResponse")
+// MsgRegisterAgency is the Msg/RegisterAgency request type.
+type MsgRegisterAgency struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// agency is the agency to register.
+	Agency Agency `protobuf:"bytes,2,opt,name=agency,proto3" json:"agency"`
 }
 
-func init() { proto.RegisterFile("govchain/datasets/v1/tx.proto", fileDescriptor_c94f77eb4f7727a8) }
-
-var fileDescriptor_c94f77eb4f7727a8 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xbd, 0x4e, 0x1b, 0x4b,
-	0x14, 0xc7, 0xbd, 0xfe, 0xde, 0xb1, 0x31, 0xdc, 0x91, 0x05, 0x63, 0x5f, 0xae, 0x2f, 0x38, 0x44,
-	0xb2, 0x50, 0xb0, 0x85, 0x11, 0x2e, 0x68, 0xa2, 0x40, 0x52, 0x12, 0x45, 0x26, 0x34, 0x69, 0xac,
-	0xf1, 0xee, 0xb0, 0x1e, 0xe1, 0xfd, 0xd0, 0xce, 0x18, 0x61, 0xaa, 0x24, 0x65, 0xaa, 0xf4, 0x79,
-	0x81, 0x94, 0x14, 0x79, 0x82, 0x54, 0x94, 0x28, 0x55, 0xaa, 0x28, 0x82, 0x82, 0x3a, 0x6f, 0x10,
-	0xcd, 0x8c, 0xd7, 0x5e, 0x90, 0x59, 0xa3, 0x28, 0x8d, 0xe5, 0x73, 0xfe, 0x67, 0xff, 0x67, 0x46,
-	0xe7, 0x37, 0x33, 0xe0, 0x3f, 0xcb, 0x3d, 0x31, 0x7a, 0x98, 0x3a, 0x0d, 0x13, 0x73, 0xcc, 0x08,
-	0x67, 0x8d, 0x93, 0xcd, 0x06, 0x3f, 0xad, 0x7b, 0xbe, 0xcb, 0x5d, 0x58, 0x0c, 0xe4, 0x7a, 0x20,
-	0xd7, 0x4f, 0x36, 0xcb, 0xff, 0x60, 0x9b, 0x3a, 0x6e, 0x43, 0xfe, 0xaa, 0xc2, 0xf2, 0x92, 0xe1,
-	0x32, 0xdb, 0x65, 0x0d, 0x9b, 0x59, 0xc2, 0xc0, 0x66, 0xd6, 0x48, 0x28, 0x29, 0xa1, 0x23, 0xa3,
-	0x86, 0x0a, 0x46, 0x52, 0xd1, 0x72, 0x2d, 0x57, 0xe5, 0xc5, 0xbf, 0x51, 0x76, 0x75, 0xea, 0x8a,
-	0x3c, 0xec, 0x63, 0x7b, 0xf4, 0x61, 0xf5, 0xab, 0x06, 0xe6, 0xf7, 0x99, 0x75, 0xe8, 0x99, 0x98,
-	0x93, 0x57, 0x52, 0x81, 0x2d, 0xa0, 0xe3, 0x01, 0xef, 0xb9, 0x3e, 0xe5, 0x43, 0xa4, 0xad, 0x68,
-	0x35, 0x7d, 0x17, 0x7d, 0xfb, 0xb2, 0x51, 0x1c, 0x75, 0x7c, 0x66, 0x9a, 0x3e, 0x61, 0xec, 0x80,
-	0xfb, 0xd4, 0xb1, 0xda, 0x93, 0x52, 0xf8, 0x14, 0xa4, 0x95, 0x37, 0x8a, 0xaf, 0x68, 0xb5, 0x5c,
-	0x73, 0xb9, 0x3e, 0x6d, 0xcb, 0x75, 0xd5, 0x65, 0x57, 0xbf, 0xf8, 0xf1, 0x7f, 0xec, 0xf3, 0xcd,
-	0xf9, 0xba, 0xd6, 0x1e, 0x7d, 0xb6, 0xd3, 0x7a, 0x7f, 0x73, 0xbe, 0x3e, 0x31, 0xfc, 0x70, 0x73,
-	0xbe, 0xfe, 0x68, 0xbc, 0x85, 0xd3, 0xc9, 0x26, 0xee, 0x2c, 0xb8, 0x5a, 0x02, 0x4b, 0x77, 0x52,
-	0x6d, 0xc2, 0x3c, 0xd7, 0x61, 0xa4, 0xfa, 0x2b, 0x01, 0x0a, 0xfb, 0xcc, 0xda, 0xf3, 0x09, 0xe6,
-	0xe4, 0x85, 0xc3, 0xfd, 0x21, 0x6c, 0x82, 0x8c, 0x21, 0x42, 0xd7, 0x9f, 0xb9, 0xb9, 0xa0, 0x10,
-	0x16, 0x41, 0x8a, 0x53, 0xde, 0x27, 0x72, 0x67, 0x7a, 0x5b, 0x05, 0x70, 0x05, 0xe4, 0x4c, 0xc2,
-	0x0c, 0x9f, 0x7a, 0x9c, 0xba, 0x0e, 0x4a, 0x48, 0x2d, 0x9c, 0x82, 0x25, 0x90, 0xa5, 0xde, 0x11,
-	0xeb, 0x18, 0xd4, 0x44, 0x49, 0x29, 0x67, 0x44, 0xbc, 0x47, 0x4d, 0xf8, 0x2f, 0xd0, 0x6d, 0x6a,
-	0x93, 0x0e, 0x1f, 0x7a, 0x04, 0xa5, 0xa4, 0x96, 0x15, 0x89, 0xd7, 0x43, 0x8f, 0x08, 0xf1, 0x88,
-	0xf6, 0x49, 0xc7, 0xc1, 0x36, 0x41, 0x69, 0x25, 0x8a, 0xc4, 0x4b, 0x6c, 0x13, 0x61, 0x2a, 0xc5,
-	0x81, 0xdf, 0x47, 0x19, 0x65, 0x2a, 0xe2, 0x43, 0xbf, 0x0f, 0x57, 0x41, 0xfe, 0x08, 0xf7, 0xfb,
-	0x5d, 0x6c, 0x1c, 0x4b, 0x39, 0xab, 0x96, 0x14, 0xe4, 0x44, 0x49, 0x60, 0xcd, 0xe8, 0x19, 0x41,
-	0xfa, 0xc4, 0xfa, 0x80, 0x9e, 0x11, 0x58, 0x03, 0x0b, 0x46, 0x8f, 0x18, 0xc7, 0x6c, 0x60, 0x77,
-	0x58, 0x0f, 0x77, 0x9a, 0xdb, 0x2d, 0x04, 0x64, 0x4d, 0x21, 0xc8, 0x1f, 0xf4, 0x70, 0x73, 0xbb,
-	0x05, 0x17, 0x41, 0x1a, 0x5b, 0xc4, 0x31, 0x86, 0x28, 0x27, 0xf5, 0x51, 0x04, 0xcb, 0x20, 0x6b,
-	0x60, 0x4e, 0x2c, 0xd7, 0x1f, 0xa2, 0xbc, 0x72, 0x0f, 0x62, 0xb8, 0x0c, 0x74, 0x36, 0xe8, 0xda,
-	0x94, 0x73, 0xe2, 0xa3, 0x39, 0x29, 0x4e, 0x12, 0x42, 0xe5, 0xd4, 0x26, 0x8c, 0x63, 0xdb, 0x43,
-	0x05, 0xa5, 0x8e, 0x13, 0x62, 0xd9, 0x1e, 0x75, 0x3a, 0x86, 0x3b, 0x70, 0x38, 0x9a, 0x57, 0xc6,
-	0x1e, 0x75, 0xf6, 0x44, 0xbc, 0x93, 0x17, 0xe0, 0x04, 0xc3, 0xaa, 0xd6, 0xc0, 0xe2, 0xed, 0x91,
-	0x07, 0x34, 0xc0, 0x02, 0x88, 0x53, 0x53, 0x4e, 0x3d, 0xd9, 0x8e, 0x53, 0xb3, 0xfa, 0x2e, 0x29,
-	0xe9, 0x50, 0xe4, 0xfc, 0x39, 0x1d, 0xca, 0x36, 0x1e, 0xd8, 0x4e, 0x68, 0x49, 0x44, 0xd0, 0x92,
-	0x8c, 0xa6, 0x25, 0x15, 0x41, 0x4b, 0x3a, 0x8a, 0x96, 0x4c, 0x04, 0x2d, 0xd9, 0x68, 0x5a, 0xf4,
-	0x19, 0xb4, 0x80, 0x07, 0xd0, 0x92, 0x9b, 0x41, 0x4b, 0xfe, 0x5e, 0x5a, 0xe6, 0xa2, 0x68, 0x29,
-	0x44, 0xd2, 0x32, 0x1f, 0x49, 0xcb, 0x42, 0x24, 0x2d, 0x48, 0xd2, 0x12, 0x42, 0x60, 0x7c, 0x77,
-	0x74, 0x25, 0x1c, 0xcf, 0x49, 0x9f, 0xfc, 0x45, 0x38, 0xa6, 0x76, 0x0f, 0xf5, 0x08, 0xba, 0x37,
-	0x3f, 0x25, 0x40, 0x62, 0x9f, 0x59, 0xd0, 0x04, 0xf9, 0x5b, 0xb7, 0xf3, 0xe3, 0xe9, 0xb7, 0xea,
-	0x9d, 0x0b, 0xb0, 0xbc, 0xf1, 0xa0, 0xb2, 0xf1, 0xc9, 0xc0, 0x20, 0x17, 0xbe, 0x23, 0xd7, 0xee,
-	0xfd, 0x3a, 0x54, 0x55, 0x7e, 0xf2, 0x90, 0xaa, 0x70, 0x8b, 0xf0, 0x41, 0x5b, 0x9b, 0xb1, 0xc0,
-	0x59, 0x2d, 0xa6, 0x4c, 0x4c, 0xb4, 0x08, 0x8f, 0xeb, 0xfe, 0x16, 0xa1, 0xaa, 0x88, 0x16, 0x53,
-	0xc6, 0x52, 0x4e, 0xbd, 0x15, 0x4f, 0xd6, 0xee, 0xd6, 0xc5, 0x55, 0x45, 0xbb, 0xbc, 0xaa, 0x68,
-	0x3f, 0xaf, 0x2a, 0xda, 0xc7, 0xeb, 0x4a, 0xec, 0xf2, 0xba, 0x12, 0xfb, 0x7e, 0x5d, 0x89, 0xbd,
-	0x29, 0x4d, 0x7b, 0xb1, 0xc4, 0xb1, 0x65, 0xdd, 0xb4, 0x7c, 0x73, 0xb7, 0x7e, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0xcb, 0x96, 0xa4, 0xef, 0x2a, 0x08, 0x00, 0x00,
+func (m *MsgRegisterAgency) Reset()         { *m = MsgRegisterAgency{} }
+func (m *MsgRegisterAgency) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAgency) ProtoMessage()    {}
+func (*MsgRegisterAgency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{8}
+}
+func (m *MsgRegisterAgency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAgency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAgency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAgency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAgency.Merge(m, src)
+}
+func (m *MsgRegisterAgency) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAgency) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAgency.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRegisterAgency proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateEntry defines the CreateEntry RPC.
-	CreateEntry(ctx context.Context, in *MsgCreateEntry, opts ...grpc.CallOption) (*MsgCreateEntryResponse, error)
-	// UpdateEntry defines the UpdateEntry RPC.
-	UpdateEntry(ctx context.Context, in *MsgUpdateEntry, opts ...grpc.CallOption) (*MsgUpdateEntryResponse, error)
-	// DeleteEntry defines the DeleteEntry RPC.
-	DeleteEntry(ctx context.Context, in *MsgDeleteEntry, opts ...grpc.CallOption) (*MsgDeleteEntryResponse, error)
+func (m *MsgRegisterAgency) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterAgency) GetAgency() Agency {
+	if m != nil {
+		return m.Agency
+	}
+	return Agency{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgRegisterAgencyResponse defines the response structure for executing a
+// MsgRegisterAgency message.
+type MsgRegisterAgencyResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterAgencyResponse) Reset()         { *m = MsgRegisterAgencyResponse{} }
+func (m *MsgRegisterAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAgencyResponse) ProtoMessage()    {}
+func (*MsgRegisterAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{9}
+}
+func (m *MsgRegisterAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAgencyResponse.Merge(m, src)
+}
+func (m *MsgRegisterAgencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAgencyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateEntry(ctx context.Context, in *MsgCreateEntry, opts ...grpc.CallOption) (*MsgCreateEntryResponse, error) {
-	out := new(MsgCreateEntryResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/CreateEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterAgencyResponse proto.InternalMessageInfo
+
+// MsgUpdateAgency is the Msg/UpdateAgency request type.
+type MsgUpdateAgency struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All fields, including the full publisher list, must be supplied.
+	Agency Agency `protobuf:"bytes,2,opt,name=agency,proto3" json:"agency"`
 }
 
-func (c *msgClient) UpdateEntry(ctx context.Context, in *MsgUpdateEntry, opts ...grpc.CallOption) (*MsgUpdateEntryResponse, error) {
-	out := new(MsgUpdateEntryResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/UpdateEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgency) Reset()         { *m = MsgUpdateAgency{} }
+func (m *MsgUpdateAgency) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgency) ProtoMessage()    {}
+func (*MsgUpdateAgency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{10}
+}
+func (m *MsgUpdateAgency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateAgency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgency.Merge(m, src)
+}
+func (m *MsgUpdateAgency) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgency) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgency.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteEntry(ctx context.Context, in *MsgDeleteEntry, opts ...grpc.CallOption) (*MsgDeleteEntryResponse, error) {
-	out := new(MsgDeleteEntryResponse)
-	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/DeleteEntry", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateAgency proto.InternalMessageInfo
+
+func (m *MsgUpdateAgency) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateEntry defines the CreateEntry RPC.
-	CreateEntry(context.Context, *MsgCreateEntry) (*MsgCreateEntryResponse, error)
-	// UpdateEntry defines the UpdateEntry RPC.
-	UpdateEntry(context.Context, *MsgUpdateEntry) (*MsgUpdateEntryResponse, error)
-	// DeleteEntry defines the DeleteEntry RPC.
-	DeleteEntry(context.Context, *MsgDeleteEntry) (*MsgDeleteEntryResponse, error)
+func (m *MsgUpdateAgency) GetAgency() Agency {
+	if m != nil {
+		return m.Agency
+	}
+	return Agency{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUpdateAgencyResponse defines the response structure for executing a
+// MsgUpdateAgency message.
+type MsgUpdateAgencyResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateAgencyResponse) Reset()         { *m = MsgUpdateAgencyResponse{} }
+func (m *MsgUpdateAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgencyResponse) ProtoMessage()    {}
+func (*MsgUpdateAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{11}
 }
-func (*UnimplementedMsgServer) CreateEntry(ctx context.Context, req *MsgCreateEntry) (*MsgCreateEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
+func (m *MsgUpdateAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateEntry(ctx context.Context, req *MsgUpdateEntry) (*MsgUpdateEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntry not implemented")
+func (m *MsgUpdateAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteEntry(ctx context.Context, req *MsgDeleteEntry) (*MsgDeleteEntryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
+func (m *MsgUpdateAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgencyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAgencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgencyResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateAgencyResponse proto.InternalMessageInfo
+
+// MsgDeregisterAgency is the Msg/DeregisterAgency request type.
+type MsgDeregisterAgency struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the agency to remove.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgDeregisterAgency) Reset()         { *m = MsgDeregisterAgency{} }
+func (m *MsgDeregisterAgency) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterAgency) ProtoMessage()    {}
+func (*MsgDeregisterAgency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{12}
+}
+func (m *MsgDeregisterAgency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterAgency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterAgency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterAgency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterAgency.Merge(m, src)
+}
+func (m *MsgDeregisterAgency) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterAgency) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterAgency.DiscardUnknown(m)
 }
 
-func _Msg_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateEntry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Msg/CreateEntry",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateEntry(ctx, req.(*MsgCreateEntry))
+var xxx_messageInfo_MsgDeregisterAgency proto.InternalMessageInfo
+
+func (m *MsgDeregisterAgency) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateEntry)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateEntry(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Msg/UpdateEntry",
+func (m *MsgDeregisterAgency) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateEntry(ctx, req.(*MsgUpdateEntry))
+	return ""
+}
+
+// MsgDeregisterAgencyResponse defines the response structure for executing a
+// MsgDeregisterAgency message.
+type MsgDeregisterAgencyResponse struct {
+}
+
+func (m *MsgDeregisterAgencyResponse) Reset()         { *m = MsgDeregisterAgencyResponse{} }
+func (m *MsgDeregisterAgencyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterAgencyResponse) ProtoMessage()    {}
+func (*MsgDeregisterAgencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{13}
+}
+func (m *MsgDeregisterAgencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterAgencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterAgencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterAgencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterAgencyResponse.Merge(m, src)
+}
+func (m *MsgDeregisterAgencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterAgencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterAgencyResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteEntry)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeregisterAgencyResponse proto.InternalMessageInfo
+
+// MsgPurgeEntry is the Msg/PurgeEntry request type. It physically removes an
+// entry and its revision history, and is reserved for legally mandated
+// removals such as leaked personal data.
+type MsgPurgeEntry struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the entry to purge.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// reason is the legal ground of the removal.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgPurgeEntry) Reset()         { *m = MsgPurgeEntry{} }
+func (m *MsgPurgeEntry) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeEntry) ProtoMessage()    {}
+func (*MsgPurgeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{14}
+}
+func (m *MsgPurgeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteEntry(ctx, in)
+}
+func (m *MsgPurgeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeEntry.Merge(m, src)
+}
+func (m *MsgPurgeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurgeEntry proto.InternalMessageInfo
+
+func (m *MsgPurgeEntry) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govchain.datasets.v1.Msg/DeleteEntry",
+	return ""
+}
+
+func (m *MsgPurgeEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteEntry(ctx, req.(*MsgDeleteEntry))
+	return 0
+}
+
+func (m *MsgPurgeEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "govchain.datasets.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateEntry",
-			Handler:    _Msg_CreateEntry_Handler,
-		},
-		{
-			MethodName: "UpdateEntry",
-			Handler:    _Msg_UpdateEntry_Handler,
-		},
-		{
-			MethodName: "DeleteEntry",
-			Handler:    _Msg_DeleteEntry_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "govchain/datasets/v1/tx.proto",
+// MsgPurgeEntryResponse defines the response structure for executing a
+// MsgPurgeEntry message.
+type MsgPurgeEntryResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPurgeEntryResponse) Reset()         { *m = MsgPurgeEntryResponse{} }
+func (m *MsgPurgeEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurgeEntryResponse) ProtoMessage()    {}
+func (*MsgPurgeEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{15}
+}
+func (m *MsgPurgeEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurgeEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurgeEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPurgeEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurgeEntryResponse.Merge(m, src)
+}
+func (m *MsgPurgeEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurgeEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurgeEntryResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPurgeEntryResponse proto.InternalMessageInfo
+
+// MsgRegisterPinner is the Msg/RegisterPinner request type.
+type MsgRegisterPinner struct {
+	Pinner  string `protobuf:"bytes,1,opt,name=pinner,proto3" json:"pinner,omitempty"`
+	Moniker string `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// endpoint optionally locates the IPFS node of the pinner.
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterPinner) Reset()         { *m = MsgRegisterPinner{} }
+func (m *MsgRegisterPinner) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPinner) ProtoMessage()    {}
+func (*MsgRegisterPinner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{16}
+}
+func (m *MsgRegisterPinner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPinner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPinner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRegisterPinner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPinner.Merge(m, src)
+}
+func (m *MsgRegisterPinner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPinner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPinner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPinner proto.InternalMessageInfo
+
+func (m *MsgRegisterPinner) GetPinner() string {
+	if m != nil {
+		return m.Pinner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterPinner) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterPinner) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRegisterPinnerResponse defines the response structure for executing a
+// MsgRegisterPinner message.
+type MsgRegisterPinnerResponse struct {
 }
 
-func (m *MsgCreateEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterPinnerResponse) Reset()         { *m = MsgRegisterPinnerResponse{} }
+func (m *MsgRegisterPinnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPinnerResponse) ProtoMessage()    {}
+func (*MsgRegisterPinnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{17}
+}
+func (m *MsgRegisterPinnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPinnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPinnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterPinnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPinnerResponse.Merge(m, src)
+}
+func (m *MsgRegisterPinnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPinnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPinnerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterPinnerResponse proto.InternalMessageInfo
+
+// MsgAttestPin is the Msg/AttestPin request type.
+type MsgAttestPin struct {
+	Pinner  string `protobuf:"bytes,1,opt,name=pinner,proto3" json:"pinner,omitempty"`
+	EntryId uint64 `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// cid is the IPFS CID of the pinned content. It must be the CID of the
+	// entry, in either CID version.
+	Cid string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	// proof_height is the block height at which the pinner checked that it
+	// holds the content. The attestation expires pin_attestation_window_blocks
+	// after it.
+	ProofHeight int64 `protobuf:"varint,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
 }
 
-func (m *MsgCreateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PinCount) > 0 {
-		i -= len(m.PinCount)
-		copy(dAtA[i:], m.PinCount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PinCount)))
-		i--
-		dAtA[i] = 0x7a
+func (m *MsgAttestPin) Reset()         { *m = MsgAttestPin{} }
+func (m *MsgAttestPin) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestPin) ProtoMessage()    {}
+func (*MsgAttestPin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{18}
+}
+func (m *MsgAttestPin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestPin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestPin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Timestamp) > 0 {
-		i -= len(m.Timestamp)
-		copy(dAtA[i:], m.Timestamp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Timestamp)))
-		i--
-		dAtA[i] = 0x72
+}
+func (m *MsgAttestPin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestPin.Merge(m, src)
+}
+func (m *MsgAttestPin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestPin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestPin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestPin proto.InternalMessageInfo
+
+func (m *MsgAttestPin) GetPinner() string {
+	if m != nil {
+		return m.Pinner
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *MsgAttestPin) GetEntryId() uint64 {
+	if m != nil {
+		return m.EntryId
 	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Agency) > 0 {
-		i -= len(m.Agency)
-		copy(dAtA[i:], m.Agency)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Agency)))
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+func (m *MsgAttestPin) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
-	if len(m.ChecksumSha_256) > 0 {
-		i -= len(m.ChecksumSha_256)
-		copy(dAtA[i:], m.ChecksumSha_256)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChecksumSha_256)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *MsgAttestPin) GetProofHeight() int64 {
+	if m != nil {
+		return m.ProofHeight
 	}
-	if len(m.FileSize) > 0 {
-		i -= len(m.FileSize)
-		copy(dAtA[i:], m.FileSize)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileSize)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+// MsgAttestPinResponse defines the response structure for executing a
+// MsgAttestPin message.
+type MsgAttestPinResponse struct {
+	ExpiresHeight int64 `protobuf:"varint,1,opt,name=expires_height,json=expiresHeight,proto3" json:"expires_height,omitempty"`
+}
+
+func (m *MsgAttestPinResponse) Reset()         { *m = MsgAttestPinResponse{} }
+func (m *MsgAttestPinResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestPinResponse) ProtoMessage()    {}
+func (*MsgAttestPinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{19}
+}
+func (m *MsgAttestPinResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestPinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestPinResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FallbackUrl) > 0 {
-		i -= len(m.FallbackUrl)
-		copy(dAtA[i:], m.FallbackUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackUrl)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *MsgAttestPinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestPinResponse.Merge(m, src)
+}
+func (m *MsgAttestPinResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestPinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestPinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestPinResponse proto.InternalMessageInfo
+
+func (m *MsgAttestPinResponse) GetExpiresHeight() int64 {
+	if m != nil {
+		return m.ExpiresHeight
 	}
-	if len(m.FileUrl) > 0 {
-		i -= len(m.FileUrl)
-		copy(dAtA[i:], m.FileUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileUrl)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+// MsgRespondChallenge is the Msg/RespondChallenge request type.
+type MsgRespondChallenge struct {
+	Pinner      string `protobuf:"bytes,1,opt,name=pinner,proto3" json:"pinner,omitempty"`
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// chunk is the leaf block of the content holding the challenged byte, or
+	// the root block when the challenged offset is past the content.
+	Chunk []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// path lists the blocks of the UnixFS DAG from the root, the block of the
+	// challenged CID, down to the parent of the chunk. It is empty when the
+	// content is a single chunk or the offset is past the content.
+	Path [][]byte `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *MsgRespondChallenge) Reset()         { *m = MsgRespondChallenge{} }
+func (m *MsgRespondChallenge) String() string { return proto.CompactTextString(m) }
+func (*MsgRespondChallenge) ProtoMessage()    {}
+func (*MsgRespondChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{20}
+}
+func (m *MsgRespondChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRespondChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRespondChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FileName) > 0 {
-		i -= len(m.FileName)
-		copy(dAtA[i:], m.FileName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileName)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *MsgRespondChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRespondChallenge.Merge(m, src)
+}
+func (m *MsgRespondChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRespondChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRespondChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRespondChallenge proto.InternalMessageInfo
+
+func (m *MsgRespondChallenge) GetPinner() string {
+	if m != nil {
+		return m.Pinner
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *MsgRespondChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
 	}
-	if len(m.IpfsCid) > 0 {
-		i -= len(m.IpfsCid)
-		copy(dAtA[i:], m.IpfsCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsCid)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *MsgRespondChallenge) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *MsgRespondChallenge) GetPath() [][]byte {
+	if m != nil {
+		return m.Path
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+// MsgRespondChallengeResponse defines the response structure for executing a
+// MsgRespondChallenge message.
+type MsgRespondChallengeResponse struct {
+	// passed tells whether the response proves the retrievability of the
+	// content. A challenge answered with an invalid proof is failed.
+	Passed bool `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	// reputation is the reputation of the pinner after the challenge.
+	Reputation uint64 `protobuf:"varint,2,opt,name=reputation,proto3" json:"reputation,omitempty"`
+}
+
+func (m *MsgRespondChallengeResponse) Reset()         { *m = MsgRespondChallengeResponse{} }
+func (m *MsgRespondChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRespondChallengeResponse) ProtoMessage()    {}
+func (*MsgRespondChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{21}
+}
+func (m *MsgRespondChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRespondChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRespondChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRespondChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRespondChallengeResponse.Merge(m, src)
+}
+func (m *MsgRespondChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRespondChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRespondChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRespondChallengeResponse proto.InternalMessageInfo
+
+func (m *MsgRespondChallengeResponse) GetPassed() bool {
+	if m != nil {
+		return m.Passed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *MsgCreateEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRespondChallengeResponse) GetReputation() uint64 {
+	if m != nil {
+		return m.Reputation
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateEntriesBatch is the Msg/CreateEntriesBatch request type.
+type MsgCreateEntriesBatch struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// entries are created in order, as by MsgCreateEntry messages of the
+	// creator.
+	Entries []BatchEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *MsgCreateEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgCreateEntriesBatch) Reset()         { *m = MsgCreateEntriesBatch{} }
+func (m *MsgCreateEntriesBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEntriesBatch) ProtoMessage()    {}
+func (*MsgCreateEntriesBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{22}
+}
+func (m *MsgCreateEntriesBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEntriesBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEntriesBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateEntriesBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEntriesBatch.Merge(m, src)
+}
+func (m *MsgCreateEntriesBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEntriesBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEntriesBatch.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateEntriesBatch proto.InternalMessageInfo
+
+func (m *MsgCreateEntriesBatch) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateEntriesBatch) GetEntries() []BatchEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (m *MsgUpdateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PinCount) > 0 {
-		i -= len(m.PinCount)
-		copy(dAtA[i:], m.PinCount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PinCount)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+// BatchEntry holds the fields of an entry created by MsgCreateEntriesBatch,
+// with the meaning they have in MsgCreateEntry.
+type BatchEntry struct {
+	Title           string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	IpfsCid         string      `protobuf:"bytes,3,opt,name=ipfs_cid,json=ipfsCid,proto3" json:"ipfs_cid,omitempty"`
+	MimeType        string      `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	FileName        string      `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileUrl         string      `protobuf:"bytes,6,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	FallbackUrl     string      `protobuf:"bytes,7,opt,name=fallback_url,json=fallbackUrl,proto3" json:"fallback_url,omitempty"`
+	FileSize        uint64      `protobuf:"varint,8,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	ChecksumSha_256 string      `protobuf:"bytes,9,opt,name=checksum_sha_256,json=checksumSha256,proto3" json:"checksum_sha_256,omitempty"`
+	Agency          string      `protobuf:"bytes,10,opt,name=agency,proto3" json:"agency,omitempty"`
+	Category        string      `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	Submitter       string      `protobuf:"bytes,12,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	PublishedAt     time.Time   `protobuf:"bytes,13,opt,name=published_at,json=publishedAt,proto3,stdtime" json:"published_at"`
+	MirrorOf        *MirrorLink `protobuf:"bytes,14,opt,name=mirror_of,json=mirrorOf,proto3" json:"mirror_of,omitempty"`
+}
+
+func (m *BatchEntry) Reset()         { *m = BatchEntry{} }
+func (m *BatchEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchEntry) ProtoMessage()    {}
+func (*BatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{23}
+}
+func (m *BatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Timestamp) > 0 {
-		i -= len(m.Timestamp)
-		copy(dAtA[i:], m.Timestamp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Timestamp)))
-		i--
-		dAtA[i] = 0x7a
+}
+func (m *BatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEntry.Merge(m, src)
+}
+func (m *BatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchEntry proto.InternalMessageInfo
+
+func (m *BatchEntry) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x72
+	return ""
+}
+
+func (m *BatchEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Category) > 0 {
-		i -= len(m.Category)
-		copy(dAtA[i:], m.Category)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Agency) > 0 {
-		i -= len(m.Agency)
-		copy(dAtA[i:], m.Agency)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Agency)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.ChecksumSha_256) > 0 {
-		i -= len(m.ChecksumSha_256)
-		copy(dAtA[i:], m.ChecksumSha_256)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChecksumSha_256)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.FileSize) > 0 {
-		i -= len(m.FileSize)
-		copy(dAtA[i:], m.FileSize)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileSize)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.FallbackUrl) > 0 {
-		i -= len(m.FallbackUrl)
-		copy(dAtA[i:], m.FallbackUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackUrl)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.FileUrl) > 0 {
-		i -= len(m.FileUrl)
-		copy(dAtA[i:], m.FileUrl)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileUrl)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *BatchEntry) GetIpfsCid() string {
+	if m != nil {
+		return m.IpfsCid
 	}
-	if len(m.FileName) > 0 {
-		i -= len(m.FileName)
-		copy(dAtA[i:], m.FileName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileName)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *BatchEntry) GetMimeType() string {
+	if m != nil {
+		return m.MimeType
 	}
-	if len(m.MimeType) > 0 {
-		i -= len(m.MimeType)
-		copy(dAtA[i:], m.MimeType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *BatchEntry) GetFileName() string {
+	if m != nil {
+		return m.FileName
 	}
-	if len(m.IpfsCid) > 0 {
-		i -= len(m.IpfsCid)
-		copy(dAtA[i:], m.IpfsCid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsCid)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *BatchEntry) GetFileUrl() string {
+	if m != nil {
+		return m.FileUrl
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *BatchEntry) GetFallbackUrl() string {
+	if m != nil {
+		return m.FallbackUrl
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *BatchEntry) GetFileSize() uint64 {
+	if m != nil {
+		return m.FileSize
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *BatchEntry) GetChecksumSha_256() string {
+	if m != nil {
+		return m.ChecksumSha_256
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *BatchEntry) GetAgency() string {
+	if m != nil {
+		return m.Agency
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchEntry) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchEntry) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
 }
 
-func (m *MsgUpdateEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BatchEntry) GetPublishedAt() time.Time {
+	if m != nil {
+		return m.PublishedAt
+	}
+	return time.Time{}
 }
 
-func (m *MsgDeleteEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchEntry) GetMirrorOf() *MirrorLink {
+	if m != nil {
+		return m.MirrorOf
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateEntriesBatchResponse defines the response structure for executing
+// a MsgCreateEntriesBatch message.
+type MsgCreateEntriesBatchResponse struct {
+	// ids are the ids of the created entries, in the order of the batch.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *MsgDeleteEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateEntriesBatchResponse) Reset()         { *m = MsgCreateEntriesBatchResponse{} }
+func (m *MsgCreateEntriesBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateEntriesBatchResponse) ProtoMessage()    {}
+func (*MsgCreateEntriesBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{24}
+}
+func (m *MsgCreateEntriesBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateEntriesBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateEntriesBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateEntriesBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateEntriesBatchResponse.Merge(m, src)
+}
+func (m *MsgCreateEntriesBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateEntriesBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateEntriesBatchResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteEntryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateEntriesBatchResponse proto.InternalMessageInfo
+
+func (m *MsgCreateEntriesBatchResponse) GetIds() []uint64 {
+	if m != nil {
+		return m.Ids
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeleteEntryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateAllowedPinners is the Msg/UpdateAllowedPinners request type. The
+// revoked addresses are removed before the allowed ones are added, and the
+// resulting list cannot exceed the max_allowed_pinners param.
+type MsgUpdateAllowedPinners struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// allow lists the addresses to allow.
+	Allow []string `protobuf:"bytes,2,rep,name=allow,proto3" json:"allow,omitempty"`
+	// revoke lists the addresses to revoke. A revoked pinner stays registered
+	// but can no longer attest to pins.
+	Revoke []string `protobuf:"bytes,3,rep,name=revoke,proto3" json:"revoke,omitempty"`
 }
 
-func (m *MsgDeleteEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateAllowedPinners) Reset()         { *m = MsgUpdateAllowedPinners{} }
+func (m *MsgUpdateAllowedPinners) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedPinners) ProtoMessage()    {}
+func (*MsgUpdateAllowedPinners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{25}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgUpdateAllowedPinners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateAllowedPinners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedPinners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgUpdateAllowedPinners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedPinners.Merge(m, src)
+}
+func (m *MsgUpdateAllowedPinners) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedPinners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedPinners.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateAllowedPinners proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowedPinners) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCreateEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateAllowedPinners) GetAllow() []string {
+	if m != nil {
+		return m.Allow
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgUpdateAllowedPinners) GetRevoke() []string {
+	if m != nil {
+		return m.Revoke
 	}
-	l = len(m.IpfsCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgUpdateAllowedPinnersResponse defines the response structure for executing
+// a MsgUpdateAllowedPinners message.
+type MsgUpdateAllowedPinnersResponse struct {
+}
+
+func (m *MsgUpdateAllowedPinnersResponse) Reset()         { *m = MsgUpdateAllowedPinnersResponse{} }
+func (m *MsgUpdateAllowedPinnersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedPinnersResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowedPinnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c94f77eb4f7727a8, []int{26}
+}
+func (m *MsgUpdateAllowedPinnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedPinnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedPinnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgUpdateAllowedPinnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedPinnersResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowedPinnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedPinnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedPinnersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowedPinnersResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "govchain.datasets.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "govchain.datasets.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateEntry)(nil), "govchain.datasets.v1.MsgCreateEntry")
+	proto.RegisterType((*MsgCreateEntryResponse)(nil), "govchain.datasets.v1.MsgCreateEntryResponse")
+	proto.RegisterType((*MsgUpdateEntry)(nil), "govchain.datasets.v1.MsgUpdateEntry")
+	proto.RegisterType((*MsgUpdateEntryResponse)(nil), "govchain.datasets.v1.MsgUpdateEntryResponse")
+	proto.RegisterType((*MsgDeleteEntry)(nil), "govchain.datasets.v1.MsgDeleteEntry")
+	proto.RegisterType((*MsgDeleteEntryResponse)(nil), "govchain.datasets.v1.MsgDeleteEntryResponse")
+	proto.RegisterType((*MsgRegisterAgency)(nil), "govchain.datasets.v1.MsgRegisterAgency")
+	proto.RegisterType((*MsgRegisterAgencyResponse)(nil), "govchain.datasets.v1.MsgRegisterAgencyResponse")
+	proto.RegisterType((*MsgUpdateAgency)(nil), "govchain.datasets.v1.MsgUpdateAgency")
+	proto.RegisterType((*MsgUpdateAgencyResponse)(nil), "govchain.datasets.v1.MsgUpdateAgencyResponse")
+	proto.RegisterType((*MsgDeregisterAgency)(nil), "govchain.datasets.v1.MsgDeregisterAgency")
+	proto.RegisterType((*MsgDeregisterAgencyResponse)(nil), "govchain.datasets.v1.MsgDeregisterAgencyResponse")
+	proto.RegisterType((*MsgPurgeEntry)(nil), "govchain.datasets.v1.MsgPurgeEntry")
+	proto.RegisterType((*MsgPurgeEntryResponse)(nil), "govchain.datasets.v1.MsgPurgeEntryResponse")
+	proto.RegisterType((*MsgRegisterPinner)(nil), "govchain.datasets.v1.MsgRegisterPinner")
+	proto.RegisterType((*MsgRegisterPinnerResponse)(nil), "govchain.datasets.v1.MsgRegisterPinnerResponse")
+	proto.RegisterType((*MsgAttestPin)(nil), "govchain.datasets.v1.MsgAttestPin")
+	proto.RegisterType((*MsgAttestPinResponse)(nil), "govchain.datasets.v1.MsgAttestPinResponse")
+	proto.RegisterType((*MsgRespondChallenge)(nil), "govchain.datasets.v1.MsgRespondChallenge")
+	proto.RegisterType((*MsgRespondChallengeResponse)(nil), "govchain.datasets.v1.MsgRespondChallengeResponse")
+	proto.RegisterType((*MsgCreateEntriesBatch)(nil), "govchain.datasets.v1.MsgCreateEntriesBatch")
+	proto.RegisterType((*BatchEntry)(nil), "govchain.datasets.v1.BatchEntry")
+	proto.RegisterType((*MsgCreateEntriesBatchResponse)(nil), "govchain.datasets.v1.MsgCreateEntriesBatchResponse")
+	proto.RegisterType((*MsgUpdateAllowedPinners)(nil), "govchain.datasets.v1.MsgUpdateAllowedPinners")
+	proto.RegisterType((*MsgUpdateAllowedPinnersResponse)(nil), "govchain.datasets.v1.MsgUpdateAllowedPinnersResponse")
+}
+
+func init() { proto.RegisterFile("govchain/datasets/v1/tx.proto", fileDescriptor_c94f77eb4f7727a8) }
+
+var fileDescriptor_c94f77eb4f7727a8 = []byte{
+	// 1683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6c, 0x1b, 0x45,
+	0x17, 0xcf, 0xc6, 0x8e, 0x63, 0x3f, 0x3b, 0xae, 0xb3, 0x4d, 0xd3, 0x8d, 0xdb, 0x26, 0x8e, 0xdb,
+	0xaa, 0x6e, 0xfa, 0xd5, 0xfe, 0x92, 0x7e, 0x8d, 0x3e, 0x05, 0x15, 0x94, 0x94, 0x02, 0x8d, 0x30,
+	0x44, 0xdb, 0xf6, 0x02, 0x12, 0xd6, 0xc6, 0x3b, 0x5e, 0x0f, 0xb6, 0x77, 0x57, 0x3b, 0xe3, 0xb4,
+	0xa9, 0x38, 0x20, 0x4e, 0x88, 0x53, 0xaf, 0x1c, 0x90, 0xe0, 0x80, 0xc4, 0xb1, 0x07, 0x8e, 0x9c,
+	0x38, 0xa0, 0x1e, 0x2b, 0x4e, 0x9c, 0x00, 0xb5, 0x12, 0xbd, 0x22, 0x71, 0xe1, 0x88, 0x66, 0x66,
+	0x77, 0xbd, 0xeb, 0x3f, 0x6b, 0x27, 0xad, 0xc4, 0xc5, 0xda, 0x79, 0xf3, 0x9b, 0x79, 0xef, 0xcd,
+	0xfc, 0x66, 0xde, 0x6f, 0x0c, 0xe7, 0x0c, 0xeb, 0xa0, 0xde, 0xd4, 0xb0, 0x59, 0xd1, 0x35, 0xaa,
+	0x11, 0x44, 0x49, 0xe5, 0x60, 0xbd, 0x42, 0x1f, 0x94, 0x6d, 0xc7, 0xa2, 0x96, 0xbc, 0xe0, 0x75,
+	0x97, 0xbd, 0xee, 0xf2, 0xc1, 0x7a, 0x7e, 0x5e, 0xeb, 0x60, 0xd3, 0xaa, 0xf0, 0x5f, 0x01, 0xcc,
+	0x9f, 0xae, 0x5b, 0xa4, 0x63, 0x91, 0x4a, 0x87, 0x18, 0x6c, 0x82, 0x0e, 0x31, 0xdc, 0x8e, 0x25,
+	0xd1, 0x51, 0xe3, 0xad, 0x8a, 0x68, 0xb8, 0x5d, 0x0b, 0x86, 0x65, 0x58, 0xc2, 0xce, 0xbe, 0x5c,
+	0x6b, 0xc1, 0xb0, 0x2c, 0xa3, 0x8d, 0x2a, 0xbc, 0xb5, 0xdf, 0x6d, 0x54, 0x1a, 0x18, 0xb5, 0xf5,
+	0x5a, 0x47, 0x23, 0x2d, 0x17, 0xb1, 0xd2, 0x8f, 0xa0, 0xb8, 0x83, 0x08, 0xd5, 0x3a, 0xb6, 0x0b,
+	0x58, 0x1d, 0x9a, 0x94, 0x66, 0x20, 0xb3, 0x7e, 0x18, 0x09, 0xb1, 0x35, 0x47, 0xeb, 0x90, 0x5e,
+	0x20, 0x03, 0x90, 0x8d, 0x0a, 0x32, 0xa9, 0xe3, 0x4e, 0x52, 0xfc, 0x51, 0x82, 0x13, 0x55, 0x62,
+	0xdc, 0xb3, 0x75, 0x8d, 0xa2, 0x3d, 0x3e, 0x56, 0xde, 0x84, 0x94, 0xd6, 0xa5, 0x4d, 0xcb, 0xc1,
+	0xf4, 0x50, 0x91, 0x0a, 0x52, 0x29, 0xb5, 0xa3, 0xfc, 0xfc, 0xfd, 0xd5, 0x05, 0x37, 0xf3, 0x6d,
+	0x5d, 0x77, 0x10, 0x21, 0x77, 0xa8, 0x83, 0x4d, 0x43, 0xed, 0x41, 0xe5, 0x37, 0x20, 0x21, 0xbc,
+	0x2b, 0xd3, 0x05, 0xa9, 0x94, 0xde, 0x38, 0x5b, 0x1e, 0xb6, 0xf4, 0x65, 0xe1, 0x65, 0x27, 0xf5,
+	0xe4, 0xd7, 0x95, 0xa9, 0xef, 0x5e, 0x3c, 0x5e, 0x93, 0x54, 0x77, 0xd8, 0xd6, 0xe6, 0x67, 0x2f,
+	0x1e, 0xaf, 0xf5, 0x26, 0xfc, 0xe2, 0xc5, 0xe3, 0xb5, 0xf3, 0x7e, 0x06, 0x0f, 0x7a, 0x39, 0xf4,
+	0x05, 0x5c, 0x5c, 0x82, 0xd3, 0x7d, 0x26, 0x15, 0x11, 0xdb, 0x32, 0x09, 0x2a, 0xfe, 0x11, 0x87,
+	0x6c, 0x95, 0x18, 0x37, 0x1d, 0xa4, 0x51, 0x74, 0x8b, 0x25, 0x2e, 0x6f, 0xc0, 0x6c, 0x9d, 0x35,
+	0x2d, 0x67, 0x6c, 0x72, 0x1e, 0x50, 0x5e, 0x80, 0x19, 0x8a, 0x69, 0x1b, 0xf1, 0xcc, 0x52, 0xaa,
+	0x68, 0xc8, 0x05, 0x48, 0xeb, 0x88, 0xd4, 0x1d, 0x6c, 0x53, 0x6c, 0x99, 0x4a, 0x8c, 0xf7, 0x05,
+	0x4d, 0xf2, 0x12, 0x24, 0xb1, 0xdd, 0x20, 0xb5, 0x3a, 0xd6, 0x95, 0x38, 0xef, 0x9e, 0x65, 0xed,
+	0x9b, 0x58, 0x97, 0xcf, 0x40, 0xaa, 0x83, 0x3b, 0xa8, 0x46, 0x0f, 0x6d, 0xa4, 0xcc, 0xf0, 0xbe,
+	0x24, 0x33, 0xdc, 0x3d, 0xb4, 0x11, 0xeb, 0x6c, 0xe0, 0x36, 0xaa, 0x99, 0x5a, 0x07, 0x29, 0x09,
+	0xd1, 0xc9, 0x0c, 0xef, 0x69, 0x1d, 0xc4, 0x26, 0xe5, 0x9d, 0x5d, 0xa7, 0xad, 0xcc, 0x8a, 0x49,
+	0x59, 0xfb, 0x9e, 0xd3, 0x96, 0x57, 0x21, 0xd3, 0xd0, 0xda, 0xed, 0x7d, 0xad, 0xde, 0xe2, 0xdd,
+	0x49, 0x11, 0x92, 0x67, 0x63, 0x90, 0x12, 0xe4, 0xea, 0x4d, 0x54, 0x6f, 0x91, 0x6e, 0xa7, 0x46,
+	0x9a, 0x5a, 0x6d, 0xe3, 0xfa, 0xa6, 0x02, 0x1c, 0x96, 0xf5, 0xec, 0x77, 0x9a, 0xda, 0xc6, 0xf5,
+	0x4d, 0x79, 0x11, 0x12, 0x82, 0x70, 0x4a, 0x9a, 0xf7, 0xbb, 0x2d, 0x39, 0x0f, 0xc9, 0xba, 0x46,
+	0x91, 0x61, 0x39, 0x87, 0x4a, 0x46, 0xc4, 0xe6, 0xb5, 0xe5, 0xb3, 0x90, 0x22, 0xdd, 0xfd, 0x0e,
+	0xa6, 0x14, 0x39, 0xca, 0x1c, 0xef, 0xec, 0x19, 0xe4, 0x1b, 0x2c, 0x67, 0xc7, 0xb1, 0x9c, 0x9a,
+	0xd5, 0x50, 0x72, 0x9c, 0x24, 0x85, 0x61, 0x24, 0xd9, 0x28, 0x57, 0x39, 0xec, 0x5d, 0x6c, 0xb6,
+	0xd8, 0xaa, 0xb0, 0xef, 0xf7, 0x1b, 0xfe, 0xaa, 0x10, 0xfc, 0x10, 0x29, 0xf3, 0x05, 0xa9, 0x14,
+	0x17, 0xab, 0x72, 0x07, 0x3f, 0x44, 0xf2, 0xdb, 0x90, 0xb1, 0xbb, 0xfb, 0x6d, 0x4c, 0x9a, 0x48,
+	0xaf, 0x69, 0x54, 0x91, 0xf9, 0xf4, 0xf9, 0xb2, 0x38, 0x69, 0x65, 0xef, 0xa4, 0x95, 0xef, 0x7a,
+	0x27, 0x6d, 0x27, 0xc9, 0x18, 0xf8, 0xe8, 0xb7, 0x15, 0x49, 0x4d, 0xfb, 0x23, 0xb7, 0xe9, 0x56,
+	0x86, 0xb1, 0xd0, 0xdb, 0xf9, 0xdd, 0x78, 0x32, 0x95, 0x83, 0xdd, 0x78, 0x32, 0x9b, 0x3b, 0xb1,
+	0x1b, 0x4f, 0x9e, 0xc8, 0xe5, 0xd4, 0x94, 0x7f, 0x56, 0xd5, 0x94, 0x8d, 0xcd, 0x5a, 0xdd, 0xea,
+	0x9a, 0xb4, 0x58, 0x82, 0xc5, 0x30, 0xcf, 0x3c, 0x0a, 0xca, 0x59, 0x98, 0xc6, 0x3a, 0xa7, 0x5a,
+	0x5c, 0x9d, 0xc6, 0x7a, 0xf1, 0x87, 0x19, 0x4e, 0x49, 0x41, 0xd7, 0xe3, 0x53, 0x52, 0x4c, 0x3b,
+	0xed, 0x4d, 0xdb, 0xa3, 0x68, 0x2c, 0x82, 0xa2, 0xf1, 0x68, 0x8a, 0xce, 0x44, 0x50, 0x34, 0x11,
+	0x45, 0xd1, 0xd9, 0x08, 0x8a, 0x26, 0xa3, 0x29, 0x9a, 0x9a, 0x8c, 0xa2, 0xe9, 0x31, 0x14, 0xcd,
+	0x8c, 0xa4, 0xe8, 0x5c, 0x14, 0x45, 0xb3, 0xfd, 0x14, 0x3d, 0x0f, 0x73, 0xf5, 0xa6, 0x66, 0x1a,
+	0xa8, 0xe6, 0x20, 0x8d, 0x58, 0x26, 0xe7, 0x59, 0x4a, 0xcd, 0x08, 0xa3, 0xca, 0x6d, 0xf2, 0x6b,
+	0x90, 0xee, 0xf2, 0xed, 0xe3, 0x77, 0xfa, 0x48, 0xaa, 0xbd, 0xc5, 0xae, 0xfd, 0xaa, 0x46, 0x5a,
+	0x2a, 0x08, 0x38, 0xfb, 0x66, 0x0b, 0x60, 0xa2, 0xfb, 0x35, 0x07, 0x1d, 0x60, 0xc2, 0xf6, 0xe4,
+	0x64, 0x41, 0x2a, 0x25, 0xd5, 0xb4, 0x89, 0xee, 0xab, 0xae, 0x29, 0x4c, 0xf4, 0x85, 0x31, 0x44,
+	0x3f, 0xf5, 0xaa, 0x88, 0x0e, 0xb9, 0xb4, 0xa0, 0xf8, 0x6e, 0x3c, 0x99, 0xcb, 0xcd, 0x8f, 0x20,
+	0xba, 0xc2, 0x89, 0x1e, 0x60, 0xaf, 0x7f, 0xd7, 0x3e, 0xe4, 0xbc, 0x7e, 0x13, 0xb5, 0xd1, 0xab,
+	0xe4, 0xf5, 0x22, 0x24, 0xdc, 0x9d, 0x10, 0xc4, 0x76, 0x5b, 0xe1, 0xe8, 0xdd, 0xa8, 0x02, 0xbe,
+	0xfd, 0xa8, 0x7e, 0x92, 0x60, 0xbe, 0x4a, 0x0c, 0x15, 0x19, 0x98, 0x50, 0xe4, 0x6c, 0x0b, 0x82,
+	0xbc, 0x44, 0x8d, 0x73, 0x09, 0x17, 0x59, 0xe3, 0x84, 0x97, 0x50, 0x8d, 0x13, 0xc3, 0xb6, 0xfe,
+	0x3f, 0x58, 0xe3, 0x2e, 0x8e, 0xa8, 0x71, 0xe1, 0x90, 0x8b, 0x67, 0x60, 0x69, 0xc0, 0xe8, 0x67,
+	0x19, 0xaa, 0xe3, 0xff, 0x76, 0x8e, 0x47, 0xae, 0xe3, 0x6e, 0x86, 0xc1, 0x3a, 0xde, 0x97, 0xdf,
+	0x97, 0x12, 0x9c, 0xe4, 0x1b, 0xec, 0xbc, 0x9a, 0x7d, 0xec, 0xb1, 0x2c, 0xc5, 0x58, 0xb6, 0xb5,
+	0x35, 0x18, 0xf2, 0xa5, 0x11, 0x21, 0xf7, 0xc7, 0x50, 0x3c, 0x07, 0x67, 0x86, 0x98, 0xfd, 0xd0,
+	0xbf, 0x95, 0x60, 0xae, 0x4a, 0x8c, 0xbd, 0xae, 0x63, 0xb8, 0xc7, 0xe2, 0xe5, 0x83, 0x8e, 0x3e,
+	0x1a, 0xff, 0x1b, 0x4c, 0x66, 0x75, 0x44, 0x32, 0xbd, 0xa8, 0x8a, 0xa7, 0xe1, 0x54, 0xc8, 0xe0,
+	0x27, 0xf0, 0x79, 0xf8, 0x04, 0xed, 0x61, 0xd3, 0x44, 0x8e, 0xfc, 0x5f, 0x48, 0xd8, 0xfc, 0x6b,
+	0x6c, 0x06, 0x2e, 0x4e, 0x56, 0x60, 0xb6, 0x63, 0x99, 0xb8, 0x85, 0x1c, 0x77, 0xe1, 0xbd, 0x26,
+	0xbb, 0xae, 0x91, 0xa9, 0xdb, 0x16, 0x36, 0xa9, 0x9b, 0x8a, 0xdf, 0xde, 0x4a, 0xb3, 0x64, 0xdc,
+	0x29, 0xfa, 0xce, 0x80, 0x88, 0xc4, 0x8f, 0xf3, 0x2b, 0x09, 0x32, 0x55, 0x62, 0x6c, 0x53, 0x8a,
+	0x08, 0xdd, 0xc3, 0xe6, 0x31, 0x42, 0x5c, 0x62, 0x81, 0x50, 0xe7, 0xb0, 0xe6, 0xaf, 0xf3, 0x2c,
+	0x6f, 0xdf, 0xd6, 0xe5, 0x1c, 0xc4, 0x58, 0x89, 0x14, 0xe1, 0xb1, 0x4f, 0x76, 0x91, 0xdb, 0x8e,
+	0x65, 0x35, 0x6a, 0x4d, 0x84, 0x8d, 0x26, 0xe5, 0xc5, 0x35, 0xa6, 0xa6, 0xb9, 0xed, 0x1d, 0x6e,
+	0x0a, 0x07, 0x7f, 0x03, 0x16, 0x82, 0xe1, 0xf9, 0x02, 0xe1, 0x22, 0x64, 0xd1, 0x03, 0x1b, 0x3b,
+	0x88, 0x78, 0x33, 0x49, 0x7c, 0xa6, 0x39, 0xd7, 0x2a, 0xe6, 0x2a, 0x7e, 0x23, 0x8e, 0x80, 0x18,
+	0xa6, 0xdf, 0x6c, 0x6a, 0xed, 0x36, 0x32, 0x0d, 0x74, 0x8c, 0x2c, 0x57, 0x81, 0x95, 0x33, 0x31,
+	0xbc, 0x97, 0x69, 0xda, 0xb7, 0xdd, 0xe6, 0x6a, 0xa2, 0xde, 0xec, 0x9a, 0x2d, 0x9e, 0x6f, 0x46,
+	0x15, 0x0d, 0x59, 0x86, 0xb8, 0xad, 0xd1, 0xa6, 0x12, 0x2f, 0xc4, 0x4a, 0x19, 0x95, 0x7f, 0x87,
+	0x53, 0xbc, 0xc7, 0x8f, 0x42, 0x7f, 0x88, 0x7e, 0xa6, 0x8b, 0xec, 0x85, 0x40, 0x08, 0x12, 0x72,
+	0x28, 0xa9, 0xba, 0x2d, 0x79, 0x19, 0xc0, 0x41, 0x76, 0x97, 0x6a, 0x5c, 0xa4, 0x88, 0x70, 0x02,
+	0x96, 0xe2, 0xd7, 0x12, 0xe7, 0x66, 0x4f, 0x5d, 0x61, 0x44, 0x76, 0x34, 0x5a, 0x6f, 0x1e, 0xab,
+	0xc2, 0xdc, 0x02, 0xbe, 0xa9, 0x18, 0xb1, 0x87, 0x4a, 0x6c, 0x94, 0x06, 0x5d, 0x2f, 0x73, 0x0f,
+	0xfc, 0x28, 0x04, 0x2f, 0x39, 0x6f, 0x6c, 0x5f, 0x01, 0xfa, 0x3b, 0x06, 0xd0, 0x1b, 0xd0, 0x53,
+	0x63, 0x52, 0x84, 0x1a, 0x9b, 0x8e, 0x56, 0x63, 0xb1, 0x08, 0x35, 0x16, 0x8f, 0x52, 0x63, 0x33,
+	0x11, 0x6a, 0x2c, 0x11, 0xad, 0xc6, 0x66, 0x07, 0xd5, 0x58, 0x48, 0x8c, 0x24, 0xfb, 0xc4, 0xc8,
+	0x30, 0xa9, 0x96, 0x1a, 0x23, 0xd5, 0x60, 0xa4, 0x54, 0x4b, 0x47, 0x49, 0xb5, 0x4c, 0xbf, 0x54,
+	0xeb, 0x17, 0x42, 0x73, 0xc7, 0x14, 0x42, 0xe1, 0x67, 0x49, 0xf6, 0xa8, 0xcf, 0x92, 0xe2, 0x3a,
+	0x9c, 0x1b, 0x4a, 0x4e, 0x9f, 0xf6, 0x39, 0x88, 0x61, 0x9d, 0x28, 0x52, 0x21, 0x56, 0x8a, 0xab,
+	0xec, 0xb3, 0xf8, 0x97, 0x14, 0x2c, 0x75, 0xed, 0xb6, 0x75, 0x1f, 0xe9, 0xe2, 0x36, 0x3b, 0xfe,
+	0xf3, 0xbb, 0x0c, 0x33, 0x1a, 0x9b, 0x89, 0x93, 0x3a, 0x6a, 0x8c, 0x80, 0xb1, 0x7b, 0xc3, 0x41,
+	0x07, 0x56, 0x8b, 0xbd, 0x18, 0xa2, 0x07, 0xb8, 0xb8, 0xad, 0xd7, 0x07, 0xeb, 0xca, 0x95, 0xe8,
+	0xba, 0x1e, 0xca, 0xac, 0xb8, 0x0a, 0x2b, 0x23, 0xba, 0xbc, 0xa5, 0xda, 0xf8, 0x13, 0x20, 0x56,
+	0x25, 0x86, 0xac, 0x43, 0x26, 0xf4, 0x9f, 0xc4, 0xc5, 0xe1, 0x47, 0xb4, 0xef, 0xd9, 0x9f, 0xbf,
+	0x3a, 0x11, 0xcc, 0xdf, 0x18, 0x0d, 0xd2, 0xc1, 0x7f, 0x06, 0x2e, 0x8c, 0x1c, 0x1d, 0x40, 0xe5,
+	0xff, 0x33, 0x09, 0x2a, 0xe8, 0x22, 0xf8, 0xd2, 0xbb, 0x30, 0x26, 0xc0, 0x71, 0x2e, 0x86, 0xe8,
+	0x6e, 0xe6, 0x22, 0x28, 0xba, 0x47, 0xbb, 0x08, 0xa0, 0x22, 0x5c, 0x0c, 0x11, 0xd1, 0xf2, 0xc7,
+	0x90, 0xed, 0x13, 0xd0, 0x97, 0x46, 0x8e, 0x0f, 0x03, 0xf3, 0x95, 0x09, 0x81, 0xbe, 0x2f, 0x7f,
+	0xeb, 0x5d, 0x4f, 0xe3, 0xb6, 0xde, 0xf5, 0x73, 0x75, 0x22, 0x98, 0xef, 0xc5, 0x86, 0xdc, 0x80,
+	0x98, 0xbc, 0x1c, 0xb1, 0x26, 0x61, 0x68, 0x7e, 0x7d, 0x62, 0xa8, 0xef, 0xf1, 0x23, 0x80, 0x80,
+	0x06, 0x3c, 0x3f, 0x72, 0x82, 0x1e, 0x28, 0x7f, 0x65, 0x02, 0xd0, 0xb0, 0x3d, 0x72, 0x25, 0xda,
+	0xf8, 0x3d, 0x12, 0xc0, 0x09, 0xf6, 0x28, 0x2c, 0xb5, 0xe4, 0x0f, 0x21, 0xd5, 0x93, 0x59, 0xc5,
+	0x91, 0xa3, 0x7d, 0x4c, 0x7e, 0x6d, 0x3c, 0x26, 0xb8, 0x35, 0x03, 0x22, 0xe7, 0x72, 0x44, 0x84,
+	0x61, 0x68, 0xc4, 0xd6, 0x8c, 0xd4, 0x25, 0x07, 0x20, 0x0f, 0xd1, 0x16, 0x57, 0x26, 0x38, 0xe8,
+	0x1e, 0x38, 0x7f, 0xed, 0x08, 0x60, 0xdf, 0xef, 0x27, 0xb0, 0x30, 0xb4, 0x04, 0x8c, 0xe5, 0x72,
+	0x08, 0x9e, 0xbf, 0x7e, 0x24, 0xb8, 0xe7, 0x3d, 0x3f, 0xf3, 0x29, 0x13, 0x34, 0x3b, 0xd7, 0x9e,
+	0x3c, 0x5b, 0x96, 0x9e, 0x3e, 0x5b, 0x96, 0x7e, 0x7f, 0xb6, 0x2c, 0x3d, 0x7a, 0xbe, 0x3c, 0xf5,
+	0xf4, 0xf9, 0xf2, 0xd4, 0x2f, 0xcf, 0x97, 0xa7, 0x3e, 0x58, 0x1a, 0x76, 0xb9, 0x33, 0xf9, 0x41,
+	0xf6, 0x13, 0xbc, 0xba, 0x5e, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0xca, 0x4e, 0xdf, 0x5d, 0x7d,
+	0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateEntry defines the CreateEntry RPC.
+	CreateEntry(ctx context.Context, in *MsgCreateEntry, opts ...grpc.CallOption) (*MsgCreateEntryResponse, error)
+	// UpdateEntry defines the UpdateEntry RPC.
+	UpdateEntry(ctx context.Context, in *MsgUpdateEntry, opts ...grpc.CallOption) (*MsgUpdateEntryResponse, error)
+	// DeleteEntry retracts an entry. The entry is kept and flagged as retracted.
+	DeleteEntry(ctx context.Context, in *MsgDeleteEntry, opts ...grpc.CallOption) (*MsgDeleteEntryResponse, error)
+	// RegisterAgency defines a (governance) operation for registering an agency
+	// and its authorized publishers.
+	RegisterAgency(ctx context.Context, in *MsgRegisterAgency, opts ...grpc.CallOption) (*MsgRegisterAgencyResponse, error)
+	// UpdateAgency defines a (governance) operation for updating a registered
+	// agency, including its authorized publishers.
+	UpdateAgency(ctx context.Context, in *MsgUpdateAgency, opts ...grpc.CallOption) (*MsgUpdateAgencyResponse, error)
+	// DeregisterAgency defines a (governance) operation for removing an agency
+	// from the registry.
+	DeregisterAgency(ctx context.Context, in *MsgDeregisterAgency, opts ...grpc.CallOption) (*MsgDeregisterAgencyResponse, error)
+	// PurgeEntry defines a (governance) operation for physically removing an
+	// entry and its history.
+	PurgeEntry(ctx context.Context, in *MsgPurgeEntry, opts ...grpc.CallOption) (*MsgPurgeEntryResponse, error)
+	// RegisterPinner registers the signer as a pinner, or updates its
+	// registration.
+	RegisterPinner(ctx context.Context, in *MsgRegisterPinner, opts ...grpc.CallOption) (*MsgRegisterPinnerResponse, error)
+	// AttestPin attests that a registered pinner pins the content of an entry,
+	// or renews its attestation.
+	AttestPin(ctx context.Context, in *MsgAttestPin, opts ...grpc.CallOption) (*MsgAttestPinResponse, error)
+	// RespondChallenge answers a proof-of-retrievability challenge of the
+	// signer.
+	RespondChallenge(ctx context.Context, in *MsgRespondChallenge, opts ...grpc.CallOption) (*MsgRespondChallengeResponse, error)
+	// CreateEntriesBatch creates several entries of the signer at once, up to
+	// the max_batch_entries param. Either all the entries are created or none.
+	CreateEntriesBatch(ctx context.Context, in *MsgCreateEntriesBatch, opts ...grpc.CallOption) (*MsgCreateEntriesBatchResponse, error)
+	// UpdateAllowedPinners defines a (governance) operation for allowing
+	// addresses to register as pinners and to attest to pins, or revoking them.
+	UpdateAllowedPinners(ctx context.Context, in *MsgUpdateAllowedPinners, opts ...grpc.CallOption) (*MsgUpdateAllowedPinnersResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FileName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateEntry(ctx context.Context, in *MsgCreateEntry, opts ...grpc.CallOption) (*MsgCreateEntryResponse, error) {
+	out := new(MsgCreateEntryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/CreateEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FileUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateEntry(ctx context.Context, in *MsgUpdateEntry, opts ...grpc.CallOption) (*MsgUpdateEntryResponse, error) {
+	out := new(MsgUpdateEntryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/UpdateEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FallbackUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeleteEntry(ctx context.Context, in *MsgDeleteEntry, opts ...grpc.CallOption) (*MsgDeleteEntryResponse, error) {
+	out := new(MsgDeleteEntryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/DeleteEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.FileSize)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterAgency(ctx context.Context, in *MsgRegisterAgency, opts ...grpc.CallOption) (*MsgRegisterAgencyResponse, error) {
+	out := new(MsgRegisterAgencyResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/RegisterAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChecksumSha_256)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAgency(ctx context.Context, in *MsgUpdateAgency, opts ...grpc.CallOption) (*MsgUpdateAgencyResponse, error) {
+	out := new(MsgUpdateAgencyResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/UpdateAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Agency)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DeregisterAgency(ctx context.Context, in *MsgDeregisterAgency, opts ...grpc.CallOption) (*MsgDeregisterAgencyResponse, error) {
+	out := new(MsgDeregisterAgencyResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/DeregisterAgency", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) PurgeEntry(ctx context.Context, in *MsgPurgeEntry, opts ...grpc.CallOption) (*MsgPurgeEntryResponse, error) {
+	out := new(MsgPurgeEntryResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/PurgeEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RegisterPinner(ctx context.Context, in *MsgRegisterPinner, opts ...grpc.CallOption) (*MsgRegisterPinnerResponse, error) {
+	out := new(MsgRegisterPinnerResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/RegisterPinner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Timestamp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AttestPin(ctx context.Context, in *MsgAttestPin, opts ...grpc.CallOption) (*MsgAttestPinResponse, error) {
+	out := new(MsgAttestPinResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/AttestPin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PinCount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RespondChallenge(ctx context.Context, in *MsgRespondChallenge, opts ...grpc.CallOption) (*MsgRespondChallengeResponse, error) {
+	out := new(MsgRespondChallengeResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/RespondChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreateEntriesBatch(ctx context.Context, in *MsgCreateEntriesBatch, opts ...grpc.CallOption) (*MsgCreateEntriesBatchResponse, error) {
+	out := new(MsgCreateEntriesBatchResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/CreateEntriesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAllowedPinners(ctx context.Context, in *MsgUpdateAllowedPinners, opts ...grpc.CallOption) (*MsgUpdateAllowedPinnersResponse, error) {
+	out := new(MsgUpdateAllowedPinnersResponse)
+	err := c.cc.Invoke(ctx, "/govchain.datasets.v1.Msg/UpdateAllowedPinners", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateEntry) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateEntry defines the CreateEntry RPC.
+	CreateEntry(context.Context, *MsgCreateEntry) (*MsgCreateEntryResponse, error)
+	// UpdateEntry defines the UpdateEntry RPC.
+	UpdateEntry(context.Context, *MsgUpdateEntry) (*MsgUpdateEntryResponse, error)
+	// DeleteEntry retracts an entry. The entry is kept and flagged as retracted.
+	DeleteEntry(context.Context, *MsgDeleteEntry) (*MsgDeleteEntryResponse, error)
+	// RegisterAgency defines a (governance) operation for registering an agency
+	// and its authorized publishers.
+	RegisterAgency(context.Context, *MsgRegisterAgency) (*MsgRegisterAgencyResponse, error)
+	// UpdateAgency defines a (governance) operation for updating a registered
+	// agency, including its authorized publishers.
+	UpdateAgency(context.Context, *MsgUpdateAgency) (*MsgUpdateAgencyResponse, error)
+	// DeregisterAgency defines a (governance) operation for removing an agency
+	// from the registry.
+	DeregisterAgency(context.Context, *MsgDeregisterAgency) (*MsgDeregisterAgencyResponse, error)
+	// PurgeEntry defines a (governance) operation for physically removing an
+	// entry and its history.
+	PurgeEntry(context.Context, *MsgPurgeEntry) (*MsgPurgeEntryResponse, error)
+	// RegisterPinner registers the signer as a pinner, or updates its
+	// registration.
+	RegisterPinner(context.Context, *MsgRegisterPinner) (*MsgRegisterPinnerResponse, error)
+	// AttestPin attests that a registered pinner pins the content of an entry,
+	// or renews its attestation.
+	AttestPin(context.Context, *MsgAttestPin) (*MsgAttestPinResponse, error)
+	// RespondChallenge answers a proof-of-retrievability challenge of the
+	// signer.
+	RespondChallenge(context.Context, *MsgRespondChallenge) (*MsgRespondChallengeResponse, error)
+	// CreateEntriesBatch creates several entries of the signer at once, up to
+	// the max_batch_entries param. Either all the entries are created or none.
+	CreateEntriesBatch(context.Context, *MsgCreateEntriesBatch) (*MsgCreateEntriesBatchResponse, error)
+	// UpdateAllowedPinners defines a (governance) operation for allowing
+	// addresses to register as pinners and to attest to pins, or revoking them.
+	UpdateAllowedPinners(context.Context, *MsgUpdateAllowedPinners) (*MsgUpdateAllowedPinnersResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateEntry(ctx context.Context, req *MsgCreateEntry) (*MsgCreateEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEntry(ctx context.Context, req *MsgUpdateEntry) (*MsgUpdateEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntry not implemented")
+}
+func (*UnimplementedMsgServer) DeleteEntry(ctx context.Context, req *MsgDeleteEntry) (*MsgDeleteEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEntry not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAgency(ctx context.Context, req *MsgRegisterAgency) (*MsgRegisterAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgency not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAgency(ctx context.Context, req *MsgUpdateAgency) (*MsgUpdateAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgency not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterAgency(ctx context.Context, req *MsgDeregisterAgency) (*MsgDeregisterAgencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterAgency not implemented")
+}
+func (*UnimplementedMsgServer) PurgeEntry(ctx context.Context, req *MsgPurgeEntry) (*MsgPurgeEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeEntry not implemented")
+}
+func (*UnimplementedMsgServer) RegisterPinner(ctx context.Context, req *MsgRegisterPinner) (*MsgRegisterPinnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPinner not implemented")
+}
+func (*UnimplementedMsgServer) AttestPin(ctx context.Context, req *MsgAttestPin) (*MsgAttestPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestPin not implemented")
+}
+func (*UnimplementedMsgServer) RespondChallenge(ctx context.Context, req *MsgRespondChallenge) (*MsgRespondChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondChallenge not implemented")
+}
+func (*UnimplementedMsgServer) CreateEntriesBatch(ctx context.Context, req *MsgCreateEntriesBatch) (*MsgCreateEntriesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntriesBatch not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAllowedPinners(ctx context.Context, req *MsgUpdateAllowedPinners) (*MsgUpdateAllowedPinnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowedPinners not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/UpdateParams",
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEntry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.IpfsCid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEntry(ctx, in)
 	}
-	l = len(m.MimeType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/CreateEntry",
 	}
-	l = len(m.FileName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEntry(ctx, req.(*MsgCreateEntry))
 	}
-	l = len(m.FileUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEntry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.FallbackUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEntry(ctx, in)
 	}
-	l = len(m.FileSize)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/UpdateEntry",
 	}
-	l = len(m.ChecksumSha_256)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEntry(ctx, req.(*MsgUpdateEntry))
 	}
-	l = len(m.Agency)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteEntry)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Category)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteEntry(ctx, in)
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/DeleteEntry",
 	}
-	l = len(m.Timestamp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteEntry(ctx, req.(*MsgDeleteEntry))
 	}
-	l = len(m.PinCount)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAgency)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/RegisterAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAgency(ctx, req.(*MsgRegisterAgency))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAgency)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAgency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/UpdateAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAgency(ctx, req.(*MsgUpdateAgency))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_DeregisterAgency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterAgency)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterAgency(ctx, in)
 	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/DeregisterAgency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterAgency(ctx, req.(*MsgDeregisterAgency))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurgeEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurgeEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurgeEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/PurgeEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurgeEntry(ctx, req.(*MsgPurgeEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterPinner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPinner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPinner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/RegisterPinner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPinner(ctx, req.(*MsgRegisterPinner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestPin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/AttestPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestPin(ctx, req.(*MsgAttestPin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RespondChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRespondChallenge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RespondChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/RespondChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RespondChallenge(ctx, req.(*MsgRespondChallenge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateEntriesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEntriesBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEntriesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/CreateEntriesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEntriesBatch(ctx, req.(*MsgCreateEntriesBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowedPinners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowedPinners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowedPinners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govchain.datasets.v1.Msg/UpdateAllowedPinners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowedPinners(ctx, req.(*MsgUpdateAllowedPinners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "govchain.datasets.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateEntry",
+			Handler:    _Msg_CreateEntry_Handler,
+		},
+		{
+			MethodName: "UpdateEntry",
+			Handler:    _Msg_UpdateEntry_Handler,
+		},
+		{
+			MethodName: "DeleteEntry",
+			Handler:    _Msg_DeleteEntry_Handler,
+		},
+		{
+			MethodName: "RegisterAgency",
+			Handler:    _Msg_RegisterAgency_Handler,
+		},
+		{
+			MethodName: "UpdateAgency",
+			Handler:    _Msg_UpdateAgency_Handler,
+		},
+		{
+			MethodName: "DeregisterAgency",
+			Handler:    _Msg_DeregisterAgency_Handler,
+		},
+		{
+			MethodName: "PurgeEntry",
+			Handler:    _Msg_PurgeEntry_Handler,
+		},
+		{
+			MethodName: "RegisterPinner",
+			Handler:    _Msg_RegisterPinner_Handler,
+		},
+		{
+			MethodName: "AttestPin",
+			Handler:    _Msg_AttestPin_Handler,
+		},
+		{
+			MethodName: "RespondChallenge",
+			Handler:    _Msg_RespondChallenge_Handler,
+		},
+		{
+			MethodName: "CreateEntriesBatch",
+			Handler:    _Msg_CreateEntriesBatch_Handler,
+		},
+		{
+			MethodName: "UpdateAllowedPinners",
+			Handler:    _Msg_UpdateAllowedPinners_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "govchain/datasets/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.FileSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MirrorOf != nil {
+		{
+			size, err := m.MirrorOf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ChecksumSha_256) > 0 {
+		i -= len(m.ChecksumSha_256)
+		copy(dAtA[i:], m.ChecksumSha_256)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChecksumSha_256)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.FallbackUrl) > 0 {
+		i -= len(m.FallbackUrl)
+		copy(dAtA[i:], m.FallbackUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackUrl)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FileUrl) > 0 {
+		i -= len(m.FileUrl)
+		copy(dAtA[i:], m.FileUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileUrl)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IpfsCid) > 0 {
+		i -= len(m.IpfsCid)
+		copy(dAtA[i:], m.IpfsCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsCid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.FileSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.NewRevision {
+		i--
+		if m.NewRevision {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.ChangeReason) > 0 {
+		i -= len(m.ChangeReason)
+		copy(dAtA[i:], m.ChangeReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChangeReason)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ChecksumSha_256) > 0 {
+		i -= len(m.ChecksumSha_256)
+		copy(dAtA[i:], m.ChecksumSha_256)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChecksumSha_256)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.FallbackUrl) > 0 {
+		i -= len(m.FallbackUrl)
+		copy(dAtA[i:], m.FallbackUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackUrl)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.FileUrl) > 0 {
+		i -= len(m.FileUrl)
+		copy(dAtA[i:], m.FileUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileUrl)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IpfsCid) > 0 {
+		i -= len(m.IpfsCid)
+		copy(dAtA[i:], m.IpfsCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsCid)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAgency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAgency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAgency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Agency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Agency.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterAgency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterAgency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterAgency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterAgencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterAgencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterAgencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurgeEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurgeEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurgeEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPinner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPinner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPinner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pinner) > 0 {
+		i -= len(m.Pinner)
+		copy(dAtA[i:], m.Pinner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pinner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPinnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPinnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPinnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestPin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestPin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestPin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EntryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EntryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pinner) > 0 {
+		i -= len(m.Pinner)
+		copy(dAtA[i:], m.Pinner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pinner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestPinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestPinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestPinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRespondChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRespondChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRespondChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Path[iNdEx])
+			copy(dAtA[i:], m.Path[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Path[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pinner) > 0 {
+		i -= len(m.Pinner)
+		copy(dAtA[i:], m.Pinner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pinner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRespondChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRespondChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRespondChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reputation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reputation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEntriesBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEntriesBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEntriesBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MirrorOf != nil {
+		{
+			size, err := m.MirrorOf.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Agency) > 0 {
+		i -= len(m.Agency)
+		copy(dAtA[i:], m.Agency)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Agency)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ChecksumSha_256) > 0 {
+		i -= len(m.ChecksumSha_256)
+		copy(dAtA[i:], m.ChecksumSha_256)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChecksumSha_256)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FileSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FileSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.FallbackUrl) > 0 {
+		i -= len(m.FallbackUrl)
+		copy(dAtA[i:], m.FallbackUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FallbackUrl)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FileUrl) > 0 {
+		i -= len(m.FileUrl)
+		copy(dAtA[i:], m.FileUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileUrl)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FileName) > 0 {
+		i -= len(m.FileName)
+		copy(dAtA[i:], m.FileName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MimeType) > 0 {
+		i -= len(m.MimeType)
+		copy(dAtA[i:], m.MimeType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MimeType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IpfsCid) > 0 {
+		i -= len(m.IpfsCid)
+		copy(dAtA[i:], m.IpfsCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsCid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEntriesBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEntriesBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEntriesBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA11 := make([]byte, len(m.Ids)*10)
+		var j10 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedPinners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedPinners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedPinners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revoke) > 0 {
+		for iNdEx := len(m.Revoke) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revoke[iNdEx])
+			copy(dAtA[i:], m.Revoke[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Revoke[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allow) > 0 {
+		for iNdEx := len(m.Allow) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allow[iNdEx])
+			copy(dAtA[i:], m.Allow[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allow[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedPinnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedPinnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedPinnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FallbackUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChecksumSha_256)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MirrorOf != nil {
+		l = m.MirrorOf.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 2 + sovTx(uint64(m.FileSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt)
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FallbackUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChecksumSha_256)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChangeReason)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.NewRevision {
+		n += 3
+	}
+	if m.FileSize != 0 {
+		n += 2 + sovTx(uint64(m.FileSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt)
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterAgency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Agency.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAgency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Agency.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterAgency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterAgencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPurgeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPurgeEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterPinner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pinner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPinnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAttestPin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pinner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EntryId != 0 {
+		n += 1 + sovTx(uint64(m.EntryId))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *MsgAttestPinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresHeight))
+	}
+	return n
+}
+
+func (m *MsgRespondChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pinner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Path) > 0 {
+		for _, b := range m.Path {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRespondChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Passed {
+		n += 2
+	}
+	if m.Reputation != 0 {
+		n += 1 + sovTx(uint64(m.Reputation))
+	}
+	return n
+}
+
+func (m *MsgCreateEntriesBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MimeType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FallbackUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FileSize != 0 {
+		n += 1 + sovTx(uint64(m.FileSize))
+	}
+	l = len(m.ChecksumSha_256)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Agency)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishedAt)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MirrorOf != nil {
+		l = m.MirrorOf.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEntriesBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowedPinners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Allow) > 0 {
+		for _, s := range m.Allow {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Revoke) > 0 {
+		for _, s := range m.Revoke {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowedPinnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorOf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MirrorOf == nil {
+				m.MirrorOf = &MirrorLink{}
+			}
+			if err := m.MirrorOf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MimeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRevision", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewRevision = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+			}
+			m.FileSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAgency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAgency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAgency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Agency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteEntryResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAgency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1568,10 +6040,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAgency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAgency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1608,7 +6080,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1635,7 +6107,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Agency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1660,7 +6132,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAgencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1683,10 +6155,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAgencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1710,7 +6182,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterAgency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1733,15 +6205,15 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterAgency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterAgency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1769,11 +6241,11 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1801,11 +6273,111 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterAgencyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterAgencyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterAgencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPurgeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurgeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurgeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1833,11 +6405,30 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsCid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1865,11 +6456,111 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsCid = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPurgeEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurgeEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurgeEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPinner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPinner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPinner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1897,11 +6588,11 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MimeType = string(dAtA[iNdEx:postIndex])
+			m.Pinner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1929,11 +6620,11 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileName = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1961,11 +6652,111 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileUrl = string(dAtA[iNdEx:postIndex])
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPinnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPinnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPinnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestPin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestPin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestPin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FallbackUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1993,13 +6784,13 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FallbackUrl = string(dAtA[iNdEx:postIndex])
+			m.Pinner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryId", wireType)
 			}
-			var stringLen uint64
+			m.EntryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2009,27 +6800,14 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EntryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileSize = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,13 +6835,13 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
-			var stringLen uint64
+			m.ProofHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2073,29 +6851,66 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProofHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Agency = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAttestPinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAttestPinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAttestPinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresHeight", wireType)
+			}
+			m.ExpiresHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2105,27 +6920,64 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiresHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Category = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRespondChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRespondChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRespondChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pinner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2153,13 +7005,32 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+			m.Pinner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2169,29 +7040,31 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2201,23 +7074,23 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PinCount = string(dAtA[iNdEx:postIndex])
+			m.Path = append(m.Path, make([]byte, postIndex-iNdEx))
+			copy(m.Path[len(m.Path)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2240,7 +7113,7 @@ func (m *MsgCreateEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRespondChallengeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2263,17 +7136,17 @@ func (m *MsgCreateEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRespondChallengeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRespondChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
 			}
-			m.Id = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2283,7 +7156,27 @@ func (m *MsgCreateEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			m.Reputation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reputation |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2309,7 +7202,7 @@ func (m *MsgCreateEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateEntriesBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2332,10 +7225,10 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateEntriesBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateEntriesBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2371,10 +7264,10 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2384,12 +7277,77 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
@@ -2421,7 +7379,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -2453,7 +7411,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IpfsCid", wireType)
 			}
@@ -2485,7 +7443,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.IpfsCid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MimeType", wireType)
 			}
@@ -2517,7 +7475,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.MimeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FileName", wireType)
 			}
@@ -2549,7 +7507,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.FileName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FileUrl", wireType)
 			}
@@ -2581,7 +7539,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.FileUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FallbackUrl", wireType)
 			}
@@ -2613,11 +7571,11 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.FallbackUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
+		case 8:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FileSize", wireType)
 			}
-			var stringLen uint64
+			m.FileSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2627,25 +7585,12 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FileSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FileSize = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChecksumSha_256", wireType)
 			}
@@ -2677,7 +7622,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.ChecksumSha_256 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Agency", wireType)
 			}
@@ -2709,7 +7654,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Agency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
@@ -2741,7 +7686,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
@@ -2773,11 +7718,11 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2787,29 +7732,30 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timestamp = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PinCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MirrorOf", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2819,23 +7765,27 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PinCount = string(dAtA[iNdEx:postIndex])
+			if m.MirrorOf == nil {
+				m.MirrorOf = &MirrorLink{}
+			}
+			if err := m.MirrorOf.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2858,7 +7808,7 @@ func (m *MsgUpdateEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateEntriesBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2881,12 +7831,88 @@ func (m *MsgUpdateEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateEntriesBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateEntriesBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2908,7 +7934,7 @@ func (m *MsgUpdateEntryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteEntry) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAllowedPinners) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2931,15 +7957,15 @@ func (m *MsgDeleteEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAllowedPinners: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAllowedPinners: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2967,13 +7993,13 @@ func (m *MsgDeleteEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2983,11 +8009,56 @@ func (m *MsgDeleteEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allow = append(m.Allow, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revoke = append(m.Revoke, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3009,7 +8080,7 @@ func (m *MsgDeleteEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAllowedPinnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3032,10 +8103,10 @@ func (m *MsgDeleteEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAllowedPinnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAllowedPinnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,210 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// UnixFS import parameters of the upload flow, which are the defaults of
+// `ipfs add`: fixed size chunks, a balanced DAG of dag-pb nodes, UnixFS file
+// leaves and CIDv0.
+const (
+	UnixFSChunkSize = 256 << 10
+	UnixFSMaxLinks  = 174
+)
+
+// unixfsTypeFile is the UnixFS data type of file nodes.
+const unixfsTypeFile = 2
+
+// FileCid returns the CID that the upload flow assigns to the content read
+// from r, without storing any block.
+func FileCid(r io.Reader) (cid.Cid, error) {
+	return ImportFile(r, nil)
+}
+
+// ImportFile builds the UnixFS DAG that the upload flow assigns to the content
+// read from r, passes each of its blocks to put, unless nil, and returns the
+// CID of its root.
+func ImportFile(r io.Reader, put func(c cid.Cid, block []byte) error) (cid.Cid, error) {
+	b := unixfsBuilder{r: r, chunk: make([]byte, UnixFSChunkSize), put: put}
+	root, err := b.layout()
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.NewCidV0(root.hash), nil
+}
+
+// unixfsNode is a node of the DAG as linked from its parent.
+type unixfsNode struct {
+	hash multihash.Multihash
+	// size is the size of the blocks of the node and its descendants.
+	size uint64
+	// fileSize is the size of the file content under the node.
+	fileSize uint64
+}
+
+// unixfsBuilder builds the balanced DAG of a file the same way as the
+// balanced layout of the go-ipfs importer, chunk by chunk.
+type unixfsBuilder struct {
+	r     io.Reader
+	chunk []byte
+	// n is the length of the next chunk, read ahead of time to know whether
+	// the content is exhausted.
+	n      int
+	loaded bool
+	eof    bool
+	put    func(c cid.Cid, block []byte) error
+}
+
+// store passes the block of node to put.
+func (b *unixfsBuilder) store(node unixfsNode, block []byte) (unixfsNode, error) {
+	if b.put == nil {
+		return node, nil
+	}
+	return node, b.put(cid.NewCidV0(node.hash), block)
+}
+
+func (b *unixfsBuilder) done() (bool, error) {
+	if !b.loaded {
+		n, err := io.ReadFull(b.r, b.chunk)
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			b.eof = n == 0
+		case err != nil:
+			return false, err
+		}
+		b.n, b.loaded = n, true
+	}
+	return b.eof, nil
+}
+
+// nextLeaf returns the leaf of the next chunk. done must have returned false.
+func (b *unixfsBuilder) nextLeaf() (unixfsNode, error) {
+	b.loaded = false
+	return b.store(leafNode(b.chunk[:b.n]))
+}
+
+// layout returns the root of the DAG. The root of a single chunk is its leaf;
+// otherwise the DAG grows one level each time its root holds the maximum
+// number of links.
+func (b *unixfsBuilder) layout() (unixfsNode, error) {
+	done, err := b.done()
+	if err != nil {
+		return unixfsNode{}, err
+	}
+	if done {
+		return b.store(leafNode(nil))
+	}
+
+	root, err := b.nextLeaf()
+	if err != nil {
+		return unixfsNode{}, err
+	}
+	for depth := 1; ; depth++ {
+		if done, err := b.done(); err != nil || done {
+			return root, err
+		}
+		if root, err = b.fill([]unixfsNode{root}, depth); err != nil {
+			return unixfsNode{}, err
+		}
+	}
+}
+
+// fill adds children of the given depth to a node until it holds the maximum
+// number of links or the content is exhausted.
+func (b *unixfsBuilder) fill(children []unixfsNode, depth int) (unixfsNode, error) {
+	for len(children) < UnixFSMaxLinks {
+		done, err := b.done()
+		if err != nil {
+			return unixfsNode{}, err
+		}
+		if done {
+			break
+		}
+
+		var child unixfsNode
+		if depth == 1 {
+			child, err = b.nextLeaf()
+		} else {
+			child, err = b.fill(nil, depth-1)
+		}
+		if err != nil {
+			return unixfsNode{}, err
+		}
+		children = append(children, child)
+	}
+	return b.store(internalNode(children))
+}
+
+// leafNode returns the dag-pb node holding a chunk, and its block.
+func leafNode(data []byte) (unixfsNode, []byte) {
+	var unixfs []byte
+	unixfs = protowire.AppendTag(unixfs, 1, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, unixfsTypeFile)
+	if len(data) > 0 {
+		unixfs = protowire.AppendTag(unixfs, 2, protowire.BytesType)
+		unixfs = protowire.AppendBytes(unixfs, data)
+	}
+	unixfs = protowire.AppendTag(unixfs, 3, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, uint64(len(data)))
+
+	block := dagPBNode(nil, unixfs)
+	return unixfsNode{hash: sha256Multihash(block), size: uint64(len(block)), fileSize: uint64(len(data))}, block
+}
+
+// internalNode returns the dag-pb node linking children, in order, and its
+// block.
+func internalNode(children []unixfsNode) (unixfsNode, []byte) {
+	var fileSize, size uint64
+	for _, child := range children {
+		fileSize += child.fileSize
+		size += child.size
+	}
+
+	var unixfs []byte
+	unixfs = protowire.AppendTag(unixfs, 1, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, unixfsTypeFile)
+	unixfs = protowire.AppendTag(unixfs, 3, protowire.VarintType)
+	unixfs = protowire.AppendVarint(unixfs, fileSize)
+	for _, child := range children {
+		unixfs = protowire.AppendTag(unixfs, 4, protowire.VarintType)
+		unixfs = protowire.AppendVarint(unixfs, child.fileSize)
+	}
+
+	block := dagPBNode(children, unixfs)
+	return unixfsNode{hash: sha256Multihash(block), size: size + uint64(len(block)), fileSize: fileSize}, block
+}
+
+// dagPBNode returns the canonical dag-pb encoding of a node, whose links come
+// before its data.
+func dagPBNode(links []unixfsNode, data []byte) []byte {
+	var block []byte
+	for _, link := range links {
+		var pbLink []byte
+		pbLink = protowire.AppendTag(pbLink, 1, protowire.BytesType)
+		pbLink = protowire.AppendBytes(pbLink, link.hash)
+		pbLink = protowire.AppendTag(pbLink, 2, protowire.BytesType)
+		pbLink = protowire.AppendString(pbLink, "")
+		pbLink = protowire.AppendTag(pbLink, 3, protowire.VarintType)
+		pbLink = protowire.AppendVarint(pbLink, link.size)
+
+		block = protowire.AppendTag(block, 2, protowire.BytesType)
+		block = protowire.AppendBytes(block, pbLink)
+	}
+	block = protowire.AppendTag(block, 1, protowire.BytesType)
+	return protowire.AppendBytes(block, data)
+}
+
+func sha256Multihash(block []byte) multihash.Multihash {
+	digest := sha256.Sum256(block)
+	hash, err := multihash.Encode(digest[:], multihash.SHA2_256)
+	if err != nil {
+		panic(err)
+	}
+	return hash
+}
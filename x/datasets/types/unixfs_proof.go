@@ -0,0 +1,311 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Limits of the UnixFS Merkle paths answering retrievability challenges.
+const (
+	// MaxUnixFSBlockSize is the maximum size of a block, the limit of bitswap.
+	MaxUnixFSBlockSize = 2 << 20
+	// MaxUnixFSPathDepth is the maximum number of blocks above a chunk, more
+	// than the depth of the DAG of any file the upload flow accepts.
+	MaxUnixFSPathDepth = 8
+)
+
+// unixfsTypeRaw is the UnixFS data type of raw leaves in dag-pb nodes.
+const unixfsTypeRaw = 0
+
+// ChunkPath returns the leaf block of the UnixFS DAG rooted at root that holds
+// the byte at offset, and the blocks from the root down to the parent of the
+// leaf, reading the blocks through get. When offset is past the content, it
+// returns the root block alone, which proves it.
+func ChunkPath(get func(c cid.Cid) ([]byte, error), root cid.Cid, offset uint64) (chunk []byte, path [][]byte, err error) {
+	c := root
+	for depth := 0; ; depth++ {
+		block, err := get(c)
+		if err != nil {
+			return nil, nil, fmt.Errorf("getting block %s: %w", c, err)
+		}
+		if depth == 0 {
+			size, err := UnixFSContentSize(c, block)
+			if err != nil {
+				return nil, nil, err
+			}
+			if offset >= size {
+				return block, nil, nil
+			}
+		}
+		next, nextOffset, leaf, err := unixfsDescend(c, block, offset)
+		if err != nil {
+			return nil, nil, err
+		}
+		if leaf {
+			return block, path, nil
+		}
+		if depth == MaxUnixFSPathDepth {
+			return nil, nil, fmt.Errorf("the DAG of %s is deeper than %d blocks", root, MaxUnixFSPathDepth)
+		}
+		path = append(path, block)
+		c, offset = next, nextOffset
+	}
+}
+
+// FileChunkPath returns the CID that the upload flow assigns to the content
+// read from r, with the leaf block holding the byte at offset and the blocks
+// from the root down to its parent. Only these blocks are kept in memory.
+func FileChunkPath(r io.Reader, offset uint64) (root cid.Cid, chunk []byte, path [][]byte, err error) {
+	// The leaves are built in the order of the content.
+	// The root, built last, is kept as well since it proves an offset past
+	// the content.
+	target := offset / UnixFSChunkSize
+	var leaves uint64
+	var last []byte
+	blocks := make(map[string][]byte)
+	root, err = ImportFile(r, func(c cid.Cid, block []byte) error {
+		node, err := decodeUnixFSNode(block)
+		if err != nil {
+			return err
+		}
+		last = block
+		if len(node.links) == 0 {
+			leaves++
+			if leaves-1 != target {
+				return nil
+			}
+		}
+		blocks[string(c.Hash())] = block
+		return nil
+	})
+	if err != nil {
+		return cid.Undef, nil, nil, err
+	}
+	blocks[string(root.Hash())] = last
+
+	chunk, path, err = ChunkPath(func(c cid.Cid) ([]byte, error) {
+		block, ok := blocks[string(c.Hash())]
+		if !ok {
+			return nil, errors.New("block not found")
+		}
+		return block, nil
+	}, root, offset)
+	return root, chunk, path, err
+}
+
+// VerifyChunkPath checks that chunk is the leaf block of the UnixFS DAG rooted
+// at root that holds the byte at offset, through the blocks of path from the
+// root down to the parent of the leaf. An offset past the content, drawn from
+// an oversized declaration, is instead proven by the root block as chunk with
+// an empty path.
+func VerifyChunkPath(root cid.Cid, offset uint64, chunk []byte, path [][]byte) error {
+	if len(path) > MaxUnixFSPathDepth {
+		return fmt.Errorf("path of %d blocks exceeds the maximum depth %d", len(path), MaxUnixFSPathDepth)
+	}
+	if len(path) == 0 {
+		if size, err := UnixFSContentSize(root, chunk); err == nil && offset >= size {
+			return nil
+		}
+	}
+	c := root
+	for i, block := range path {
+		next, nextOffset, leaf, err := unixfsDescend(c, block, offset)
+		if err != nil {
+			return fmt.Errorf("block %d of the path: %w", i, err)
+		}
+		if leaf {
+			return fmt.Errorf("block %d of the path already holds offset %d", i, offset)
+		}
+		c, offset = next, nextOffset
+	}
+	_, _, leaf, err := unixfsDescend(c, chunk, offset)
+	if err != nil {
+		return fmt.Errorf("chunk: %w", err)
+	}
+	if !leaf {
+		return errors.New("chunk is not a leaf of the DAG")
+	}
+	return nil
+}
+
+// unixfsDescend checks that block is the block of c and returns the child
+// linked by it that holds the byte at offset, with the offset of the byte in
+// the child, or whether the block itself holds it.
+func unixfsDescend(c cid.Cid, block []byte, offset uint64) (child cid.Cid, childOffset uint64, leaf bool, err error) {
+	if err := checkUnixFSBlock(c, block); err != nil {
+		return cid.Undef, 0, false, err
+	}
+
+	switch c.Type() {
+	case cid.Raw:
+		if offset >= uint64(len(block)) {
+			return cid.Undef, 0, false, fmt.Errorf("offset %d is past the %d bytes of raw block %s", offset, len(block), c)
+		}
+		return cid.Undef, 0, true, nil
+	case cid.DagProtobuf:
+	default:
+		return cid.Undef, 0, false, fmt.Errorf("unsupported codec of %s: %#x", c, c.Type())
+	}
+
+	node, err := decodeUnixFSNode(block)
+	if err != nil {
+		return cid.Undef, 0, false, fmt.Errorf("decoding block %s: %w", c, err)
+	}
+	if offset < uint64(len(node.data)) {
+		return cid.Undef, 0, true, nil
+	}
+	offset -= uint64(len(node.data))
+	for i, size := range node.blockSizes {
+		if offset < size {
+			return node.links[i], offset, false, nil
+		}
+		offset -= size
+	}
+	return cid.Undef, 0, false, fmt.Errorf("offset is past the content of block %s", c)
+}
+
+// UnixFSContentSize checks that block is the block of c, a UnixFS file or
+// raw leaf, and returns the size of the content under it.
+func UnixFSContentSize(c cid.Cid, block []byte) (uint64, error) {
+	if err := checkUnixFSBlock(c, block); err != nil {
+		return 0, err
+	}
+	switch c.Type() {
+	case cid.Raw:
+		return uint64(len(block)), nil
+	case cid.DagProtobuf:
+	default:
+		return 0, fmt.Errorf("unsupported codec of %s: %#x", c, c.Type())
+	}
+
+	node, err := decodeUnixFSNode(block)
+	if err != nil {
+		return 0, fmt.Errorf("decoding block %s: %w", c, err)
+	}
+	size := uint64(len(node.data))
+	for _, blockSize := range node.blockSizes {
+		size += blockSize
+	}
+	return size, nil
+}
+
+// checkUnixFSBlock checks that block is the block of c.
+func checkUnixFSBlock(c cid.Cid, block []byte) error {
+	if len(block) > MaxUnixFSBlockSize {
+		return fmt.Errorf("block of %d bytes exceeds the maximum size %d", len(block), MaxUnixFSBlockSize)
+	}
+	sum, err := c.Prefix().Sum(block)
+	if err != nil {
+		return fmt.Errorf("hashing block: %w", err)
+	}
+	if !sum.Equals(c) {
+		return fmt.Errorf("block does not match %s", c)
+	}
+	return nil
+}
+
+// unixfsFileNode is the content of a dag-pb block of a UnixFS file.
+type unixfsFileNode struct {
+	links      []cid.Cid
+	data       []byte
+	blockSizes []uint64
+}
+
+// decodeUnixFSNode decodes a dag-pb block holding a UnixFS file node, whose
+// links match its block sizes.
+func decodeUnixFSNode(block []byte) (unixfsFileNode, error) {
+	var node unixfsFileNode
+	var unixfs []byte
+	hasData := false
+	err := walkProtoFields(block, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			unixfs, hasData = v, true
+		case num == 2 && typ == protowire.BytesType:
+			var link cid.Cid
+			if err := walkProtoFields(v, func(num protowire.Number, typ protowire.Type, v []byte, _ uint64) error {
+				if num != 1 || typ != protowire.BytesType {
+					return nil
+				}
+				c, err := cid.Cast(v)
+				if err != nil {
+					return fmt.Errorf("invalid link: %w", err)
+				}
+				link = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if !link.Defined() {
+				return errors.New("link without hash")
+			}
+			node.links = append(node.links, link)
+		}
+		return nil
+	})
+	if err != nil {
+		return unixfsFileNode{}, err
+	}
+	if !hasData {
+		return unixfsFileNode{}, errors.New("not a UnixFS node")
+	}
+
+	dataType := uint64(unixfsTypeRaw)
+	err = walkProtoFields(unixfs, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			dataType = n
+		case num == 2 && typ == protowire.BytesType:
+			node.data = v
+		case num == 4 && typ == protowire.VarintType:
+			node.blockSizes = append(node.blockSizes, n)
+		}
+		return nil
+	})
+	if err != nil {
+		return unixfsFileNode{}, err
+	}
+	if dataType != unixfsTypeFile && dataType != unixfsTypeRaw {
+		return unixfsFileNode{}, fmt.Errorf("UnixFS node of type %d is not a file", dataType)
+	}
+	if len(node.blockSizes) != len(node.links) {
+		return unixfsFileNode{}, fmt.Errorf("%d block sizes for %d links", len(node.blockSizes), len(node.links))
+	}
+	return node, nil
+}
+
+// walkProtoFields calls fn with the number, type and value of each field of
+// the protobuf message b: v for length-delimited fields, n for varints.
+func walkProtoFields(b []byte, fn func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error) error {
+	for len(b) > 0 {
+		num, typ, l := protowire.ConsumeTag(b)
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		b = b[l:]
+
+		var v []byte
+		var n uint64
+		switch typ {
+		case protowire.VarintType:
+			n, l = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			v, l = protowire.ConsumeBytes(b)
+		default:
+			l = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		b = b[l:]
+
+		if err := fn(num, typ, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+
+	"govchain/x/datasets/types"
+)
+
+// unixfsContent returns n bytes of deterministic content.
+func unixfsContent(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i*7 + i>>9)
+	}
+	return b
+}
+
+func TestFileCid(t *testing.T) {
+	// the CIDs assigned by `ipfs add` with its default parameters
+	const chunk = types.UnixFSChunkSize
+	tests := []struct {
+		size int
+		cid  string
+	}{
+		{0, "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"},
+		{1, "QmS9JArPwa55ePgDnyg6TzX24mYTS1b1vLqWNebyVotKxQ"},
+		{chunk - 1, "QmdwvKRpcSnqy1D5eXvNdzTzHnUavUWa8wxXTN2Zvbk5At"},
+		{chunk, "QmdpiKSVUr4aTXhqu7BXuzzyjMT1fdfL2B1fW7LCUcUoEp"},
+		{chunk + 1, "QmcfxCN5Dqd9MqQiYH1352y1x2pGyxo7nBnbxXXPpDDHDD"},
+		{types.UnixFSMaxLinks * chunk, "QmSPHdj3Tt1pyAeVyhjkrrgjD55FJux35aJcFh2dnbdmxW"},
+		{types.UnixFSMaxLinks*chunk + 1, "QmQarNNTnvUCRBJGiJ8c7dpVkCptrF8ksL6gmsaDC9sh4y"},
+		{2*types.UnixFSMaxLinks*chunk + 5, "QmYU6yuGf7fxXhvCRhb4ZiduW7CEyeWUcw5FVdT82pkkQy"},
+	}
+	for _, tc := range tests {
+		c, err := types.FileCid(bytes.NewReader(unixfsContent(tc.size)))
+		require.NoError(t, err)
+		require.Equal(t, tc.cid, c.String(), "size %d", tc.size)
+	}
+
+	c, err := types.FileCid(strings.NewReader("hello world\n"))
+	require.NoError(t, err)
+	require.Equal(t, "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o", c.String())
+}
+
+func TestChunkPath(t *testing.T) {
+	const chunk = types.UnixFSChunkSize
+	for _, size := range []int{1, chunk, 3*chunk + 5, types.UnixFSMaxLinks*chunk + 1} {
+		content := unixfsContent(size)
+		blocks := make(map[string][]byte)
+		root, err := types.ImportFile(bytes.NewReader(content), func(c cid.Cid, block []byte) error {
+			blocks[string(c.Hash())] = block
+			return nil
+		})
+		require.NoError(t, err)
+		get := func(c cid.Cid) ([]byte, error) {
+			block, ok := blocks[string(c.Hash())]
+			if !ok {
+				return nil, errors.New("block not found")
+			}
+			return block, nil
+		}
+
+		for _, offset := range []uint64{0, uint64(size) / 2, uint64(size) - 1} {
+			leaf, path, err := types.ChunkPath(get, root, offset)
+			require.NoError(t, err)
+			require.NoError(t, types.VerifyChunkPath(root, offset, leaf, path), "size %d, offset %d", size, offset)
+
+			fileRoot, fileLeaf, filePath, err := types.FileChunkPath(bytes.NewReader(content), offset)
+			require.NoError(t, err)
+			require.Equal(t, root, fileRoot)
+			require.Equal(t, leaf, fileLeaf)
+			require.Equal(t, path, filePath)
+
+			// the leaf holds the challenged byte
+			require.Contains(t, string(leaf), string(content[offset:offset+1]))
+
+			if len(path) > 0 {
+				require.Error(t, types.VerifyChunkPath(root, offset, leaf, path[1:]), "truncated path")
+				require.Error(t, types.VerifyChunkPath(root, offset, path[len(path)-1], path), "leaf of the path")
+			}
+			tampered := bytes.Clone(leaf)
+			tampered[len(tampered)/2] ^= 1
+			require.Error(t, types.VerifyChunkPath(root, offset, tampered, path), "tampered leaf")
+		}
+
+		// the root block alone proves an offset past the content
+		for _, offset := range []uint64{uint64(size), 2 * uint64(size)} {
+			leaf, path, err := types.ChunkPath(get, root, offset)
+			require.NoError(t, err)
+			require.Equal(t, blocks[string(root.Hash())], leaf)
+			require.Empty(t, path)
+			require.NoError(t, types.VerifyChunkPath(root, offset, leaf, path), "size %d, offset past the content %d", size, offset)
+
+			_, fileLeaf, filePath, err := types.FileChunkPath(bytes.NewReader(content), offset)
+			require.NoError(t, err)
+			require.Equal(t, leaf, fileLeaf)
+			require.Empty(t, filePath)
+		}
+		if size > chunk {
+			require.Error(t, types.VerifyChunkPath(root, uint64(size)-1, blocks[string(root.Hash())], nil), "root of an offset in the content")
+
+			leaf, path, err := types.ChunkPath(get, root, 0)
+			require.NoError(t, err)
+			require.Error(t, types.VerifyChunkPath(root, uint64(size)-1, leaf, path), "leaf of another offset")
+		}
+	}
+
+	// raw leaves are their content
+	content := []byte("hello world\n")
+	hash, err := multihash.Sum(content, multihash.SHA2_256, -1)
+	require.NoError(t, err)
+	root := cid.NewCidV1(cid.Raw, hash)
+	require.NoError(t, types.VerifyChunkPath(root, 11, content, nil))
+	require.NoError(t, types.VerifyChunkPath(root, 12, content, nil), "offset past the content")
+	require.Error(t, types.VerifyChunkPath(root, 12, content[:11], nil), "block of other content")
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"encoding/hex"
+	"mime"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ipfs/go-cid"
+)
+
+// Field length limits, in bytes, enforced on entry metadata.
+const (
+	MaxTitleLength        = 256
+	MaxDescriptionLength  = 8192
+	MaxFileNameLength     = 255
+	MaxURLLength          = 2048
+	MaxAgencyLength       = 128
+	MaxCategoryLength     = 128
+	MaxSubmitterLength    = 256
+	MaxChangeReasonLength = 1024
+)
+
+// restrictedName matches a type or subtype name as defined in RFC 6838 section 4.2.
+var restrictedName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]{0,126}$`)
+
+// allowedURLSchemes lists the schemes accepted for file and fallback URLs.
+var allowedURLSchemes = map[string]bool{
+	"http":  true,
+	"https": true,
+	"ipfs":  true,
+	"ipns":  true,
+}
+
+// ValidateCid checks that s is a valid CIDv0 or multibase-encoded CIDv1.
+func ValidateCid(s string) error {
+	if s == "" {
+		return errorsmod.Wrap(ErrInvalidCid, "cid cannot be empty")
+	}
+	if _, err := cid.Decode(s); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCid, "%s: %s", s, err)
+	}
+	return nil
+}
+
+// NormalizeCid returns the canonical form of an IPFS CID, used to detect the
+// same content referenced by a CIDv0 and a CIDv1. Values that are not valid
+// CIDs are returned unchanged.
+func NormalizeCid(s string) string {
+	c, err := cid.Decode(s)
+	if err != nil {
+		return s
+	}
+	return cid.NewCidV1(c.Type(), c.Hash()).String()
+}
+
+// NormalizeChecksum returns the canonical, lower-case form of a hex encoded
+// SHA-256 checksum.
+func NormalizeChecksum(s string) string {
+	return strings.ToLower(s)
+}
+
+// ValidateReason checks that s is a non-empty retraction or removal reason.
+func ValidateReason(s string) error {
+	return validateText(ErrInvalidReason, "reason", s, MaxChangeReasonLength, true)
+}
+
+// ValidateChecksum checks that s is a hex encoded SHA-256 digest.
+func ValidateChecksum(s string) error {
+	if len(s) != 64 {
+		return errorsmod.Wrapf(ErrInvalidChecksum, "expected 64 hex characters, got %d", len(s))
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChecksum, "%s is not hex encoded", s)
+	}
+	return nil
+}
+
+// ValidateMimeType checks that s is a media type following the RFC 6838 naming
+// rules, optionally followed by parameters (e.g. "text/csv; charset=utf-8").
+func ValidateMimeType(s string) error {
+	if s == "" {
+		return errorsmod.Wrap(ErrInvalidMimeType, "mime type cannot be empty")
+	}
+	mediaType, _, err := mime.ParseMediaType(s)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidMimeType, "%s: %s", s, err)
+	}
+	typ, subtype, ok := strings.Cut(mediaType, "/")
+	if !ok || !restrictedName.MatchString(typ) || !restrictedName.MatchString(subtype) {
+		return errorsmod.Wrapf(ErrInvalidMimeType, "%s is not of the form type/subtype", s)
+	}
+	return nil
+}
+
+// ValidateURL checks that s is an absolute http(s), ipfs or ipns URL.
+func ValidateURL(s string) error {
+	if len(s) > MaxURLLength {
+		return errorsmod.Wrapf(ErrInvalidURL, "url exceeds %d characters", MaxURLLength)
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidURL, "%s: %s", s, err)
+	}
+	if !allowedURLSchemes[u.Scheme] {
+		return errorsmod.Wrapf(ErrInvalidURL, "%s: unsupported scheme %q", s, u.Scheme)
+	}
+	if u.Host == "" {
+		return errorsmod.Wrapf(ErrInvalidURL, "%s: missing host", s)
+	}
+	return nil
+}
+
+// validateText checks a free-form text field for length, UTF-8 encoding and,
+// when required, presence.
+func validateText(err *errorsmod.Error, name, s string, maxLen int, required bool) error {
+	switch {
+	case required && strings.TrimSpace(s) == "":
+		return errorsmod.Wrapf(err, "%s cannot be empty", name)
+	case len(s) > maxLen:
+		return errorsmod.Wrapf(err, "%s exceeds %d characters", name, maxLen)
+	case !utf8.ValidString(s):
+		return errorsmod.Wrapf(err, "%s is not valid UTF-8", name)
+	}
+	return nil
+}
+
+// validateEntryFields performs the stateless checks shared by MsgCreateEntry
+// and MsgUpdateEntry. Only the fields for which include returns true are
+// checked.
+func validateEntryFields(
+	include func(field string) bool,
+	title, description, ipfsCid, mimeType, fileName, fileUrl, fallbackUrl,
+	checksum, agency, category, submitter string,
+) error {
+	if include(FieldTitle) {
+		if err := validateText(ErrInvalidTitle, "title", title, MaxTitleLength, true); err != nil {
+			return err
+		}
+	}
+	if include(FieldDescription) {
+		if err := validateText(ErrInvalidDescription, "description", description, MaxDescriptionLength, false); err != nil {
+			return err
+		}
+	}
+	if include(FieldIpfsCid) {
+		if err := ValidateCid(ipfsCid); err != nil {
+			return err
+		}
+	}
+	if include(FieldMimeType) {
+		if err := ValidateMimeType(mimeType); err != nil {
+			return err
+		}
+	}
+	if include(FieldFileName) {
+		if err := validateText(ErrInvalidFileName, "file name", fileName, MaxFileNameLength, false); err != nil {
+			return err
+		}
+		if strings.ContainsAny(fileName, "/\\") {
+			return errorsmod.Wrapf(ErrInvalidFileName, "%s must not contain path separators", fileName)
+		}
+	}
+	if include(FieldFileUrl) && fileUrl != "" {
+		if err := ValidateURL(fileUrl); err != nil {
+			return errorsmod.Wrap(err, "file url")
+		}
+	}
+	if include(FieldFallbackUrl) && fallbackUrl != "" {
+		if err := ValidateURL(fallbackUrl); err != nil {
+			return errorsmod.Wrap(err, "fallback url")
+		}
+	}
+	if include(FieldChecksumSha256) {
+		if err := ValidateChecksum(checksum); err != nil {
+			return err
+		}
+	}
+	if include(FieldAgency) {
+		if err := validateText(ErrInvalidAgency, "agency", agency, MaxAgencyLength, true); err != nil {
+			return err
+		}
+	}
+	if include(FieldCategory) {
+		if err := validateText(ErrInvalidCategory, "category", category, MaxCategoryLength, false); err != nil {
+			return err
+		}
+	}
+	if include(FieldSubmitter) {
+		return validateText(ErrInvalidSubmitter, "submitter", submitter, MaxSubmitterLength, false)
+	}
+	return nil
+}